@@ -4,6 +4,7 @@ require (
 	github.com/google/go-cmp v0.2.0
 	github.com/h2non/gock v1.0.9
 	github.com/nbio/st v0.0.0-20140626010706-e9e8d9816f32 // indirect
+	golang.org/x/crypto v0.0.0-20211117183948-ae814b36b871
 )
 
 go 1.13
@@ -0,0 +1,157 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// CheckRun represents a check run. Providers without
+	// native check runs represent the check run using the
+	// closest equivalent, such as a commit status or a
+	// code insights report.
+	CheckRun struct {
+		ID         string
+		Name       string
+		HeadSHA    string
+		ExternalID string
+		DetailsURL string
+		Link       string
+		State      State
+
+		// Conclusion is the provider specific conclusion
+		// of a completed check run, for example neutral or
+		// skipped, which cannot be represented by State.
+		Conclusion string
+
+		SuiteID   string
+		Output    *CheckRunOutput
+		Started   time.Time
+		Completed time.Time
+	}
+
+	// CheckRunOutput represents the output of a check run.
+	CheckRunOutput struct {
+		Title       string
+		Summary     string
+		Text        string
+		Annotations []*CheckRunAnnotation
+	}
+
+	// CheckRunAnnotation represents a check run annotation
+	// that references a specific location in a file.
+	CheckRunAnnotation struct {
+		Path        string
+		StartLine   int
+		EndLine     int
+		StartColumn int
+		EndColumn   int
+		Level       AnnotationLevel
+		Title       string
+		Message     string
+		RawDetails  string
+	}
+
+	// CheckRunAction represents an action the user can
+	// request the integrator to perform, displayed as a
+	// button next to the check run.
+	CheckRunAction struct {
+		Label       string
+		Description string
+		Identifier  string
+	}
+
+	// CheckRunInput provides the input fields required for
+	// creating or updating a check run.
+	CheckRunInput struct {
+		Name       string
+		HeadSHA    string
+		ExternalID string
+		DetailsURL string
+		State      State
+
+		// Conclusion optionally overrides the conclusion
+		// derived from State, for providers that support
+		// additional conclusions, for example neutral.
+		Conclusion string
+
+		Output    *CheckRunOutput
+		Actions   []*CheckRunAction
+		Started   time.Time
+		Completed time.Time
+
+		// PullRequest optionally identifies the pull request.
+		// Providers that report checks as pull request
+		// statuses, for example Azure DevOps, use the pull
+		// request instead of the commit sha.
+		PullRequest int
+	}
+
+	// CheckSuite represents a check suite, a collection of
+	// check runs created by a single app for a commit.
+	CheckSuite struct {
+		ID         string
+		HeadBranch string
+		HeadSHA    string
+		Before     string
+		After      string
+		State      State
+		Conclusion string
+		App        *App
+		Created    time.Time
+		Updated    time.Time
+	}
+
+	// CheckSuiteInput provides the input fields required
+	// for creating a check suite.
+	CheckSuiteInput struct {
+		HeadSHA string
+	}
+
+	// CheckListOptions provides options for querying a
+	// list of check runs or check suites.
+	CheckListOptions struct {
+		Name string
+		Page int
+		Size int
+	}
+
+	// ChecksService provides access to check runs and
+	// check suites.
+	ChecksService interface {
+		// FindCheckRun returns a check run by id.
+		FindCheckRun(context.Context, string, string) (*CheckRun, *Response, error)
+
+		// ListCheckRuns returns a list of check runs for
+		// the commit reference.
+		ListCheckRuns(context.Context, string, string, CheckListOptions) ([]*CheckRun, *Response, error)
+
+		// ListAnnotations returns a list of check run
+		// annotations.
+		ListAnnotations(context.Context, string, string, ListOptions) ([]*CheckRunAnnotation, *Response, error)
+
+		// CreateCheckRun creates a new check run.
+		CreateCheckRun(context.Context, string, *CheckRunInput) (*CheckRun, *Response, error)
+
+		// UpdateCheckRun updates an existing check run.
+		UpdateCheckRun(context.Context, string, string, *CheckRunInput) (*CheckRun, *Response, error)
+
+		// FindCheckSuite returns a check suite by id.
+		FindCheckSuite(context.Context, string, string) (*CheckSuite, *Response, error)
+
+		// ListCheckSuites returns a list of check suites
+		// for the commit reference.
+		ListCheckSuites(context.Context, string, string, CheckListOptions) ([]*CheckSuite, *Response, error)
+
+		// CreateCheckSuite creates a new check suite.
+		CreateCheckSuite(context.Context, string, *CheckSuiteInput) (*CheckSuite, *Response, error)
+
+		// RerequestCheckSuite requests the check suite is
+		// run again.
+		RerequestCheckSuite(context.Context, string, string) (*Response, error)
+	}
+)
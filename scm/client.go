@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -28,6 +29,18 @@ var (
 	// authorized or the user does not have access to the
 	// resource.
 	ErrNotAuthorized = errors.New("Not Authorized")
+
+	// ErrConflict indicates the request conflicts with the
+	// current state of the resource.
+	ErrConflict = errors.New("Conflict")
+
+	// ErrRateLimited indicates the request was rejected
+	// because the rate limit is exceeded.
+	ErrRateLimited = errors.New("Rate Limited")
+
+	// ErrValidation indicates the request was rejected
+	// because the input failed validation.
+	ErrValidation = errors.New("Validation Failed")
 )
 
 type (
@@ -37,6 +50,17 @@ type (
 		Path   string
 		Header http.Header
 		Body   io.Reader
+
+		// ContentLength records the length of the body when
+		// it is streamed from a reader of unknown length. If
+		// zero, the length is derived from the body.
+		ContentLength int64
+
+		// NoRedirect disables following redirects, and the
+		// redirect response is returned to the caller. This
+		// prevents the client credentials from being sent to
+		// the redirect location.
+		NoRedirect bool
 	}
 
 	// Response represents an HTTP response.
@@ -108,20 +132,28 @@ type (
 		BaseURL *url.URL
 
 		// Services used for communicating with the API.
-		Driver        Driver
-		Linker        Linker
-		Apps          AppsService
-		Contents      ContentService
-		Git           GitService
-		Organizations OrganizationService
-		Issues        IssueService
-		Milestones    MilestoneService
-		PullRequests  PullRequestService
-		Repositories  RepositoryService
-		Releases      ReleaseService
-		Reviews       ReviewService
-		Users         UserService
-		Webhooks      WebhookService
+		Driver            Driver
+		Linker            Linker
+		Apps              AppsService
+		BranchProtections BranchProtectionService
+		Checks            ChecksService
+		Contents          ContentService
+		Deployments       DeploymentService
+		Git               GitService
+		GitData           GitDataService
+		Organizations     OrganizationService
+		Pipelines         PipelineService
+		Issues            IssueService
+		Keys              KeyService
+		Labels            LabelService
+		Milestones        MilestoneService
+		PullRequests      PullRequestService
+		Repositories      RepositoryService
+		Releases          ReleaseService
+		Reviews           ReviewService
+		Secrets           SecretService
+		Users             UserService
+		Webhooks          WebhookService
 
 		// DumpResponse optionally specifies a function to
 		// dump the the response body for debugging purposes.
@@ -201,6 +233,9 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 	if err != nil {
 		return nil, err
 	}
+	if in.ContentLength > 0 {
+		req.ContentLength = in.ContentLength
+	}
 	// hack to prevent the client from un-escaping the
 	// encoded github path parameters when parsing the url.
 	if strings.Contains(in.Path, "%2F") {
@@ -217,6 +252,13 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 	if client == nil {
 		client = http.DefaultClient
 	}
+	if in.NoRedirect {
+		noRedirect := *client
+		noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		client = &noRedirect
+	}
 	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -246,9 +288,36 @@ func newResponse(r *http.Response) *Response {
 		Body:   r.Body,
 	}
 	res.populatePageValues()
+	res.Rate = ParseRate(r.Header)
 	return res
 }
 
+// ParseRate parses the rate limit snapshot from the HTTP
+// response headers. It supports the X-RateLimit-* headers
+// used by GitHub, Gitea and Bitbucket, and the RateLimit-*
+// headers used by GitLab. A reset value that is not a unix
+// timestamp is treated as the number of seconds until the
+// rate limit resets.
+func ParseRate(h http.Header) Rate {
+	rate := Rate{}
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		limit := h.Get(prefix + "Limit")
+		if limit == "" {
+			continue
+		}
+		rate.Limit, _ = strconv.Atoi(limit)
+		rate.Remaining, _ = strconv.Atoi(h.Get(prefix + "Remaining"))
+		rate.Reset, _ = strconv.ParseInt(h.Get(prefix+"Reset"), 10, 64)
+		break
+	}
+	// a reset value below this threshold cannot be a recent
+	// unix timestamp and is assumed to be a delta.
+	if rate.Reset > 0 && rate.Reset < 1000000000 {
+		rate.Reset = time.Now().Unix() + rate.Reset
+	}
+	return rate
+}
+
 // populatePageValues parses the HTTP Link response headers
 // and populates the various pagination link values in the
 // Response.
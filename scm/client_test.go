@@ -7,6 +7,7 @@ package scm
 import (
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestClient(t *testing.T) {
@@ -39,3 +40,46 @@ func TestResponse(t *testing.T) {
 		t.Errorf("Want rel next %d, got %d", want, got)
 	}
 }
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		header http.Header
+		want   Rate
+	}{
+		{
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"60"},
+				"X-Ratelimit-Remaining": {"59"},
+				"X-Ratelimit-Reset":     {"1512076018"},
+			},
+			want: Rate{Limit: 60, Remaining: 59, Reset: 1512076018},
+		},
+		{
+			header: http.Header{
+				"Ratelimit-Limit":     {"600"},
+				"Ratelimit-Remaining": {"599"},
+				"Ratelimit-Reset":     {"1512454441"},
+			},
+			want: Rate{Limit: 600, Remaining: 599, Reset: 1512454441},
+		},
+		{
+			header: http.Header{},
+			want:   Rate{},
+		},
+	}
+	for _, test := range tests {
+		if got := ParseRate(test.header); got != test.want {
+			t.Errorf("Want rate %v, got %v", test.want, got)
+		}
+	}
+
+	// a reset value that is not a unix timestamp is
+	// treated as the number of seconds until reset.
+	got := ParseRate(http.Header{
+		"Ratelimit-Limit": {"100"},
+		"Ratelimit-Reset": {"60"},
+	})
+	if got.Reset < time.Now().Unix()+59 {
+		t.Errorf("Want relative reset converted to unix time, got %d", got.Reset)
+	}
+}
@@ -48,6 +48,14 @@ const (
 	ActionUnpublish
 	ActionPrerelease
 	ActionRelease
+	// check runs and check suites
+	ActionComplete
+	ActionRequest
+	ActionRerequest
+	ActionRequestAction
+	// reviews
+	ActionSubmit
+	ActionDismiss
 )
 
 // String returns the string representation of Action.
@@ -83,6 +91,18 @@ func (a Action) String() (s string) {
 		return "released"
 	case ActionReviewReady:
 		return "review_ready"
+	case ActionComplete:
+		return "completed"
+	case ActionRequest:
+		return "requested"
+	case ActionRerequest:
+		return "rerequested"
+	case ActionRequestAction:
+		return "requested_action"
+	case ActionSubmit:
+		return "submitted"
+	case ActionDismiss:
+		return "dismissed"
 	default:
 		return
 	}
@@ -132,6 +152,226 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionRelease
 	case "review_ready":
 		*a = ActionReviewReady
+	case "completed":
+		*a = ActionComplete
+	case "requested":
+		*a = ActionRequest
+	case "rerequested":
+		*a = ActionRerequest
+	case "requested_action":
+		*a = ActionRequestAction
+	case "submitted":
+		*a = ActionSubmit
+	case "dismissed":
+		*a = ActionDismiss
+	}
+	return nil
+}
+
+// AnnotationLevel identifies the severity of a check run
+// annotation.
+type AnnotationLevel int
+
+// AnnotationLevel values.
+const (
+	AnnotationLevelUnknown AnnotationLevel = iota
+	AnnotationLevelNotice
+	AnnotationLevelWarning
+	AnnotationLevelFailure
+)
+
+// String returns the string representation of AnnotationLevel.
+func (l AnnotationLevel) String() string {
+	switch l {
+	case AnnotationLevelNotice:
+		return "notice"
+	case AnnotationLevelWarning:
+		return "warning"
+	case AnnotationLevelFailure:
+		return "failure"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded AnnotationLevel.
+func (l AnnotationLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded AnnotationLevel.
+func (l *AnnotationLevel) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case "notice":
+		*l = AnnotationLevelNotice
+	case "warning":
+		*l = AnnotationLevelWarning
+	case "failure":
+		*l = AnnotationLevelFailure
+	default:
+		*l = AnnotationLevelUnknown
+	}
+	return nil
+}
+
+// MergeMethod defines the pull request merge method.
+type MergeMethod int
+
+// MergeMethod values.
+const (
+	MergeMethodDefault MergeMethod = iota
+	MergeMethodMerge
+	MergeMethodSquash
+	MergeMethodRebase
+	MergeMethodFastForward
+)
+
+// String returns the string representation of MergeMethod.
+func (m MergeMethod) String() string {
+	switch m {
+	case MergeMethodMerge:
+		return "merge"
+	case MergeMethodSquash:
+		return "squash"
+	case MergeMethodRebase:
+		return "rebase"
+	case MergeMethodFastForward:
+		return "fast-forward"
+	default:
+		return "default"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded MergeMethod.
+func (m MergeMethod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded MergeMethod.
+func (m *MergeMethod) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case MergeMethodMerge.String():
+		*m = MergeMethodMerge
+	case MergeMethodSquash.String():
+		*m = MergeMethodSquash
+	case MergeMethodRebase.String():
+		*m = MergeMethodRebase
+	case MergeMethodFastForward.String():
+		*m = MergeMethodFastForward
+	default:
+		*m = MergeMethodDefault
+	}
+	return nil
+}
+
+// ReviewState defines the state of a pull request review.
+type ReviewState int
+
+// ReviewState values.
+const (
+	ReviewStateUnknown ReviewState = iota
+	ReviewStatePending
+	ReviewStateCommented
+	ReviewStateApproved
+	ReviewStateChangesRequested
+	ReviewStateDismissed
+)
+
+// String returns the string representation of ReviewState.
+func (s ReviewState) String() string {
+	switch s {
+	case ReviewStatePending:
+		return "pending"
+	case ReviewStateCommented:
+		return "commented"
+	case ReviewStateApproved:
+		return "approved"
+	case ReviewStateChangesRequested:
+		return "changes_requested"
+	case ReviewStateDismissed:
+		return "dismissed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded ReviewState.
+func (s ReviewState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded ReviewState.
+func (s *ReviewState) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case ReviewStatePending.String():
+		*s = ReviewStatePending
+	case ReviewStateCommented.String():
+		*s = ReviewStateCommented
+	case ReviewStateApproved.String():
+		*s = ReviewStateApproved
+	case ReviewStateChangesRequested.String():
+		*s = ReviewStateChangesRequested
+	case ReviewStateDismissed.String():
+		*s = ReviewStateDismissed
+	default:
+		*s = ReviewStateUnknown
+	}
+	return nil
+}
+
+// ReviewSide defines the side of the diff a review comment
+// applies to. The right side is assumed when unknown.
+type ReviewSide int
+
+// ReviewSide values.
+const (
+	ReviewSideUnknown ReviewSide = iota
+	ReviewSideLeft
+	ReviewSideRight
+)
+
+// String returns the string representation of ReviewSide.
+func (s ReviewSide) String() string {
+	switch s {
+	case ReviewSideLeft:
+		return "left"
+	case ReviewSideRight:
+		return "right"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded ReviewSide.
+func (s ReviewSide) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded ReviewSide.
+func (s *ReviewSide) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v {
+	case ReviewSideLeft.String():
+		*s = ReviewSideLeft
+	case ReviewSideRight.String():
+		*s = ReviewSideRight
+	default:
+		*s = ReviewSideUnknown
 	}
 	return nil
 }
@@ -261,6 +501,83 @@ func (k *ContentKind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// FileAction defines the change made to a file in a
+// commit.
+type FileAction int
+
+// FileAction values.
+const (
+	FileActionUnknown FileAction = iota
+	FileActionCreate
+	FileActionUpdate
+	FileActionDelete
+	FileActionMove
+)
+
+// String returns the string representation of FileAction.
+func (a FileAction) String() string {
+	switch a {
+	case FileActionCreate:
+		return "create"
+	case FileActionUpdate:
+		return "update"
+	case FileActionDelete:
+		return "delete"
+	case FileActionMove:
+		return "move"
+	default:
+		return "unknown"
+	}
+}
+
+// ArchiveFormat defines the format of a repository
+// archive.
+type ArchiveFormat int
+
+// ArchiveFormat values.
+const (
+	ArchiveFormatTarGz ArchiveFormat = iota
+	ArchiveFormatZip
+)
+
+// String returns the string representation of
+// ArchiveFormat, which is the archive file extension.
+func (f ArchiveFormat) String() string {
+	switch f {
+	case ArchiveFormatZip:
+		return "zip"
+	default:
+		return "tar.gz"
+	}
+}
+
+// SecretVisibility defines the repositories that can
+// access an organization secret.
+type SecretVisibility int
+
+// SecretVisibility values.
+const (
+	SecretVisibilityUndefined SecretVisibility = iota
+	SecretVisibilityAll
+	SecretVisibilityPrivate
+	SecretVisibilitySelected
+)
+
+// String returns the string representation of
+// SecretVisibility.
+func (v SecretVisibility) String() string {
+	switch v {
+	case SecretVisibilityAll:
+		return "all"
+	case SecretVisibilityPrivate:
+		return "private"
+	case SecretVisibilitySelected:
+		return "selected"
+	default:
+		return "unknown"
+	}
+}
+
 // Visibility defines repository visibility.
 type Visibility int
 
@@ -286,6 +603,56 @@ func (v Visibility) String() (s string) {
 	}
 }
 
+// Permission defines a normalized repository permission
+// level.
+type Permission int
+
+// Permission values.
+const (
+	PermissionUndefined Permission = iota
+	PermissionRead
+	PermissionWrite
+	PermissionAdmin
+)
+
+// String returns the string representation of Permission.
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return "read"
+	case PermissionWrite:
+		return "write"
+	case PermissionAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded Permission.
+func (p Permission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded Permission.
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case PermissionRead.String():
+		*p = PermissionRead
+	case PermissionWrite.String():
+		*p = PermissionWrite
+	case PermissionAdmin.String():
+		*p = PermissionAdmin
+	default:
+		*p = PermissionUndefined
+	}
+	return nil
+}
+
 // Status defines an enum for execution status
 type ExecutionStatus int
 
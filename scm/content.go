@@ -4,7 +4,10 @@
 
 package scm
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type (
 	// Content stores the contents of a repository file.
@@ -15,6 +18,20 @@ type (
 		BlobID string
 	}
 
+	// RawContent stores the metadata of a repository file,
+	// and streams the file contents. The metadata is only
+	// populated when it is returned by the provider. Sha is
+	// the sha of the last commit that changed the file, and
+	// BlobID is the blob sha. GitHub and Gitea only return
+	// the BlobID, and Gitee and Gogs return neither.
+	RawContent struct {
+		Path   string
+		Size   int64
+		Sha    string
+		BlobID string
+		Body   io.ReadCloser
+	}
+
 	// ContentParams provide parameters for creating and
 	// updating repository content.
 	ContentParams struct {
@@ -27,6 +44,31 @@ type (
 		Signature Signature
 	}
 
+	// CommitInput provides the input fields required for
+	// committing changes to multiple repository files in a
+	// single commit.
+	CommitInput struct {
+		Branch  string
+		Message string
+		Author  Signature
+		Actions []*CommitAction
+
+		// Parent is the expected sha of the branch head. If
+		// provided, the commit is rejected when the branch
+		// was updated since the parent commit.
+		Parent string
+	}
+
+	// CommitAction describes a change to a repository file.
+	// A moved file is renamed from the previous path, and its
+	// content is replaced if data is provided.
+	CommitAction struct {
+		Action       FileAction
+		Path         string
+		PreviousPath string
+		Data         []byte
+	}
+
 	// ContentInfo stores the kind of any content in a repository.
 	ContentInfo struct {
 		Path   string
@@ -40,6 +82,16 @@ type (
 		// Find returns the repository file content by path.
 		Find(ctx context.Context, repo, path, ref string) (*Content, *Response, error)
 
+		// FindRaw returns the repository file content by path
+		// as a stream, without buffering the file in memory. The
+		// caller is responsible for closing the content body.
+		FindRaw(ctx context.Context, repo, path, ref string) (*RawContent, *Response, error)
+
+		// Archive returns a snapshot of the repository at the
+		// ref as a stream in the given archive format. The
+		// caller is responsible for closing the stream.
+		Archive(ctx context.Context, repo, ref string, format ArchiveFormat) (io.ReadCloser, *Response, error)
+
 		// Create creates a new repository file.
 		Create(ctx context.Context, repo, path string, params *ContentParams) (*Response, error)
 
@@ -49,6 +101,10 @@ type (
 		// Delete deletes a repository file.
 		Delete(ctx context.Context, repo, path string, params *ContentParams) (*Response, error)
 
+		// Commit commits changes to multiple repository files
+		// in a single commit.
+		Commit(ctx context.Context, repo string, input *CommitInput) (*Commit, *Response, error)
+
 		// List returns a list of contents in a repository directory by path. It is
 		// up to the driver to list the directory recursively or non-recursively,
 		// but a robust driver should return a non-recursive list if possible.
@@ -11,6 +11,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -39,13 +41,21 @@ func New(uri, owner, project string) (*scm.Client, error) {
 	client.Driver = scm.DriverAzure
 	client.Linker = &linker{base.String()}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &RepositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -86,11 +96,14 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+	// parse the azure request id.
+	res.ID = res.Header.Get("X-Vss-E2eid")
+
 	// error response.
 	if res.Status > 300 {
-		err := new(Error)
-		_ = json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, newError(res)
 	}
 	// the following is used for debugging purposes.
 	// bytes, err := io.ReadAll(res.Body)
@@ -109,9 +122,39 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, decodeErr
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the azure request id.
+	res.ID = res.Header.Get("X-Vss-E2eid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
 // Error represents am Azure error.
 type Error struct {
-	Message string `json:"message"`
+	Message   string `json:"message"`
+	TypeKey   string `json:"typeKey"`
+	ErrorCode int    `json:"errorCode"`
 }
 
 func (e *Error) Error() string {
@@ -125,3 +168,20 @@ func ProjectRequiredError() error {
 func SanitizeBranchName(name string) string {
 	return "refs/heads/" + name
 }
+
+// newError returns an API error from the azure error
+// response.
+func newError(res *scm.Response) error {
+	raw, _ := ioutil.ReadAll(res.Body)
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	return &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Code:      from.TypeKey,
+		Message:   from.Message,
+		Header:    res.Header,
+		Raw:       raw,
+		Err:       from,
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// checksService implements the ChecksService using Azure
+// DevOps git statuses. A check run is reported as a pull
+// request status when the input includes a pull request
+// number, otherwise it is reported as a commit status. The
+// check run name is split into the status genre and name,
+// for example continuous-integration/drone. Azure DevOps
+// does not support check suites or annotations.
+type checksService struct {
+	client *wrapper
+}
+
+type gitStatusList struct {
+	Count int          `json:"count"`
+	Value []*gitStatus `json:"value"`
+}
+
+type gitStatus struct {
+	ID          int              `json:"id"`
+	State       string           `json:"state"`
+	Description string           `json:"description,omitempty"`
+	Context     gitStatusContext `json:"context"`
+	TargetURL   string           `json:"targetUrl,omitempty"`
+	CreatedAt   time.Time        `json:"creationDate"`
+	UpdatedAt   time.Time        `json:"updatedDate"`
+}
+
+type gitStatusInput struct {
+	State       string           `json:"state"`
+	Description string           `json:"description,omitempty"`
+	Context     gitStatusContext `json:"context"`
+	TargetURL   string           `json:"targetUrl,omitempty"`
+}
+
+type gitStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre,omitempty"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/statuses/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/statuses?api-version=6.0",
+		s.client.owner, s.client.project, repo, ref)
+	out := new(gitStatusList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertGitStatusList(out.Value, ref, opts.Name), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/statuses/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/statuses?api-version=6.0",
+		s.client.owner, s.client.project, repo, input.HeadSHA)
+	if input.PullRequest > 0 {
+		// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-statuses/create?view=azure-devops-rest-6.0
+		endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/statuses?api-version=6.0",
+			s.client.owner, s.client.project, repo, input.PullRequest)
+	}
+	in := &gitStatusInput{
+		State:     convertFromCheckState(input.State),
+		Context:   convertFromCheckName(input.Name),
+		TargetURL: input.DetailsURL,
+	}
+	if output := input.Output; output != nil {
+		in.Description = output.Title
+		if in.Description == "" {
+			in.Description = output.Summary
+		}
+	}
+	out := new(gitStatus)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertGitStatus(out, input.HeadSHA), res, err
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	return s.CreateCheckRun(ctx, repo, input)
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func convertGitStatusList(from []*gitStatus, sha, name string) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from {
+		run := convertGitStatus(v, sha)
+		if name != "" && run.Name != name {
+			continue
+		}
+		to = append(to, run)
+	}
+	return to
+}
+
+func convertGitStatus(from *gitStatus, sha string) *scm.CheckRun {
+	to := &scm.CheckRun{
+		ID:         strconv.Itoa(from.ID),
+		Name:       convertCheckName(from.Context),
+		HeadSHA:    sha,
+		DetailsURL: from.TargetURL,
+		State:      convertCheckState(from.State),
+		Started:    from.CreatedAt,
+		Completed:  from.UpdatedAt,
+	}
+	if from.Description != "" {
+		to.Output = &scm.CheckRunOutput{
+			Title: from.Description,
+		}
+	}
+	return to
+}
+
+func convertCheckName(from gitStatusContext) string {
+	if from.Genre == "" {
+		return from.Name
+	}
+	return from.Genre + "/" + from.Name
+}
+
+func convertFromCheckName(from string) gitStatusContext {
+	if i := strings.LastIndex(from, "/"); i != -1 {
+		return gitStatusContext{Genre: from[:i], Name: from[i+1:]}
+	}
+	return gitStatusContext{Name: from}
+}
+
+func convertCheckState(from string) scm.State {
+	switch from {
+	case "succeeded":
+		return scm.StateSuccess
+	case "failed":
+		return scm.StateFailure
+	case "error":
+		return scm.StateError
+	case "pending":
+		return scm.StatePending
+	case "notApplicable":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
+
+func convertFromCheckState(from scm.State) string {
+	switch from {
+	case scm.StatePending, scm.StateRunning:
+		return "pending"
+	case scm.StateSuccess:
+		return "succeeded"
+	case scm.StateFailure:
+		return "failed"
+	case scm.StateCanceled:
+		return "notApplicable"
+	case scm.StateError:
+		return "error"
+	default:
+		return "notSet"
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/commits/91a4a3a2b6a3a0e0d0b5f4a7d2a4b6ab4f2a6c8e/statuses").
+		JSON(map[string]interface{}{
+			"state":       "succeeded",
+			"description": "The build is successful",
+			"context":     map[string]string{"name": "drone", "genre": "continuous-integration"},
+			"targetUrl":   "https://ci.example.com/builds/1",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	input := &scm.CheckRunInput{
+		Name:       "continuous-integration/drone",
+		HeadSHA:    "91a4a3a2b6a3a0e0d0b5f4a7d2a4b6ab4f2a6c8e",
+		DetailsURL: "https://ci.example.com/builds/1",
+		State:      scm.StateSuccess,
+		Output: &scm.CheckRunOutput{
+			Title: "The build is successful",
+		},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Checks.CreateCheckRun(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/status.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCreateCheckRun_PullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/statuses").
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	input := &scm.CheckRunInput{
+		Name:        "continuous-integration/drone",
+		HeadSHA:     "91a4a3a2b6a3a0e0d0b5f4a7d2a4b6ab4f2a6c8e",
+		State:       scm.StateSuccess,
+		PullRequest: 1,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Checks.CreateCheckRun(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect status created for the pull request")
+	}
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/commits/91a4a3a2b6a3a0e0d0b5f4a7d2a4b6ab4f2a6c8e/statuses").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Checks.ListCheckRuns(context.Background(), "REPOID", "91a4a3a2b6a3a0e0d0b5f4a7d2a4b6ab4f2a6c8e", scm.CheckListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCreateCheckRun_ProjectRequired(t *testing.T) {
+	client := NewDefault("ORG", "")
+	_, _, err := client.Checks.CreateCheckRun(context.Background(), "REPOID", &scm.CheckRunInput{})
+	if err == nil {
+		t.Errorf("Expect project required error")
+	}
+}
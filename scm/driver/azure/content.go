@@ -8,7 +8,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -38,6 +40,56 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/items/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// the item metadata is requested without the content,
+	// and the content is streamed in a second request.
+	urlEncodedRef := url.QueryEscape(ref)
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items?path=%s&$format=json", s.client.owner, s.client.project, repo, path)
+	endpoint += generateURIFromRef(urlEncodedRef)
+	endpoint += "&api-version=6.0"
+	out := new(content)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items?path=%s&$format=octetStream", s.client.owner, s.client.project, repo, path)
+	endpoint += generateURIFromRef(urlEncodedRef)
+	endpoint += "&api-version=6.0"
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	return &scm.RawContent{
+		Path:   out.Path,
+		Size:   size,
+		Sha:    out.CommitID,
+		BlobID: out.ObjectID,
+		Body:   body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/items/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// the repository root folder can only be downloaded as
+	// a zip archive.
+	if format != scm.ArchiveFormatZip {
+		return nil, nil, scm.ErrNotSupported
+	}
+	urlEncodedRef := url.QueryEscape(ref)
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items?path=/&recursionLevel=full&$format=zip", s.client.owner, s.client.project, repo)
+	endpoint += generateURIFromRef(urlEncodedRef)
+	endpoint += "&api-version=6.0"
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	if s.client.project == "" {
 		return nil, ProjectRequiredError()
@@ -122,6 +174,81 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// the push is rejected if the branch head is not the
+	// parent commit. If a parent commit is not provided, the
+	// current branch head is used.
+	name := scm.ExpandRef(input.Branch, "refs/heads")
+	parent := input.Parent
+	if parent == "" {
+		current, res, err := findRef(ctx, s.client, repo, name)
+		if err != nil {
+			return nil, res, err
+		}
+		parent = current.ObjectID
+	}
+
+	com := pushCommit{
+		Comment: input.Message,
+		Changes: []change{},
+	}
+	if input.Author.Email != "" {
+		com.Author = &pushSignature{
+			Name:  input.Author.Name,
+			Email: input.Author.Email,
+		}
+	}
+	for _, v := range input.Actions {
+		switch v.Action {
+		case scm.FileActionCreate:
+			com.Changes = append(com.Changes, newContentChange("add", v.Path, v.Data))
+		case scm.FileActionUpdate:
+			com.Changes = append(com.Changes, newContentChange("edit", v.Path, v.Data))
+		case scm.FileActionDelete:
+			cha := change{ChangeType: "delete"}
+			cha.Item.Path = v.Path
+			com.Changes = append(com.Changes, cha)
+		case scm.FileActionMove:
+			// a moved file is renamed if the content is not
+			// provided, otherwise the file is deleted from the
+			// previous path and added to the new path.
+			if v.Data == nil {
+				cha := change{ChangeType: "rename", SourceServerItem: v.PreviousPath}
+				cha.Item.Path = v.Path
+				com.Changes = append(com.Changes, cha)
+			} else {
+				cha := change{ChangeType: "delete"}
+				cha.Item.Path = v.PreviousPath
+				com.Changes = append(com.Changes, cha, newContentChange("add", v.Path, v.Data))
+			}
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+	}
+	in := &pushInput{
+		RefUpdates: []refUpdate{{
+			Name:        name,
+			OldObjectID: parent,
+		}},
+		Commits: []pushCommit{com},
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pushes?api-version=6.0", s.client.owner, s.client.project, repo)
+	out := new(push)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Commits) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertCommit(out.Commits[0]), res, nil
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/items/list?view=azure-devops-rest-6.0
 	if s.client.project == "" {
@@ -163,8 +290,9 @@ type refUpdate struct {
 	OldObjectID string `json:"oldObjectId,omitempty"`
 }
 type change struct {
-	ChangeType string `json:"changeType"`
-	Item       struct {
+	ChangeType       string `json:"changeType"`
+	SourceServerItem string `json:"sourceServerItem,omitempty"`
+	Item             struct {
 		Path string `json:"path"`
 	} `json:"item"`
 	NewContent struct {
@@ -181,6 +309,16 @@ type contentCreateUpdate struct {
 	Commits    []commit    `json:"commits"`
 }
 
+// newContentChange returns a change that writes the base64
+// encoded file content.
+func newContentChange(changeType, path string, data []byte) change {
+	cha := change{ChangeType: changeType}
+	cha.Item.Path = path
+	cha.NewContent.Content = base64.StdEncoding.EncodeToString(data)
+	cha.NewContent.ContentType = "base64encoded"
+	return cha
+}
+
 func convertContentInfoList(from []*content) []*scm.ContentInfo {
 	to := []*scm.ContentInfo{}
 	for _, v := range from {
@@ -201,3 +201,128 @@ func Test_generateURIFromRef(t *testing.T) {
 		})
 	}
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "heads/main").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branches_filter.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pushes").
+		BodyString(`{"refUpdates":[{"name":"refs/heads/main","oldObjectId":"e0aee6aa543294d62520fb906689da6710af149c"}],"commits":[{"comment":"test message update tickles","author":{"name":"tp","email":"tp@harness.io"},"changes":[{"changeType":"add","item":{"path":"docs/hello.md"},"newContent":{"content":"aGVsbG8=","contentType":"base64encoded"}},{"changeType":"edit","item":{"path":"README.md"},"newContent":{"content":"IyB0ZXN0","contentType":"base64encoded"}},{"changeType":"delete","item":{"path":"docs/index.md"},"newContent":{}},{"changeType":"rename","sourceServerItem":"docs/old.md","item":{"path":"docs/new.md"},"newContent":{}}]}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	input := &scm.CommitInput{
+		Branch:  "main",
+		Message: "test message update tickles",
+		Author: scm.Signature{
+			Name:  "tp",
+			Email: "tp@harness.io",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionCreate, Path: "docs/hello.md", Data: []byte("hello")},
+			{Action: scm.FileActionUpdate, Path: "README.md", Data: []byte("# test")},
+			{Action: scm.FileActionDelete, Path: "docs/index.md"},
+			{Action: scm.FileActionMove, Path: "docs/new.md", PreviousPath: "docs/old.md"},
+		},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Contents.Commit(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/push.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/items").
+		MatchParam("path", "README.md").
+		MatchParam("$format", "json").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/items").
+		MatchParam("path", "README.md").
+		MatchParam("$format", "octetStream").
+		MatchParam("versionDescriptor.version", "main").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		BodyString("hello world\n")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Contents.FindRaw(context.Background(), "REPOID", "README.md", "main")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path:   "/README.md",
+		Size:   12,
+		Sha:    "2c0c712b26c3328ed66d5771213360812be9d035",
+		BlobID: "0ca446aab9d09eac8625b53e3df8da661976c458",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/items").
+		MatchParam("$format", "zip").
+		MatchParam("recursionLevel", "full").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client := NewDefault("ORG", "PROJ")
+	rc, _, err := client.Contents.Archive(context.Background(), "REPOID", "main", scm.ArchiveFormatZip)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
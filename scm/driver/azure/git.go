@@ -7,6 +7,8 @@ package azure
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -30,6 +32,14 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", endpoint, in, nil)
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	return s.deleteRef(ctx, repo, scm.ExpandRef(name, "refs/heads"))
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	if s.client.project == "" {
 		return nil, nil, ProjectRequiredError()
@@ -48,6 +58,35 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	if params.Message != "" {
+		// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/annotated-tags/create?view=azure-devops-rest-6.0
+		// the tagger is the authenticated user.
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/annotatedtags?api-version=6.0-preview.1", s.client.owner, s.client.project, repo)
+		in := &annotatedTagInput{
+			Name:    params.Name,
+			Message: params.Message,
+		}
+		in.TaggedObject.ObjectID = params.Sha
+		return s.client.do(ctx, "POST", endpoint, in, nil)
+	}
+	in := make(crudBranch, 1)
+	in[0].Name = scm.ExpandRef(params.Name, "refs/tags")
+	in[0].NewObjectID = params.Sha
+	in[0].OldObjectID = scm.EmptyCommit
+	return s.updateRefs(ctx, repo, in)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	return s.deleteRef(ctx, repo, scm.ExpandRef(name, "refs/tags"))
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -129,12 +168,140 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(changes), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// azure rejects the update if the reference does not
+	// match the old object id, but does not require the
+	// update to be a fast-forward.
+	name := scm.ExpandRef(params.Name, "refs/heads")
+	in := make(crudBranch, 1)
+	in[0].Name = name
+	in[0].NewObjectID = params.Sha
+	in[0].OldObjectID = params.OldSha
+	if in[0].OldObjectID == "" {
+		current, res, err := findRef(ctx, s.client, repo, name)
+		if err != nil {
+			return nil, res, err
+		}
+		in[0].OldObjectID = current.ObjectID
+	}
+	// unless forced, the update is rejected if the old object
+	// is not an ancestor of the new object.
+	if !params.Force && in[0].OldObjectID != params.Sha {
+		ok, res, err := s.isAncestor(ctx, repo, in[0].OldObjectID, params.Sha)
+		if err != nil {
+			return nil, res, err
+		}
+		if !ok {
+			return nil, res, scm.ErrConflict
+		}
+	}
+	res, err := s.updateRefs(ctx, repo, in)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Reference{
+		Name: scm.TrimRef(name),
+		Path: name,
+		Sha:  params.Sha,
+	}, res, nil
+}
+
+// isAncestor reports whether the base commit is an ancestor
+// of the target commit, which is true if the base commit is
+// the merge base of the two commits.
+func (s *gitService) isAncestor(ctx context.Context, repo, base, target string) (bool, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/diffs/commits?baseVersion=%s&baseVersionType=commit&targetVersion=%s&targetVersionType=commit&$top=1&api-version=6.0", s.client.owner, s.client.project, repo, base, target)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return false, res, err
+	}
+	return out.CommonCommit == base, res, nil
+}
+
+// deleteRef deletes the reference by updating the current
+// object id to the empty commit.
+func (s *gitService) deleteRef(ctx context.Context, repo, name string) (*scm.Response, error) {
+	current, res, err := findRef(ctx, s.client, repo, name)
+	if err != nil {
+		return res, err
+	}
+	in := make(crudBranch, 1)
+	in[0].Name = name
+	in[0].NewObjectID = scm.EmptyCommit
+	in[0].OldObjectID = current.ObjectID
+	return s.updateRefs(ctx, repo, in)
+}
+
+// updateRefs updates the references. The update status of
+// each reference is returned in the response body, and an
+// error is returned if a reference is not updated.
+func (s *gitService) updateRefs(ctx context.Context, repo string, in crudBranch) (*scm.Response, error) {
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?api-version=6.0", s.client.owner, s.client.project, repo)
+	out := new(refUpdateResultList)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out.Value {
+		switch {
+		case v.Success:
+		case v.UpdateStatus == "staleOldObjectId":
+			return res, scm.ErrConflict
+		default:
+			return res, &Error{Message: fmt.Sprintf("reference %s not updated: %s", v.Name, v.UpdateStatus)}
+		}
+	}
+	return res, nil
+}
+
+// findRef returns the reference by fully qualified name.
+func findRef(ctx context.Context, client *wrapper, repo, name string) (*branch, *scm.Response, error) {
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?filter=%s&api-version=6.0", client.owner, client.project, repo, url.QueryEscape(strings.TrimPrefix(name, "refs/")))
+	out := new(branchList)
+	res, err := client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the filter matches references by prefix.
+	for _, v := range out.Value {
+		if v.Name == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
 type crudBranch []struct {
 	Name        string `json:"name"`
 	OldObjectID string `json:"oldObjectId"`
 	NewObjectID string `json:"newObjectId"`
 }
 
+type refUpdateResultList struct {
+	Value []struct {
+		Name         string `json:"name"`
+		OldObjectID  string `json:"oldObjectId"`
+		NewObjectID  string `json:"newObjectId"`
+		Success      bool   `json:"success"`
+		UpdateStatus string `json:"updateStatus"`
+	} `json:"value"`
+	Count int `json:"count"`
+}
+
+type annotatedTagInput struct {
+	Name         string `json:"name"`
+	Message      string `json:"message"`
+	TaggedObject struct {
+		ObjectID string `json:"objectId"`
+	} `json:"taggedObject"`
+}
+
 type branchList struct {
 	Value []*branch `json:"value"`
 	Count int       `json:"count"`
@@ -165,6 +332,7 @@ type commitList struct {
 }
 type gitCommit struct {
 	CommitID string `json:"commitId"`
+	TreeID   string `json:"treeId"`
 	Author   struct {
 		Name  string    `json:"name"`
 		Email string    `json:"email"`
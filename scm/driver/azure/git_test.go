@@ -71,6 +71,191 @@ func TestGitCreateBranch(t *testing.T) {
 	}
 }
 
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "heads/main-patch").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branches_filter.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		BodyString(`[{"name":"refs/heads/main-patch","oldObjectId":"01768d964c03e97260af0bd8cd9e5cd1f9ac6356","newObjectId":"0000000000000000000000000000000000000000"}]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref_delete.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Git.DeleteBranch(context.Background(), "REPOID", "main-patch")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/annotatedtags").
+		BodyString(`{"name":"v1.0.0","message":"release v1.0.0","taggedObject":{"objectId":"e0aee6aa543294d62520fb906689da6710af149c"}}`).
+		Reply(201).
+		Type("application/json")
+
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "e0aee6aa543294d62520fb906689da6710af149c",
+		Message: "release v1.0.0",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.Git.CreateTag(context.Background(), "REPOID", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/diffs/commits").
+		MatchParam("baseVersion", "e0aee6aa543294d62520fb906689da6710af149c").
+		MatchParam("targetVersion", "01768d964c03e97260af0bd8cd9e5cd1f9ac6356").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"commonCommit":"e0aee6aa543294d62520fb906689da6710af149c","aheadCount":1,"behindCount":0,"changes":[]}`)
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		BodyString(`[{"name":"refs/heads/main","oldObjectId":"e0aee6aa543294d62520fb906689da6710af149c","newObjectId":"01768d964c03e97260af0bd8cd9e5cd1f9ac6356"}]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref_update.json")
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "main",
+		Sha:    "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+		OldSha: "e0aee6aa543294d62520fb906689da6710af149c",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.UpdateRef(context.Background(), "REPOID", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Reference{
+		Name: "main",
+		Path: "refs/heads/main",
+		Sha:  "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitUpdateRef_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/diffs/commits").
+		MatchParam("baseVersion", "b748ab7eb49b8627214f22f631f878c4af9893b5").
+		MatchParam("targetVersion", "01768d964c03e97260af0bd8cd9e5cd1f9ac6356").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"commonCommit":"b748ab7eb49b8627214f22f631f878c4af9893b5","aheadCount":1,"behindCount":0,"changes":[]}`)
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref_update_stale.json")
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "main",
+		Sha:    "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+		OldSha: "b748ab7eb49b8627214f22f631f878c4af9893b5",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Git.UpdateRef(context.Background(), "REPOID", params)
+	if err != scm.ErrConflict {
+		t.Errorf("Expect ErrConflict, got %v", err)
+	}
+}
+
+func TestGitUpdateRef_NotFastForward(t *testing.T) {
+	defer gock.Off()
+
+	// the old object is not the merge base, so the new object
+	// does not descend from it, and the reference is not
+	// updated.
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/diffs/commits").
+		MatchParam("baseVersion", "e0aee6aa543294d62520fb906689da6710af149c").
+		MatchParam("targetVersion", "01768d964c03e97260af0bd8cd9e5cd1f9ac6356").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"commonCommit":"b748ab7eb49b8627214f22f631f878c4af9893b5","aheadCount":1,"behindCount":1,"changes":[]}`)
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "main",
+		Sha:    "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+		OldSha: "e0aee6aa543294d62520fb906689da6710af149c",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Git.UpdateRef(context.Background(), "REPOID", params)
+	if err != scm.ErrConflict {
+		t.Errorf("Expect ErrConflict, got %v", err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestGitUpdateRef_Force(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		BodyString(`[{"name":"refs/heads/main","oldObjectId":"e0aee6aa543294d62520fb906689da6710af149c","newObjectId":"01768d964c03e97260af0bd8cd9e5cd1f9ac6356"}]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref_update.json")
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "main",
+		Sha:    "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+		OldSha: "e0aee6aa543294d62520fb906689da6710af149c",
+		Force:  true,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Git.UpdateRef(context.Background(), "REPOID", params)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
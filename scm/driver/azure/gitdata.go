@@ -0,0 +1,186 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// gitDataService implements the GitDataService. Azure
+// does not support creating git objects, so commits are
+// created from the tree entries using a push.
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/trees/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/trees/%s?recursive=%t&api-version=6.0", s.client.owner, s.client.project, repo, sha, recursive)
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertTree(out), res, err
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Tree != "" || input.Branch == "" || len(input.Parents) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+
+	// the push is rejected if the branch is not at the
+	// parent commit. If a parent commit is not provided,
+	// a new branch is created.
+	parent := scm.EmptyCommit
+	exists := map[string]bool{}
+	if len(input.Parents) != 0 {
+		parent = input.Parents[0]
+		// azure requires the change type to match the state
+		// of the file, which is found in the tree of the
+		// parent commit.
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s?api-version=6.0", s.client.owner, s.client.project, repo, parent)
+		commit := new(gitCommit)
+		res, err := s.client.do(ctx, "GET", endpoint, nil, commit)
+		if err != nil {
+			return nil, res, err
+		}
+		current, res, err := s.FindTree(ctx, repo, commit.TreeID, true)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range current.Entries {
+			exists[v.Path] = true
+		}
+	}
+
+	com := pushCommit{
+		Comment: input.Message,
+		Changes: []change{},
+	}
+	if input.Author.Email != "" {
+		com.Author = &pushSignature{
+			Name:  input.Author.Name,
+			Email: input.Author.Email,
+		}
+	}
+	for _, v := range input.Entries {
+		if v.Sha != "" && !v.Delete {
+			// entries that reference an existing blob
+			// cannot be written using a push.
+			return nil, nil, scm.ErrNotSupported
+		}
+		cha := change{}
+		cha.Item.Path = v.Path
+		switch {
+		case v.Delete:
+			cha.ChangeType = "delete"
+		case exists[strings.TrimPrefix(v.Path, "/")]:
+			cha.ChangeType = "edit"
+		default:
+			cha.ChangeType = "add"
+		}
+		if !v.Delete {
+			cha.NewContent.Content = base64.StdEncoding.EncodeToString(v.Content)
+			cha.NewContent.ContentType = "base64encoded"
+		}
+		com.Changes = append(com.Changes, cha)
+	}
+	in := &pushInput{
+		RefUpdates: []refUpdate{{
+			Name:        scm.ExpandRef(input.Branch, "refs/heads"),
+			OldObjectID: parent,
+		}},
+		Commits: []pushCommit{com},
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pushes?api-version=6.0", s.client.owner, s.client.project, repo)
+	out := new(push)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Commits) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertCommit(out.Commits[0]), res, nil
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
+
+type tree struct {
+	ObjectID    string       `json:"objectId"`
+	TreeEntries []*treeEntry `json:"treeEntries"`
+	URL         string       `json:"url"`
+}
+
+type treeEntry struct {
+	ObjectID      string `json:"objectId"`
+	RelativePath  string `json:"relativePath"`
+	Mode          string `json:"mode"`
+	GitObjectType string `json:"gitObjectType"`
+	Size          int64  `json:"size"`
+}
+
+type pushInput struct {
+	RefUpdates []refUpdate  `json:"refUpdates"`
+	Commits    []pushCommit `json:"commits"`
+}
+
+type pushCommit struct {
+	Comment string         `json:"comment"`
+	Author  *pushSignature `json:"author,omitempty"`
+	Changes []change       `json:"changes"`
+}
+
+type pushSignature struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type push struct {
+	PushID  int          `json:"pushId"`
+	Commits []*gitCommit `json:"commits"`
+}
+
+func convertTree(from *tree) *scm.Tree {
+	to := &scm.Tree{
+		Sha:     from.ObjectID,
+		Entries: []*scm.TreeEntry{},
+	}
+	for _, v := range from.TreeEntries {
+		to.Entries = append(to.Entries, &scm.TreeEntry{
+			Path: v.RelativePath,
+			Mode: v.Mode,
+			Type: v.GitObjectType,
+			Sha:  v.ObjectID,
+			Size: v.Size,
+		})
+	}
+	return to
+}
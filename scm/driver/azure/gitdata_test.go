@@ -0,0 +1,103 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGitDataFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/trees/efbaf98cd9984e7480f600f8c4b592432a428518").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.GitData.FindTree(context.Background(), "REPOID", "efbaf98cd9984e7480f600f8c4b592432a428518", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDataCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/commits/14897f4465d2d63508242b5cbf68aa2865f693e7").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/trees/efbaf98cd9984e7480f600f8c4b592432a428518").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pushes").
+		BodyString(`{"refUpdates":[{"name":"refs/heads/main","oldObjectId":"14897f4465d2d63508242b5cbf68aa2865f693e7"}],"commits":[{"comment":"test message update tickles","author":{"name":"tp","email":"tp@harness.io"},"changes":[{"changeType":"edit","item":{"path":"README.md"},"newContent":{"content":"IyB0ZXN0","contentType":"base64encoded"}},{"changeType":"add","item":{"path":"docs/hello.md"},"newContent":{"content":"aGVsbG8=","contentType":"base64encoded"}},{"changeType":"delete","item":{"path":"docs/index.md"},"newContent":{}}]}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	input := &scm.GitCommitInput{
+		Message: "test message update tickles",
+		Parents: []string{"14897f4465d2d63508242b5cbf68aa2865f693e7"},
+		Branch:  "main",
+		Author: scm.Signature{
+			Name:  "tp",
+			Email: "tp@harness.io",
+		},
+		Entries: []*scm.TreeEntryInput{
+			{Path: "README.md", Content: []byte("# test")},
+			{Path: "docs/hello.md", Content: []byte("hello")},
+			{Path: "docs/index.md", Delete: true},
+		},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.GitData.CreateCommit(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/push.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// keyService implements the KeyService. Azure DevOps does
+// not support deploy keys, and user ssh keys are not
+// available using the rest api.
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
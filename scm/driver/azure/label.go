@@ -0,0 +1,59 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) AddIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) RemoveIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) SetIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
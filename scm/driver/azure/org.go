@@ -6,10 +6,16 @@ package azure
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// organizationService implements the OrganizationService.
+// Azure DevOps teams are managed in the project, and the
+// organization name is ignored. Team membership and
+// repository permissions are managed using security groups
+// and access control lists, which are not supported.
 type organizationService struct {
 	client *wrapper
 }
@@ -29,3 +35,152 @@ func (s *organizationService) ListMemberships(ctx context.Context, orgNameList [
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/get-teams?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams?%s", s.client.owner, s.client.project, encodeTeamListOptions(opts))
+	out := new(teamList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertTeamList(out.Value), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, id string) (*scm.Team, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams/%s?api-version=6.0", s.client.owner, s.client.project, id)
+	out := new(team)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams?api-version=6.0", s.client.owner, s.client.project)
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	out := new(team)
+	res, err := s.client.do(ctx, "POST", endpoint, in, &out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, id string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams/%s?api-version=6.0", s.client.owner, s.client.project, id)
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	out := new(team)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, &out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, id string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/delete?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams/%s?api-version=6.0", s.client.owner, s.client.project, id)
+	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, id string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/teams/get-team-members-with-extended-properties?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/_apis/projects/%s/teams/%s/members?%s", s.client.owner, s.client.project, id, encodeTeamListOptions(opts))
+	out := new(teamMemberList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertTeamMemberList(out.Value), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, id, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, id, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, id, repo string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, id, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+type teamList struct {
+	Value []*team `json:"value"`
+	Count int     `json:"count"`
+}
+
+type team struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type teamInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type teamMemberList struct {
+	Value []*teamMember `json:"value"`
+	Count int           `json:"count"`
+}
+
+type teamMember struct {
+	IsTeamAdmin bool `json:"isTeamAdmin"`
+	Identity    struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+		UniqueName  string `json:"uniqueName"`
+		ImageURL    string `json:"imageUrl"`
+	} `json:"identity"`
+}
+
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          from.ID,
+		Name:        from.Name,
+		Description: from.Description,
+	}
+}
+
+func convertTeamMemberList(from []*teamMember) []*scm.User {
+	to := []*scm.User{}
+	for _, v := range from {
+		to = append(to, &scm.User{
+			ID:     v.Identity.ID,
+			Login:  v.Identity.UniqueName,
+			Name:   v.Identity.DisplayName,
+			Email:  v.Identity.UniqueName,
+			Avatar: v.Identity.ImageURL,
+		})
+	}
+	return to
+}
@@ -0,0 +1,137 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestOrganizationListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects/PROJ/teams").
+		MatchParam("$top", "30").
+		MatchParam("$skip", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "ORG", scm.ListOptions{Page: 2, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/teams.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationFindTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects/PROJ/teams/564e8204-a90b-4432-883b-d4363c6125ca").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.FindTeam(context.Background(), "ORG", "564e8204-a90b-4432-883b-d4363c6125ca")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationCreateTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/_apis/projects/PROJ/teams").
+		BodyString(`{"name":"Quality assurance","description":"Testing staff"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	input := &scm.TeamInput{
+		Name:        "Quality assurance",
+		Description: "Testing staff",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.CreateTeam(context.Background(), "ORG", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/projects/PROJ/teams/564e8204-a90b-4432-883b-d4363c6125ca/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "ORG", "564e8204-a90b-4432-883b-d4363c6125ca", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.User{}
+	raw, _ := ioutil.ReadFile("testdata/members.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationTeamsProjectRequired(t *testing.T) {
+	client := NewDefault("ORG", "")
+	_, _, err := client.Organizations.ListTeams(context.Background(), "ORG", scm.ListOptions{})
+	if err == nil {
+		t.Errorf("Expect project required error")
+	}
+}
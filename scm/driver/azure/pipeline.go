@@ -0,0 +1,238 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// Azure Pipelines builds. The pipeline is identified by the
+// build definition id, and jobs are read from the build
+// timeline.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/queue?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	id, err := strconv.Atoi(input.Pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds?api-version=6.0", s.client.owner, s.client.project)
+	in := &buildInput{
+		SourceBranch:       scm.ExpandRef(input.Ref, "refs/heads"),
+		TemplateParameters: input.Inputs,
+	}
+	in.Definition.ID = id
+	out := new(build)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertBuild(out), res, err
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds?%s", s.client.owner, s.client.project, encodeBuildListOptions(repo, opts))
+	out := new(buildList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertBuildList(out.Value), res, err
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds/%s?api-version=6.0", s.client.owner, s.client.project, id)
+	out := new(build)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/timeline/get?view=azure-devops-rest-6.0
+	endpoint = fmt.Sprintf("%s/%s/_apis/build/builds/%s/timeline?api-version=6.0", s.client.owner, s.client.project, id)
+	timeline := new(buildTimeline)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, timeline)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertBuild(out)
+	run.Jobs = convertTimelineJobs(timeline.Records)
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/update-build?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds/%s?api-version=6.0", s.client.owner, s.client.project, id)
+	in := &buildUpdate{Status: "cancelling"}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/update-build?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds/%s?retry=true&api-version=6.0", s.client.owner, s.client.project, id)
+	return s.client.do(ctx, "PATCH", endpoint, nil, nil)
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/build/builds/get-build-log?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/build/builds/%s/logs/%s?api-version=6.0", s.client.owner, s.client.project, run, job)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
+type buildList struct {
+	Count int      `json:"count"`
+	Value []*build `json:"value"`
+}
+
+type build struct {
+	ID          int    `json:"id"`
+	BuildNumber string `json:"buildNumber"`
+	Status      string `json:"status"`
+	Result      string `json:"result"`
+	Reason      string `json:"reason"`
+	Definition  struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"definition"`
+	SourceBranch  string    `json:"sourceBranch"`
+	SourceVersion string    `json:"sourceVersion"`
+	QueueTime     time.Time `json:"queueTime"`
+	FinishTime    time.Time `json:"finishTime"`
+	Links         struct {
+		Web struct {
+			Href string `json:"href"`
+		} `json:"web"`
+	} `json:"_links"`
+}
+
+type buildInput struct {
+	Definition struct {
+		ID int `json:"id"`
+	} `json:"definition"`
+	SourceBranch       string            `json:"sourceBranch,omitempty"`
+	TemplateParameters map[string]string `json:"templateParameters,omitempty"`
+}
+
+type buildUpdate struct {
+	Status string `json:"status"`
+}
+
+type buildTimeline struct {
+	Records []*timelineRecord `json:"records"`
+}
+
+type timelineRecord struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	State      string    `json:"state"`
+	Result     string    `json:"result"`
+	StartTime  time.Time `json:"startTime"`
+	FinishTime time.Time `json:"finishTime"`
+	Log        *struct {
+		ID  int    `json:"id"`
+		URL string `json:"url"`
+	} `json:"log"`
+}
+
+func encodeBuildListOptions(repo string, opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	params.Set("api-version", "6.0")
+	params.Set("repositoryId", repo)
+	params.Set("repositoryType", "TfsGit")
+	if opts.Pipeline != "" {
+		params.Set("definitions", opts.Pipeline)
+	}
+	if opts.Ref != "" {
+		params.Set("branchName", scm.ExpandRef(opts.Ref, "refs/heads"))
+	}
+	if opts.Size != 0 {
+		params.Set("$top", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func convertBuildList(from []*build) []*scm.PipelineRun {
+	to := []*scm.PipelineRun{}
+	for _, v := range from {
+		to = append(to, convertBuild(v))
+	}
+	return to
+}
+
+func convertBuild(from *build) *scm.PipelineRun {
+	return &scm.PipelineRun{
+		ID:       strconv.Itoa(from.ID),
+		Number:   from.ID,
+		Pipeline: strconv.Itoa(from.Definition.ID),
+		Ref:      from.SourceBranch,
+		Sha:      from.SourceVersion,
+		Event:    from.Reason,
+		Status:   convertBuildStatus(from.Status, from.Result),
+		Link:     from.Links.Web.Href,
+		Created:  from.QueueTime,
+		Updated:  from.FinishTime,
+	}
+}
+
+func convertTimelineJobs(from []*timelineRecord) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from {
+		if v.Type != "Job" {
+			continue
+		}
+		// the job logs are referenced by the log id, which
+		// is used as the job identifier.
+		id := v.ID
+		if v.Log != nil {
+			id = strconv.Itoa(v.Log.ID)
+		}
+		to = append(to, &scm.PipelineJob{
+			ID:       id,
+			Name:     v.Name,
+			Status:   convertBuildStatus(v.State, v.Result),
+			Started:  v.StartTime,
+			Finished: v.FinishTime,
+		})
+	}
+	return to
+}
+
+// convertBuildStatus returns the execution status of a
+// build or timeline record. The result is only set once
+// the build is completed.
+func convertBuildStatus(status, result string) scm.ExecutionStatus {
+	if status == "completed" {
+		if result == "partiallySucceeded" {
+			return scm.StatusFailed
+		}
+		return scm.ConvertExecutionStatus(result)
+	}
+	return scm.ConvertExecutionStatus(status)
+}
@@ -0,0 +1,177 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/build/builds").
+		MatchParam("api-version", "6.0").
+		BodyString(`{"definition":{"id":7},"sourceBranch":"refs/heads/main","templateParameters":{"environment":"staging"}}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/build.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Pipelines.Trigger(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", &scm.PipelineInput{Pipeline: "7", Ref: "main", Inputs: map[string]string{"environment": "staging"}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/build.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/build/builds").
+		MatchParam("repositoryId", "fde2d21f-13b9-4864-a995-83329045289a").
+		MatchParam("repositoryType", "TfsGit").
+		MatchParam("definitions", "7").
+		MatchParam("branchName", "refs/heads/main").
+		MatchParam("$top", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/builds.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Pipelines.ListRuns(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", scm.PipelineListOptions{Pipeline: "7", Ref: "main", Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/builds.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/build/builds/1042$").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/build/builds/1042/timeline").
+		Reply(200).
+		Type("application/json").
+		File("testdata/build_timeline.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Pipelines.FindRun(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "1042")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/build_timeline.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/build/builds/1042").
+		BodyString(`{"status":"cancelling"}`).
+		Reply(204).
+		Type("application/json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Pipelines.CancelRun(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "1042")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestPipelineRetryRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/build/builds/1042").
+		MatchParam("retry", "true").
+		Reply(204).
+		Type("application/json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Pipelines.RetryRun(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "1042")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/build/builds/1042/logs/9").
+		Reply(200).
+		Type("text/plain").
+		BodyString("##[section]Starting: Test\n")
+
+	client := NewDefault("ORG", "PROJ")
+	rc, _, err := client.Pipelines.FindLogs(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "1042", "9")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "##[section]Starting: Test\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+}
+
+func TestPipelineProjectRequired(t *testing.T) {
+	client := NewDefault("ORG", "")
+	_, _, err := client.Pipelines.ListRuns(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", scm.PipelineListOptions{})
+	if err == nil {
+		t.Errorf("Expect project required error")
+	}
+}
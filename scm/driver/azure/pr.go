@@ -6,7 +6,10 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -44,13 +47,151 @@ func (s *pullService) ListCommits(ctx context.Context, repo string, number int,
 }
 
 func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	return s.MergeWithOptions(ctx, repo, number, scm.PullRequestMergeOptions{})
+}
+
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	in := &prMergeInput{Status: "completed"}
+	in.LastMergeSourceCommit.CommitID = opts.SHA
+	in.CompletionOptions.DeleteSourceBranch = opts.DeleteSourceBranch
+	in.CompletionOptions.MergeCommitMessage = opts.CommitTitle
+	if opts.CommitMessage != "" {
+		if in.CompletionOptions.MergeCommitMessage != "" {
+			in.CompletionOptions.MergeCommitMessage += "\n\n"
+		}
+		in.CompletionOptions.MergeCommitMessage += opts.CommitMessage
+	}
+	switch opts.Method {
+	case scm.MergeMethodMerge:
+		in.CompletionOptions.MergeStrategy = "noFastForward"
+	case scm.MergeMethodSquash:
+		in.CompletionOptions.MergeStrategy = "squash"
+	case scm.MergeMethodRebase:
+		in.CompletionOptions.MergeStrategy = "rebase"
+	case scm.MergeMethodFastForward:
+		return nil, &scm.MergeMethodError{Method: opts.Method}
+	}
+	// azure requires the head of the pull request to
+	// complete the pull request.
+	if in.LastMergeSourceCommit.CommitID == "" {
+		pr, res, err := s.Find(ctx, repo, number)
+		if err != nil {
+			return res, err
+		}
+		in.LastMergeSourceCommit.CommitID = pr.Sha
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	return res, nil
 }
 
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &prUpdateInput{Status: "active"}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &prUpdateInput{
+		Title:       input.Title,
+		Description: input.Body,
+		IsDraft:     input.Draft,
+	}
+	if input.Target != "" {
+		in.TargetRefName = scm.ExpandRef(input.Target, "refs/heads")
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-reviewers/create-pull-request-reviewer?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	var res *scm.Response
+	var err error
+	// users and teams are both identified by the
+	// identity id.
+	for _, id := range reviewerIDs(input) {
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers/%s?api-version=6.0",
+			s.client.owner, s.client.project, repo, number, id)
+		in := &reviewerInput{Vote: 0}
+		res, err = s.client.do(ctx, "PUT", endpoint, in, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-reviewers/delete?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	var res *scm.Response
+	var err error
+	for _, id := range reviewerIDs(input) {
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/reviewers/%s?api-version=6.0",
+			s.client.owner, s.client.project, repo, number, id)
+		res, err = s.client.do(ctx, "DELETE", endpoint, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return pr.Reviewers, res, nil
+}
+
+func (s *pullService) SetAssignees(ctx context.Context, repo string, number int, users []string) (*scm.Response, error) {
+	// azure does not support pull request assignees.
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-6.0
 	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests?api-version=6.0", s.client.owner, s.client.project, repo)
@@ -75,6 +216,41 @@ type prInput struct {
 	} `json:"reviewers"`
 }
 
+type prUpdateInput struct {
+	Title         string `json:"title,omitempty"`
+	Description   string `json:"description,omitempty"`
+	TargetRefName string `json:"targetRefName,omitempty"`
+	IsDraft       *bool  `json:"isDraft,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+type reviewerInput struct {
+	Vote int `json:"vote"`
+}
+
+type reviewer struct {
+	ReviewerURL string `json:"reviewerUrl"`
+	Vote        int    `json:"vote"`
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	URL         string `json:"url"`
+	ImageURL    string `json:"imageUrl"`
+	IsContainer bool   `json:"isContainer"`
+}
+
+type prMergeInput struct {
+	Status                string `json:"status"`
+	LastMergeSourceCommit struct {
+		CommitID string `json:"commitId"`
+	} `json:"lastMergeSourceCommit"`
+	CompletionOptions struct {
+		DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+		MergeCommitMessage string `json:"mergeCommitMessage,omitempty"`
+		MergeStrategy      string `json:"mergeStrategy,omitempty"`
+	} `json:"completionOptions"`
+}
+
 type pr struct {
 	Repository struct {
 		ID      string `json:"id"`
@@ -117,17 +293,9 @@ type pr struct {
 		CommitID string `json:"commitId"`
 		URL      string `json:"url"`
 	} `json:"lastMergeTargetCommit"`
-	Reviewers []struct {
-		ReviewerURL string `json:"reviewerUrl"`
-		Vote        int    `json:"vote"`
-		ID          string `json:"id"`
-		DisplayName string `json:"displayName"`
-		UniqueName  string `json:"uniqueName"`
-		URL         string `json:"url"`
-		ImageURL    string `json:"imageUrl"`
-	} `json:"reviewers"`
-	URL   string `json:"url"`
-	Links struct {
+	Reviewers []*reviewer `json:"reviewers"`
+	URL       string      `json:"url"`
+	Links     struct {
 		Self struct {
 			Href string `json:"href"`
 		} `json:"self"`
@@ -161,7 +329,7 @@ type pr struct {
 }
 
 func convertPullRequest(from *pr) *scm.PullRequest {
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number: from.PullRequestID,
 		Title:  from.Title,
 		Body:   from.Description,
@@ -186,4 +354,55 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		},
 		Created: from.CreationDate,
 	}
+	for _, v := range from.Reviewers {
+		to.Reviewers = append(to.Reviewers, convertReviewer(v))
+	}
+	return to
+}
+
+// reviewerIDs returns the identity ids of the users and
+// teams.
+func reviewerIDs(from *scm.ReviewerInput) []string {
+	var to []string
+	to = append(to, from.Users...)
+	return append(to, from.Teams...)
+}
+
+// convertReviewer returns the reviewer with the review state
+// derived from the vote. Reviewers that are groups or teams
+// are identified by the display name.
+func convertReviewer(from *reviewer) scm.Reviewer {
+	to := scm.Reviewer{}
+	if from.IsContainer {
+		to.Team = from.DisplayName
+	} else {
+		to.User = scm.User{
+			ID:     from.ID,
+			Login:  from.UniqueName,
+			Name:   from.DisplayName,
+			Avatar: from.ImageURL,
+		}
+	}
+	switch {
+	case from.Vote > 0:
+		to.State = scm.ReviewStateApproved
+	case from.Vote < 0:
+		to.State = scm.ReviewStateChangesRequested
+	default:
+		to.State = scm.ReviewStatePending
+	}
+	return to
+}
+
+// convertMergeError returns a MergeMethodError if the pull
+// request could not be completed because the merge strategy
+// is not allowed by the branch policies.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		apiErr.Status == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "merge strategy") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
 }
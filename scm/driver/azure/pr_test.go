@@ -7,6 +7,7 @@ package azure
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -49,6 +50,59 @@ func TestPullCreate(t *testing.T) {
 	}
 }
 
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]interface{}{
+			"targetRefName": "refs/heads/main",
+			"isDraft":       false,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	draft := false
+	input := scm.PullRequestUpdateInput{
+		Target: "main",
+		Draft:  &draft,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.PullRequests.Update(context.Background(), "REPOID", 1, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]string{"status": "active"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.Reopen(context.Background(), "REPOID", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestPullFind(t *testing.T) {
 	defer gock.Off()
 
@@ -100,3 +154,114 @@ func TestPullListCommits(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]interface{}{
+			"status": "completed",
+			"lastMergeSourceCommit": map[string]string{
+				"commitId": "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+			},
+			"completionOptions": map[string]interface{}{
+				"deleteSourceBranch": true,
+				"mergeCommitMessage": "Merged PR 1: test_pr",
+				"mergeStrategy":      "squash",
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Merged PR 1: test_pr",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "REPOID", 1, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request completed")
+	}
+}
+
+func TestPullMergeWithOptions_NotAllowed(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "REPOID", 1, scm.PullRequestMergeOptions{Method: scm.MergeMethodFastForward})
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Put("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers/d6245f20-2af8-44f4-9451-8107cb2767db").
+		JSON(map[string]int{"vote": 0}).
+		Reply(200).
+		Type("application/json")
+
+	gock.New("https:/dev.azure.com/").
+		Put("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers/6f9e6f4c-b0c6-4e5d-a1a2-2a0ce9e5f8b4").
+		JSON(map[string]int{"vote": 0}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"d6245f20-2af8-44f4-9451-8107cb2767db"},
+		Teams: []string{"6f9e6f4c-b0c6-4e5d-a1a2-2a0ce9e5f8b4"},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers added")
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/reviewers/d6245f20-2af8-44f4-9451-8107cb2767db").
+		Reply(204)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"d6245f20-2af8-44f4-9451-8107cb2767db"},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers removed")
+	}
+}
+
+func TestPullSetAssignees(t *testing.T) {
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.PullRequests.SetAssignees(context.Background(), "REPOID", 1, []string{"d6245f20-2af8-44f4-9451-8107cb2767db"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
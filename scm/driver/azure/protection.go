@@ -0,0 +1,269 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// azure branch policy types.
+const (
+	policyMinimumReviewers = "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"
+	policyStatus           = "cbdc66da-9728-4af8-aada-9a5a32e4a226"
+)
+
+// protectionService implements the BranchProtectionService
+// using Azure DevOps branch policies. The repository must be
+// identified by id. A branch pattern ending with a wildcard
+// matches the branch prefix. Push, merge, force push and
+// deletion rules are managed using git permissions, and are
+// not supported.
+type protectionService struct {
+	client *wrapper
+}
+
+type policyConfigurations struct {
+	Count int                    `json:"count"`
+	Value []*policyConfiguration `json:"value"`
+}
+
+type policyConfiguration struct {
+	ID         int            `json:"id,omitempty"`
+	IsEnabled  bool           `json:"isEnabled"`
+	IsBlocking bool           `json:"isBlocking"`
+	Type       policyType     `json:"type"`
+	Settings   policySettings `json:"settings"`
+}
+
+type policyType struct {
+	ID string `json:"id"`
+}
+
+type policySettings struct {
+	MinimumApproverCount int            `json:"minimumApproverCount,omitempty"`
+	ResetOnSourcePush    bool           `json:"resetOnSourcePush,omitempty"`
+	StatusName           string         `json:"statusName,omitempty"`
+	StatusGenre          string         `json:"statusGenre,omitempty"`
+	Scope                []*policyScope `json:"scope"`
+}
+
+type policyScope struct {
+	RepositoryID string `json:"repositoryId"`
+	RefName      string `json:"refName"`
+	MatchKind    string `json:"matchKind"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	out, res, err := s.list(ctx, repo, branch)
+	return convertPolicies(branch, out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/configurations/update?view=azure-devops-rest-6.0
+	existing, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return nil, res, err
+	}
+	current := map[string]*policyConfiguration{}
+	for _, v := range existing {
+		if _, ok := current[policyKey(v)]; !ok {
+			current[policyKey(v)] = v
+		}
+	}
+
+	// undo holds the requests that revert the changes made
+	// so far, in the order they were made.
+	var undo []func()
+	revert := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/_apis/policy/configurations", s.client.owner, s.client.project)
+	kept := map[int]bool{}
+	out := []*policyConfiguration{}
+	for _, in := range convertFromBranchProtection(repo, branch, input) {
+		saved := new(policyConfiguration)
+		if prev, ok := current[policyKey(in)]; ok {
+			delete(current, policyKey(in))
+			kept[prev.ID] = true
+			in.ID = prev.ID
+			res, err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d?api-version=6.0", endpoint, prev.ID), in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d?api-version=6.0", endpoint, prev.ID), prev, nil)
+			})
+		} else {
+			res, err = s.client.do(ctx, "POST", endpoint+"?api-version=6.0", in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d?api-version=6.0", endpoint, saved.ID), nil, nil)
+			})
+		}
+		out = append(out, saved)
+	}
+
+	// the remaining policies are deleted once the new policies
+	// are saved, so that the branch is never unprotected.
+	for _, v := range existing {
+		if kept[v.ID] {
+			continue
+		}
+		prev := v
+		res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d?api-version=6.0", endpoint, prev.ID), nil, nil)
+		if err != nil {
+			revert()
+			return nil, res, err
+		}
+		undo = append(undo, func() {
+			in := *prev
+			in.ID = 0
+			s.client.do(ctx, "POST", endpoint+"?api-version=6.0", &in, nil)
+		})
+	}
+	return convertPolicies(branch, out), res, nil
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/configurations/delete?view=azure-devops-rest-6.0
+	out, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out {
+		endpoint := fmt.Sprintf("%s/%s/_apis/policy/configurations/%d?api-version=6.0", s.client.owner, s.client.project, v.ID)
+		res, err = s.client.do(ctx, "DELETE", endpoint, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// list returns the supported policy configurations for the
+// branch.
+func (s *protectionService) list(ctx context.Context, repo, branch string) ([]*policyConfiguration, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/policy-configurations/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	scope := convertPolicyScope(repo, branch)
+	params := url.Values{}
+	params.Set("repositoryId", repo)
+	params.Set("refName", scope.RefName)
+	params.Set("api-version", "6.0-preview.1")
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/policy/configurations?%s", s.client.owner, s.client.project, params.Encode())
+	out := new(policyConfigurations)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	var to []*policyConfiguration
+	for _, v := range out.Value {
+		switch v.Type.ID {
+		case policyMinimumReviewers, policyStatus:
+			to = append(to, v)
+		}
+	}
+	return to, res, nil
+}
+
+// policyKey returns the key that identifies the policy
+// configuration of the branch, which is the policy type and
+// the status name for status policies.
+func policyKey(from *policyConfiguration) string {
+	if from.Type.ID == policyStatus {
+		return from.Type.ID + "/" + from.Settings.StatusGenre + "/" + from.Settings.StatusName
+	}
+	return from.Type.ID
+}
+
+func convertPolicyScope(repo, branch string) *policyScope {
+	if strings.HasSuffix(branch, "*") {
+		return &policyScope{
+			RepositoryID: repo,
+			RefName:      scm.ExpandRef(strings.TrimSuffix(branch, "*"), "refs/heads"),
+			MatchKind:    "prefix",
+		}
+	}
+	return &policyScope{
+		RepositoryID: repo,
+		RefName:      scm.ExpandRef(branch, "refs/heads"),
+		MatchKind:    "exact",
+	}
+}
+
+func convertPolicies(branch string, from []*policyConfiguration) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch: branch,
+	}
+	for _, v := range from {
+		if !v.IsEnabled || !v.IsBlocking {
+			continue
+		}
+		switch v.Type.ID {
+		case policyMinimumReviewers:
+			to.RequiredReviews = &scm.RequiredReviews{
+				Count:        v.Settings.MinimumApproverCount,
+				DismissStale: v.Settings.ResetOnSourcePush,
+			}
+		case policyStatus:
+			if to.RequiredStatusChecks == nil {
+				to.RequiredStatusChecks = new(scm.RequiredStatusChecks)
+			}
+			name := convertCheckName(gitStatusContext{
+				Name:  v.Settings.StatusName,
+				Genre: v.Settings.StatusGenre,
+			})
+			to.RequiredStatusChecks.Contexts = append(to.RequiredStatusChecks.Contexts, name)
+		}
+	}
+	return to
+}
+
+func convertFromBranchProtection(repo, branch string, from *scm.BranchProtectionInput) []*policyConfiguration {
+	var to []*policyConfiguration
+	scope := []*policyScope{convertPolicyScope(repo, branch)}
+	if v := from.RequiredReviews; v != nil {
+		to = append(to, &policyConfiguration{
+			IsEnabled:  true,
+			IsBlocking: true,
+			Type:       policyType{ID: policyMinimumReviewers},
+			Settings: policySettings{
+				MinimumApproverCount: v.Count,
+				ResetOnSourcePush:    v.DismissStale,
+				Scope:                scope,
+			},
+		})
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		for _, name := range v.Contexts {
+			status := convertFromCheckName(name)
+			to = append(to, &policyConfiguration{
+				IsEnabled:  true,
+				IsBlocking: true,
+				Type:       policyType{ID: policyStatus},
+				Settings: policySettings{
+					StatusName:  status.Name,
+					StatusGenre: status.Genre,
+					Scope:       scope,
+				},
+			})
+		}
+	}
+	return to
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/policy/configurations").
+		MatchParam("repositoryId", "fde2d21f-13b9-4864-a995-83329045289a").
+		MatchParam("refName", "refs/heads/main").
+		Reply(200).
+		Type("application/json").
+		File("testdata/policies.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.BranchProtections.Find(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "main")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/policies.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/policy/configurations").
+		Reply(200).
+		Type("application/json").
+		File("testdata/policies.json")
+
+	gock.New("https:/dev.azure.com/").
+		Put("/ORG/PROJ/_apis/policy/configurations/1").
+		JSON(map[string]interface{}{
+			"id":         1,
+			"isEnabled":  true,
+			"isBlocking": true,
+			"type":       map[string]string{"id": "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"},
+			"settings": map[string]interface{}{
+				"minimumApproverCount": 1,
+				"scope": []map[string]string{
+					{
+						"repositoryId": "fde2d21f-13b9-4864-a995-83329045289a",
+						"refName":      "refs/heads/release/",
+						"matchKind":    "prefix",
+					},
+				},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":1,"isEnabled":true,"isBlocking":true,"type":{"id":"fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"},"settings":{"minimumApproverCount":1}}`)
+
+	// the status policy is no longer required, and is deleted
+	// after the reviewer policy is updated.
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/policy/configurations/2").
+		Reply(204)
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{Count: 1},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.BranchProtections.Update(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "release/*", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{
+		Branch:          "release/*",
+		RequiredReviews: &scm.RequiredReviews{Count: 1},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch policies updated")
+	}
+}
+
+func TestProtectionUpdate_Revert(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/policy/configurations").
+		Reply(200).
+		Type("application/json").
+		File("testdata/policies.json")
+
+	gock.New("https:/dev.azure.com/").
+		Put("/ORG/PROJ/_apis/policy/configurations/1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":1,"isEnabled":true,"isBlocking":true,"type":{"id":"fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"},"settings":{"minimumApproverCount":1}}`)
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/policy/configurations").
+		Reply(500).
+		Type("application/json").
+		BodyString(`{"message":"internal error"}`)
+
+	// the reviewer policy is restored, and the status policy
+	// is never deleted.
+	restored := gock.NewMatcher()
+	restored.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		raw, _ := ioutil.ReadAll(req.Body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(raw))
+		return strings.Contains(string(raw), `"minimumApproverCount":2`), nil
+	})
+	gock.New("https:/dev.azure.com/").
+		Put("/ORG/PROJ/_apis/policy/configurations/1").
+		SetMatcher(restored).
+		Reply(200)
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{Count: 1},
+		RequiredStatusChecks: &scm.RequiredStatusChecks{
+			Contexts: []string{"lint"},
+		},
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.BranchProtections.Update(context.Background(), "fde2d21f-13b9-4864-a995-83329045289a", "main", input)
+	if err == nil {
+		t.Errorf("Expect error when the status policy is not created")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch policies restored")
+	}
+}
@@ -165,6 +165,138 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
 }
 
+// Create creates a new repository in the project. The
+// visibility is inherited from the project.
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories?api-version=6.0", s.client.owner, s.client.project)
+	in := &repositoryInput{Name: input.Name}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil || !input.AutoInit {
+		return convertRepository(out, s.client.owner), res, err
+	}
+
+	// azure creates empty repositories, so a readme is
+	// pushed to create the default branch.
+	branch := input.Branch
+	if branch == "" {
+		branch = "main"
+	}
+	_, res, err = s.client.Contents.Commit(ctx, out.ID, &scm.CommitInput{
+		Branch:  branch,
+		Message: "Initial commit",
+		Parent:  scm.EmptyCommit,
+		Actions: []*scm.CommitAction{{
+			Action: scm.FileActionCreate,
+			Path:   "README.md",
+			Data:   []byte("# " + input.Name + "\n"),
+		}},
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	out.DefaultBranch = scm.ExpandRef(branch, "refs/heads")
+	return convertRepository(out, s.client.owner), res, nil
+}
+
+// Fork forks a repository. The fork is created in the
+// project named by the namespace, which defaults to the
+// client project.
+func (s *RepositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	parent := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, parent)
+	if err != nil {
+		return nil, res, err
+	}
+
+	project := s.client.project
+	if input.Namespace != "" {
+		project = input.Namespace
+	}
+	in := &repositoryInput{
+		Name:             input.Name,
+		ParentRepository: &parentRepository{ID: parent.ID},
+	}
+	in.ParentRepository.Project.ID = parent.Project.ID
+	if in.Name == "" {
+		in.Name = parent.Name
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories?api-version=6.0", s.client.owner, project)
+	out := new(repository)
+	res, err = s.client.do(ctx, "POST", endpoint, in, out)
+	return convertRepository(out, s.client.owner), res, err
+}
+
+// Update updates a repository. Azure repositories do not
+// have a description, visibility or archived state.
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.Description != "" || input.Visibility != scm.VisibilityUndefined || input.Archived != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	in := &repositoryUpdateInput{Name: input.Name}
+	if input.Branch != "" {
+		in.DefaultBranch = scm.ExpandRef(input.Branch, "refs/heads")
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, out)
+	return convertRepository(out, s.client.owner), res, err
+}
+
+// Delete deletes a repository.
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/repositories/delete?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	// the repository is deleted by id, which is found by
+	// name.
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	out := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return res, err
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, out.ID)
+	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
+}
+
+// Transfer transfers a repository to a project.
+func (s *RepositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListCollaborators returns a list of repository collaborators.
+func (s *RepositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// AddCollaborator adds a collaborator to the repository.
+func (s *RepositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// RemoveCollaborator removes a collaborator from the repository.
+func (s *RepositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // helper function to return the projectID from the project name
 func (s *RepositoryService) getProjectIDFromProjectName(ctx context.Context, projectName string) (string, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/projects/list?view=azure-devops-rest-6.0
@@ -219,6 +351,23 @@ type repository struct {
 	URL       string `json:"url"`
 }
 
+type repositoryInput struct {
+	Name             string            `json:"name"`
+	ParentRepository *parentRepository `json:"parentRepository,omitempty"`
+}
+
+type parentRepository struct {
+	ID      string `json:"id"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string `json:"name,omitempty"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+}
+
 type subscriptions struct {
 	Count int64           `json:"count"`
 	Value []*subscription `json:"value"`
@@ -170,3 +170,146 @@ func TestRepositoryFind(t *testing.T) {
 	}
 
 }
+
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories").
+		BodyString(`{"name":"test_project"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/91f0d4cb-4c36-49a5-b28d-2d72da089c4d/pushes").
+		BodyString(`{"refUpdates":[{"name":"refs/heads/main","oldObjectId":"0000000000000000000000000000000000000000"}],"commits":[{"comment":"Initial commit","changes":[{"changeType":"add","item":{"path":"README.md"},"newContent":{"content":"IyB0ZXN0X3Byb2plY3QK","contentType":"base64encoded"}}]}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/content_create.json")
+
+	input := &scm.RepositoryInput{
+		Name:     "test_project",
+		Branch:   "main",
+		AutoInit: true,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/FORKS/_apis/git/repositories").
+		BodyString(`{"name":"test_project","parentRepository":{"id":"91f0d4cb-4c36-49a5-b28d-2d72da089c4d","project":{"id":"d350c9c0-7749-4ff8-a78f-f9c1f0e56729"}}}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.Fork(context.Background(), "test_project", &scm.ForkInput{Namespace: "FORKS"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID").
+		BodyString(`{"name":"test_project","defaultBranch":"refs/heads/main"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryUpdateInput{
+		Name:   "test_project",
+		Branch: "main",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.Update(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate_Archived(t *testing.T) {
+	archived := true
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Repositories.Update(context.Background(), "REPOID", &scm.RepositoryUpdateInput{Archived: &archived})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/91f0d4cb-4c36-49a5-b28d-2d72da089c4d").
+		Reply(204)
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Repositories.Delete(context.Background(), "test_project")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
@@ -6,6 +6,10 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -23,9 +27,243 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &threadInput{
+		Comments: []*threadCommentInput{
+			{Content: input.Body, CommentType: 1},
+		},
+		Status:        "active",
+		ThreadContext: convertThreadContext(input),
+	}
+	out := new(thread)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertThread(out).Comments[0], res, nil
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	out := new(threadList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertThreadList(out.Value), res, err
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-thread-comments/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%s/comments?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, thread)
+	// the first comment of the thread has the id 1.
+	in := &threadCommentInput{
+		ParentCommentID: 1,
+		Content:         input.Body,
+		CommentType:     1,
+	}
+	out := new(threadComment)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertThreadComment(out), res, err
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.updateThreadStatus(ctx, repo, number, thread, "fixed")
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.updateThreadStatus(ctx, repo, number, thread, "active")
+}
+
+func (s *reviewService) updateThreadStatus(ctx context.Context, repo string, number int, thread, status string) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%s?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, thread)
+	in := &threadStatusInput{Status: status}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+type threadInput struct {
+	Comments      []*threadCommentInput `json:"comments"`
+	Status        string                `json:"status"`
+	ThreadContext *threadContext        `json:"threadContext,omitempty"`
+}
+
+type threadCommentInput struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     int    `json:"commentType"`
+}
+
+type threadStatusInput struct {
+	Status string `json:"status"`
+}
+
+type threadList struct {
+	Value []*thread `json:"value"`
+	Count int       `json:"count"`
+}
+
+type thread struct {
+	ID            int              `json:"id"`
+	Status        string           `json:"status"`
+	ThreadContext *threadContext   `json:"threadContext"`
+	Comments      []*threadComment `json:"comments"`
+	IsDeleted     bool             `json:"isDeleted"`
+}
+
+type threadContext struct {
+	FilePath       string        `json:"filePath"`
+	LeftFileStart  *filePosition `json:"leftFileStart,omitempty"`
+	LeftFileEnd    *filePosition `json:"leftFileEnd,omitempty"`
+	RightFileStart *filePosition `json:"rightFileStart,omitempty"`
+	RightFileEnd   *filePosition `json:"rightFileEnd,omitempty"`
+}
+
+type filePosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
+}
+
+type threadComment struct {
+	ID              int    `json:"id"`
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     string `json:"commentType"`
+	IsDeleted       bool   `json:"isDeleted"`
+	Author          struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+		UniqueName  string `json:"uniqueName"`
+		ImageURL    string `json:"imageUrl"`
+	} `json:"author"`
+	PublishedDate   time.Time `json:"publishedDate"`
+	LastUpdatedDate time.Time `json:"lastUpdatedDate"`
+}
+
+// convertThreadContext returns the thread context for the
+// review comment. Lines start at offset 1 on both the left
+// and right side of the diff.
+func convertThreadContext(from *scm.ReviewInput) *threadContext {
+	start := from.StartLine
+	if start == 0 {
+		start = from.Line
+	}
+	to := &threadContext{
+		FilePath: "/" + strings.TrimPrefix(from.Path, "/"),
+	}
+	if from.Side == scm.ReviewSideLeft {
+		to.LeftFileStart = &filePosition{Line: start, Offset: 1}
+		to.LeftFileEnd = &filePosition{Line: from.Line, Offset: 1}
+	} else {
+		to.RightFileStart = &filePosition{Line: start, Offset: 1}
+		to.RightFileEnd = &filePosition{Line: from.Line, Offset: 1}
+	}
+	return to
+}
+
+// convertThreadList returns the review threads on the diff.
+// Deleted threads and threads without file context, such as
+// system threads and pull request comments, are skipped.
+func convertThreadList(from []*thread) []*scm.ReviewThread {
+	to := []*scm.ReviewThread{}
+	for _, v := range from {
+		if v.IsDeleted || v.ThreadContext == nil {
+			continue
+		}
+		to = append(to, convertThread(v))
+	}
+	return to
+}
+
+func convertThread(from *thread) *scm.ReviewThread {
+	to := &scm.ReviewThread{
+		ID:       strconv.Itoa(from.ID),
+		Resolved: convertThreadResolved(from.Status),
+		Comments: []*scm.Review{},
+	}
+	if ctx := from.ThreadContext; ctx != nil {
+		to.Path = strings.TrimPrefix(ctx.FilePath, "/")
+		var start, end *filePosition
+		if ctx.RightFileEnd != nil {
+			start, end = ctx.RightFileStart, ctx.RightFileEnd
+			to.Side = scm.ReviewSideRight
+		} else if ctx.LeftFileEnd != nil {
+			start, end = ctx.LeftFileStart, ctx.LeftFileEnd
+			to.Side = scm.ReviewSideLeft
+		}
+		if end != nil {
+			to.Line = end.Line
+		}
+		if start != nil && start.Line != to.Line {
+			to.StartLine = start.Line
+		}
+	}
+	for _, v := range from.Comments {
+		if v.IsDeleted {
+			continue
+		}
+		comment := convertThreadComment(v)
+		comment.Path = to.Path
+		comment.Line = to.Line
+		comment.StartLine = to.StartLine
+		comment.Side = to.Side
+		to.Comments = append(to.Comments, comment)
+	}
+	return to
+}
+
+func convertThreadComment(from *threadComment) *scm.Review {
+	return &scm.Review{
+		ID:   from.ID,
+		Body: from.Content,
+		Author: scm.User{
+			Login:  from.Author.UniqueName,
+			Name:   from.Author.DisplayName,
+			Avatar: from.Author.ImageURL,
+		},
+		Created: from.PublishedDate,
+		Updated: from.LastUpdatedDate,
+	}
+}
+
+// convertThreadResolved returns true if the thread status
+// is a resolved status.
+func convertThreadResolved(status string) bool {
+	switch status {
+	case "fixed", "wontFix", "closed", "byDesign":
+		return true
+	default:
+		return false
+	}
+}
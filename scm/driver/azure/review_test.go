@@ -0,0 +1,158 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		JSON(map[string]interface{}{
+			"comments": []map[string]interface{}{
+				{"parentCommentId": 0, "content": "Nit: typo", "commentType": 1},
+			},
+			"status": "active",
+			"threadContext": map[string]interface{}{
+				"filePath":       "/README.md",
+				"rightFileStart": map[string]int{"line": 10, "offset": 1},
+				"rightFileEnd":   map[string]int{"line": 12, "offset": 1},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Nit: typo",
+		Path:      "README.md",
+		Line:      12,
+		StartLine: 10,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Create(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/thread.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		Reply(200).
+		Type("application/json").
+		File("testdata/threads.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.ListThreads(context.Background(), "REPOID", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/threads.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/148/comments").
+		JSON(map[string]interface{}{
+			"parentCommentId": 1,
+			"content":         "Fixed, thanks",
+			"commentType":     1,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread_comment.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Reply(context.Background(), "REPOID", 1, "148", &scm.CommentInput{Body: "Fixed, thanks"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/thread_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/148").
+		JSON(map[string]string{"status": "fixed"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Reviews.Resolve(context.Background(), "REPOID", 1, "148")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect thread resolved")
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/148").
+		JSON(map[string]string{"status": "active"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Reviews.Unresolve(context.Background(), "REPOID", 1, "148")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect thread unresolved")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, org, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -21,3 +21,15 @@ func encodeListOptions(opts scm.ListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeTeamListOptions(opts scm.ListOptions) string {
+	params := url.Values{}
+	params.Set("api-version", "6.0")
+	if opts.Size != 0 {
+		params.Set("$top", strconv.Itoa(opts.Size))
+	}
+	if opts.Page > 1 {
+		params.Set("$skip", strconv.Itoa((opts.Page-1)*opts.Size))
+	}
+	return params.Encode()
+}
@@ -10,8 +10,10 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -32,15 +34,23 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverBitbucket
 	client.Linker = &linker{"https://bitbucket.org/"}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -129,6 +139,19 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 			req.Header = map[string][]string{
 				"Content-Type": {writer.FormDataContentType()},
 			}
+		case *srcCommitInput:
+			body, contentType := content.encode()
+			req.Body = body
+			req.Header = map[string][]string{
+				"Content-Type": {contentType},
+			}
+		case *downloadInput:
+			body, contentType, size := content.encode()
+			req.Body = body
+			req.ContentLength = size
+			req.Header = map[string][]string{
+				"Content-Type": {contentType},
+			}
 		default:
 			buf := new(bytes.Buffer)
 			json.NewEncoder(buf).Encode(in)
@@ -146,14 +169,16 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// parse the bitbucket request id.
+	res.ID = res.Header.Get("X-Request-Uuid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
 	// if an error is encountered, unmarshal and return the
 	// error response.
-	if res.Status == 401 {
-		return res, scm.ErrNotAuthorized
-	} else if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+	if res.Status > 300 {
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -172,6 +197,90 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the bitbucket request id.
+	res.ID = res.Header.Get("X-Request-Uuid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// streamRedirect wraps the Client.Do function and returns the
+// response body without reading it. A redirect is not followed
+// with the client credentials, and the redirect location is
+// requested with the download function.
+func (c *wrapper) streamRedirect(ctx context.Context, req *scm.Request) (io.ReadCloser, *scm.Response, error) {
+	req.NoRedirect = true
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the bitbucket request id.
+	res.ID = res.Header.Get("X-Request-Uuid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	if location := res.Header.Get("Location"); res.Status >= 300 && res.Status < 400 && location != "" {
+		res.Body.Close()
+		return c.download(ctx, location)
+	}
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// download streams the file at the given url. The request is
+// authenticated only if the url has the same origin as the
+// API, so that credentials are never sent to a third-party
+// host. Other urls are requested without credentials using
+// the default http client.
+func (c *wrapper) download(ctx context.Context, rawurl string) (io.ReadCloser, *scm.Response, error) {
+	uri, err := c.BaseURL.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uri.Scheme == c.BaseURL.Scheme && uri.Host == c.BaseURL.Host {
+		return c.stream(ctx, "GET", rawurl)
+	}
+	client := &scm.Client{BaseURL: uri}
+	res, err := client.Do(ctx, &scm.Request{Method: "GET", Path: uri.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -190,10 +299,47 @@ type link struct {
 type Error struct {
 	Type string `json:"type"`
 	Data struct {
-		Message string `json:"message"`
+		Message string              `json:"message"`
+		Detail  string              `json:"detail"`
+		Fields  map[string][]string `json:"fields"`
 	} `json:"error"`
 }
 
 func (e *Error) Error() string {
 	return e.Data.Message
 }
+
+// newError returns an API error from the bitbucket error
+// response. An unauthorized response returns the
+// scm.ErrNotAuthorized error, which callers compare
+// by equality.
+func newError(res *scm.Response) error {
+	if res.Status == 401 {
+		return scm.ErrNotAuthorized
+	}
+	raw, _ := ioutil.ReadAll(res.Body)
+	to := &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Header:    res.Header,
+		Raw:       raw,
+	}
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	to.Message = from.Data.Message
+	to.Err = from
+	var fields []string
+	for field := range from.Data.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		for _, message := range from.Data.Fields[field] {
+			to.Errors = append(to.Errors, scm.FieldError{
+				Field:   field,
+				Message: message,
+			})
+		}
+	}
+	return to
+}
@@ -5,6 +5,8 @@
 package bitbucket
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
@@ -44,6 +46,16 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestNewError_NotAuthorized(t *testing.T) {
+	res := &scm.Response{
+		Status: 401,
+		Body:   ioutil.NopCloser(strings.NewReader(`{}`)),
+	}
+	if err := newError(res); err != scm.ErrNotAuthorized {
+		t.Errorf("Want ErrNotAuthorized, got %v", err)
+	}
+}
+
 func testPage(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Page.Next, 2; got != want {
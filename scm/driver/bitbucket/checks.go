@@ -0,0 +1,225 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// maxAnnotations is the maximum number of annotations
+// Bitbucket accepts in a single request.
+const maxAnnotations = 100
+
+// checksService implements the ChecksService using the
+// Bitbucket commit reports and annotations. A report is
+// identified by the commit and the report id, which is the
+// check run external id, or the check run name if the
+// external id is empty. Bitbucket does not support check
+// suites.
+type checksService struct {
+	client *wrapper
+}
+
+type reports struct {
+	pagination
+	Values []*report `json:"values"`
+}
+
+type report struct {
+	UUID       string    `json:"uuid"`
+	Title      string    `json:"title"`
+	Details    string    `json:"details"`
+	ExternalID string    `json:"external_id"`
+	Reporter   string    `json:"reporter"`
+	Link       string    `json:"link"`
+	ReportType string    `json:"report_type"`
+	Result     string    `json:"result"`
+	CommitHash string    `json:"commit_hash"`
+	CreatedOn  time.Time `json:"created_on"`
+	UpdatedOn  time.Time `json:"updated_on"`
+}
+
+type reportInput struct {
+	Title      string `json:"title"`
+	Details    string `json:"details"`
+	ExternalID string `json:"external_id"`
+	Link       string `json:"link,omitempty"`
+	ReportType string `json:"report_type"`
+	Result     string `json:"result,omitempty"`
+}
+
+type reportAnnotation struct {
+	ExternalID     string `json:"external_id"`
+	AnnotationType string `json:"annotation_type"`
+	Path           string `json:"path,omitempty"`
+	Line           int    `json:"line,omitempty"`
+	Summary        string `json:"summary"`
+	Details        string `json:"details,omitempty"`
+	Result         string `json:"result,omitempty"`
+	Severity       string `json:"severity,omitempty"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/commit/%s/reports?%s", repo, ref, encodeCheckListOptions(opts))
+	out := new(reports)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertReportList(out), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	id := input.ExternalID
+	if id == "" {
+		id = input.Name
+	}
+	return s.UpdateCheckRun(ctx, repo, id, input)
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/commit/%s/reports/%s", repo, input.HeadSHA, url.PathEscape(id))
+	in := &reportInput{
+		Title:      input.Name,
+		ExternalID: id,
+		Link:       input.DetailsURL,
+		ReportType: "TEST",
+		Result:     convertFromReportState(input.State),
+	}
+	if output := input.Output; output != nil {
+		in.Details = output.Summary
+		if in.Details == "" {
+			in.Details = output.Title
+		}
+	}
+	out := new(report)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil || input.Output == nil {
+		return convertReport(out), res, err
+	}
+
+	annotations := convertFromAnnotationList(id, input.Output.Annotations)
+	for len(annotations) > 0 {
+		batch := annotations
+		if len(batch) > maxAnnotations {
+			batch = annotations[:maxAnnotations]
+		}
+		annotations = annotations[len(batch):]
+		res, err = s.client.do(ctx, "POST", path+"/annotations", batch, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return convertReport(out), res, nil
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func convertReportList(from *reports) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from.Values {
+		to = append(to, convertReport(v))
+	}
+	return to
+}
+
+func convertReport(from *report) *scm.CheckRun {
+	return &scm.CheckRun{
+		ID:         from.ExternalID,
+		Name:       from.Title,
+		HeadSHA:    from.CommitHash,
+		ExternalID: from.ExternalID,
+		DetailsURL: from.Link,
+		State:      convertReportState(from.Result),
+		Output: &scm.CheckRunOutput{
+			Summary: from.Details,
+		},
+		Started:   from.CreatedOn,
+		Completed: from.UpdatedOn,
+	}
+}
+
+func convertFromAnnotationList(id string, from []*scm.CheckRunAnnotation) []*reportAnnotation {
+	var to []*reportAnnotation
+	for i, v := range from {
+		summary := v.Title
+		if summary == "" {
+			summary = v.Message
+		}
+		annotation := &reportAnnotation{
+			ExternalID:     fmt.Sprintf("%s-%d", id, i+1),
+			AnnotationType: "CODE_SMELL",
+			Path:           v.Path,
+			Line:           v.StartLine,
+			Summary:        summary,
+			Details:        v.Message,
+			Severity:       "LOW",
+		}
+		switch v.Level {
+		case scm.AnnotationLevelFailure:
+			annotation.AnnotationType = "BUG"
+			annotation.Severity = "HIGH"
+			annotation.Result = "FAILED"
+		case scm.AnnotationLevelWarning:
+			annotation.Severity = "MEDIUM"
+		}
+		if annotation.Details == summary {
+			annotation.Details = v.RawDetails
+		}
+		to = append(to, annotation)
+	}
+	return to
+}
+
+func convertReportState(from string) scm.State {
+	switch from {
+	case "PASSED":
+		return scm.StateSuccess
+	case "FAILED":
+		return scm.StateFailure
+	case "PENDING":
+		return scm.StatePending
+	default:
+		return scm.StateUnknown
+	}
+}
+
+func convertFromReportState(from scm.State) string {
+	switch from {
+	case scm.StatePending, scm.StateRunning:
+		return "PENDING"
+	case scm.StateSuccess:
+		return "PASSED"
+	case scm.StateUnknown:
+		return ""
+	default:
+		return "FAILED"
+	}
+}
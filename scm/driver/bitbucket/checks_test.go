@@ -0,0 +1,146 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports/drone-lint").
+		BodyString(`{"title":"drone","details":"2 lint warnings","external_id":"drone-lint","link":"https://ci.example.com/1000/output","report_type":"TEST","result":"FAILED"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/report.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports/drone-lint/annotations").
+		Reply(200).
+		Type("application/json").
+		BodyString("[]")
+
+	in := &scm.CheckRunInput{
+		Name:       "drone",
+		HeadSHA:    "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+		ExternalID: "drone-lint",
+		DetailsURL: "https://ci.example.com/1000/output",
+		State:      scm.StateFailure,
+		Output: &scm.CheckRunOutput{
+			Title:   "Lint",
+			Summary: "2 lint warnings",
+			Annotations: []*scm.CheckRunAnnotation{
+				{
+					Path:      "main.go",
+					StartLine: 12,
+					Level:     scm.AnnotationLevelWarning,
+					Message:   "exported function should have comment",
+				},
+				{
+					Path:      "main.go",
+					StartLine: 20,
+					Level:     scm.AnnotationLevelFailure,
+					Title:     "ineffectual assignment",
+					Message:   "ineffectual assignment to err",
+				},
+			},
+		},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Checks.CreateCheckRun(context.Background(), "atlassian/stash-example-plugin", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/report.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestChecksAnnotations(t *testing.T) {
+	in := []*scm.CheckRunAnnotation{
+		{
+			Path:      "main.go",
+			StartLine: 12,
+			Level:     scm.AnnotationLevelWarning,
+			Message:   "exported function should have comment",
+		},
+		{
+			Path:      "main.go",
+			StartLine: 20,
+			Level:     scm.AnnotationLevelFailure,
+			Title:     "ineffectual assignment",
+			Message:   "ineffectual assignment to err",
+		},
+	}
+
+	want := []*reportAnnotation{}
+	raw, _ := ioutil.ReadFile("testdata/report_annotations.json")
+	json.Unmarshal(raw, &want)
+
+	got := convertFromAnnotationList("drone-lint", in)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reports.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	opts := scm.CheckListOptions{Page: 1, Size: 10}
+	got, _, err := client.Checks.ListCheckRuns(context.Background(), "atlassian/stash-example-plugin", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/reports.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCheckSuites(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Checks.ListCheckSuites(context.Background(), "atlassian/stash-example-plugin", "master", scm.CheckListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
+	"path"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -41,6 +44,36 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	metaEndpoint := fmt.Sprintf("/2.0/repositories/%s/src/%s/%s?format=meta", repo, urlEncodedRef, path)
+	metaOut := new(metaContent)
+	res, err := s.client.do(ctx, "GET", metaEndpoint, nil, metaOut)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint := fmt.Sprintf("/2.0/repositories/%s/src/%s/%s", repo, urlEncodedRef, path)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.RawContent{
+		Path: path,
+		Size: metaOut.Size,
+		Sha:  metaOut.Commit.Hash,
+		Body: body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	// the api does not provide an archive endpoint, and the
+	// archive is downloaded from the website host, which is
+	// requested without the api credentials.
+	host := strings.TrimPrefix(s.client.BaseURL.Host, "api.")
+	endpoint := fmt.Sprintf("%s://%s/%s/get/%s.%s", s.client.BaseURL.Scheme, host, repo, url.PathEscape(ref), format)
+	return s.client.download(ctx, endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	endpoint := fmt.Sprintf("/2.0/repositories/%s/src", repo)
 	in := &contentCreateUpdate{
@@ -83,6 +116,60 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// the commit is rejected by bitbucket if the parent
+	// commit is provided and the branch head is not the
+	// parent commit.
+	in := &srcCommitInput{
+		Message: input.Message,
+		Branch:  input.Branch,
+		Parents: input.Parent,
+	}
+	if input.Author.Email != "" {
+		in.Author = fmt.Sprintf("%s <%s>", input.Author.Name, input.Author.Email)
+	}
+	for _, v := range input.Actions {
+		switch v.Action {
+		case scm.FileActionCreate, scm.FileActionUpdate:
+			in.Files = append(in.Files, &srcFile{Path: v.Path, Content: v.Data})
+		case scm.FileActionDelete:
+			in.Deleted = append(in.Deleted, v.Path)
+		case scm.FileActionMove:
+			// a moved file is deleted from the previous path
+			// and written to the new path, using the current
+			// content if the content is not provided.
+			data := v.Data
+			if data == nil {
+				ref := input.Parent
+				if ref == "" {
+					ref = input.Branch
+				}
+				current, res, err := s.Find(ctx, repo, v.PreviousPath, ref)
+				if err != nil {
+					return nil, res, err
+				}
+				data = current.Data
+			}
+			in.Deleted = append(in.Deleted, v.PreviousPath)
+			in.Files = append(in.Files, &srcFile{Path: v.Path, Content: data})
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+	}
+	endpoint := fmt.Sprintf("2.0/repositories/%s/src", repo)
+	res, err := s.client.do(ctx, "POST", endpoint, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	// the response body is empty, and the location header
+	// links to the new commit.
+	return &scm.Commit{
+		Sha:     path.Base(res.Header.Get("Location")),
+		Message: input.Message,
+		Author:  input.Author,
+	}, res, nil
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, opts scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	endpoint := fmt.Sprintf("/2.0/repositories/%s/src/%s/%s?%s", repo, ref, path, encodeListOptions(opts))
 	if opts.URL != "" {
@@ -111,6 +198,7 @@ type content struct {
 
 type metaContent struct {
 	Path   string `json:"path"`
+	Size   int64  `json:"size"`
 	Commit struct {
 		Hash string `json:"hash"`
 	} `json:"commit"`
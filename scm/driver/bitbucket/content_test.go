@@ -8,9 +8,11 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -266,3 +268,135 @@ func TestContentListWithUrlInput(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/src").
+		SetMatcher(gock.NewMatcher()).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			form := req.MultipartForm
+			return cmp.Equal(form.Value["README.md"], []string{"# Atlaskit"}) &&
+				cmp.Equal(form.Value["docs/guide.md"], []string{"hello"}) &&
+				cmp.Equal(form.Value["files"], []string{"docs/index.md", "docs/hello.md"}) &&
+				cmp.Equal(form.Value["message"], []string{"my commit message"}) &&
+				cmp.Equal(form.Value["branch"], []string{"master"}) &&
+				cmp.Equal(form.Value["parents"], []string{"a6e5e7d797edf751cbd839d6bd4aef86c941eec9"}) &&
+				cmp.Equal(form.Value["author"], []string{"Monalisa Octocat <octocat@github.com>"}), nil
+		}).
+		Reply(201).
+		SetHeader("Location", "https://api.bitbucket.org/2.0/repositories/atlassian/atlaskit/commit/131cb13f4aed12e725177bc4b7c28db67839bf9f")
+
+	input := &scm.CommitInput{
+		Branch:  "master",
+		Message: "my commit message",
+		Parent:  "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionUpdate, Path: "README.md", Data: []byte("# Atlaskit")},
+			{Action: scm.FileActionDelete, Path: "docs/index.md"},
+			{Action: scm.FileActionMove, Path: "docs/guide.md", PreviousPath: "docs/hello.md", Data: []byte("hello")},
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Contents.Commit(context.Background(), "atlassian/atlaskit", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Commit{
+		Sha:     "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		Message: "my commit message",
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/src/425863f9dbe56d70c8dcdbf2e4e0805e85591fcc/README").
+		MatchParam("format", "meta").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/src/425863f9dbe56d70c8dcdbf2e4e0805e85591fcc/README").
+		Reply(200).
+		Type("text/plain").
+		BodyString("hello world\n")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Contents.FindRaw(context.Background(), "atlassian/atlaskit", "README", "425863f9dbe56d70c8dcdbf2e4e0805e85591fcc")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path: "README",
+		Size: 39,
+		Sha:  "0846a192175701903ddd9264ece31922d8437c1a",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	// the api credentials must not be sent to the website host.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://bitbucket.org").
+		Get("/atlassian/atlaskit/get/425863f9dbe56d70c8dcdbf2e4e0805e85591fcc.tar.gz").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client, _ := New("https://api.bitbucket.org")
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Contents.Archive(context.Background(), "atlassian/atlaskit", "425863f9dbe56d70c8dcdbf2e4e0805e85591fcc", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
@@ -28,6 +28,11 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches/%s", repo, name)
 	out := new(branch)
@@ -56,6 +61,25 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// bitbucket creates an annotated tag if a message is
+	// provided. The tagger is the authenticated user.
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags", repo)
+	in := &createTag{
+		Name:    params.Name,
+		Message: params.Message,
+		Target: target{
+			Hash: params.Sha,
+		},
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
 	out := new(branch)
@@ -127,11 +151,21 @@ type branch struct {
 	} `json:"target"`
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type createBranch struct {
 	Name   string `json:"name"`
 	Target target `json:"target"`
 }
 
+type createTag struct {
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+	Target  target `json:"target"`
+}
+
 type target struct {
 	Hash string `json:"hash"`
 }
@@ -123,6 +123,79 @@ func TestGitCreateBranch(t *testing.T) {
 		t.Errorf("Unexpected Results")
 	}
 }
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/branches/yooo").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	res, err := client.Git.DeleteBranch(context.Background(), "atlassian/stash-example-plugin", "yooo")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		BodyString(`{"name":"v1.0.0","message":"release v1.0.0","target":{"hash":"2e684d13a43afd86cb48ea36d9f40f43e791fae9"}}`).
+		Reply(201).
+		Type("application/json")
+
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "2e684d13a43afd86cb48ea36d9f40f43e791fae9",
+		Message: "release v1.0.0",
+	}
+	client, _ := New("https://api.bitbucket.org")
+	res, err := client.Git.CreateTag(context.Background(), "atlassian/stash-example-plugin", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	res, err := client.Git.DeleteTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.UpdateRef(context.Background(), "atlassian/stash-example-plugin", &scm.ReferenceUpdateInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
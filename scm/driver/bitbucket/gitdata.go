@@ -0,0 +1,128 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// gitDataService implements the GitDataService. Bitbucket
+// does not support git objects, so commits are created
+// from the tree entries using the multipart src endpoint.
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	if input.Tree != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	in := &srcCommitInput{
+		Message: input.Message,
+		Branch:  input.Branch,
+		Parents: strings.Join(input.Parents, ","),
+	}
+	if input.Author.Email != "" {
+		in.Author = fmt.Sprintf("%s <%s>", input.Author.Name, input.Author.Email)
+	}
+	for _, v := range input.Entries {
+		switch {
+		case v.Delete:
+			in.Deleted = append(in.Deleted, v.Path)
+		case v.Sha != "":
+			// entries that reference an existing blob
+			// cannot be written using the src endpoint.
+			return nil, nil, scm.ErrNotSupported
+		default:
+			in.Files = append(in.Files, &srcFile{
+				Path:    v.Path,
+				Content: v.Content,
+			})
+		}
+	}
+	endpoint := fmt.Sprintf("2.0/repositories/%s/src", repo)
+	res, err := s.client.do(ctx, "POST", endpoint, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	// the response body is empty, and the location header
+	// links to the new commit.
+	return &scm.Commit{
+		Sha:     path.Base(res.Header.Get("Location")),
+		Message: input.Message,
+		Author:  input.Author,
+	}, res, nil
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
+
+type srcCommitInput struct {
+	Message string
+	Branch  string
+	Parents string
+	Author  string
+	Files   []*srcFile
+	Deleted []string
+}
+
+type srcFile struct {
+	Path    string
+	Content []byte
+}
+
+// encode returns the multipart form body and content type.
+// Each file is written to a field named by its path, and
+// deleted files are listed in the files field.
+func (in *srcCommitInput) encode() (io.Reader, string) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	for _, v := range in.Files {
+		fw, _ := w.CreateFormFile(v.Path, "")
+		_, _ = fw.Write(v.Content)
+	}
+	for _, v := range in.Deleted {
+		_ = w.WriteField("files", v)
+	}
+	if in.Message != "" {
+		_ = w.WriteField("message", in.Message)
+	}
+	if in.Branch != "" {
+		_ = w.WriteField("branch", in.Branch)
+	}
+	if in.Parents != "" {
+		_ = w.WriteField("parents", in.Parents)
+	}
+	if in.Author != "" {
+		_ = w.WriteField("author", in.Author)
+	}
+	w.Close()
+	return body, w.FormDataContentType()
+}
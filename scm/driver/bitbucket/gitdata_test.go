@@ -0,0 +1,87 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGitDataCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/src").
+		SetMatcher(gock.NewMatcher()).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			form := req.MultipartForm
+			return cmp.Equal(form.Value["README.md"], []string{"# Atlaskit"}) &&
+				cmp.Equal(form.Value["docs/hello.md"], []string{"hello"}) &&
+				cmp.Equal(form.Value["files"], []string{"docs/index.md"}) &&
+				cmp.Equal(form.Value["message"], []string{"my commit message"}) &&
+				cmp.Equal(form.Value["branch"], []string{"master"}) &&
+				cmp.Equal(form.Value["parents"], []string{"a6e5e7d797edf751cbd839d6bd4aef86c941eec9"}) &&
+				cmp.Equal(form.Value["author"], []string{"Monalisa Octocat <octocat@github.com>"}), nil
+		}).
+		Reply(201).
+		SetHeader("Location", "https://api.bitbucket.org/2.0/repositories/atlassian/atlaskit/commit/131cb13f4aed12e725177bc4b7c28db67839bf9f")
+
+	input := &scm.GitCommitInput{
+		Message: "my commit message",
+		Parents: []string{"a6e5e7d797edf751cbd839d6bd4aef86c941eec9"},
+		Branch:  "master",
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+		Entries: []*scm.TreeEntryInput{
+			{Path: "README.md", Content: []byte("# Atlaskit")},
+			{Path: "docs/hello.md", Content: []byte("hello")},
+			{Path: "docs/index.md", Delete: true},
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.GitData.CreateCommit(context.Background(), "atlassian/atlaskit", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Commit{
+		Sha:     "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		Message: "my commit message",
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDataCreateCommit_Tree(t *testing.T) {
+	input := &scm.GitCommitInput{
+		Message: "my commit message",
+		Tree:    "827efc6d56897b048c772eb4087f854f46256132",
+	}
+	client := NewDefault()
+	_, _, err := client.GitData.CreateCommit(context.Background(), "atlassian/atlaskit", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// keyService implements the KeyService. Bitbucket deploy
+// keys are always read-only, and gpg keys are not supported.
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys?%s", repo, encodeListOptions(opts))
+	out := new(deployKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertDeployKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	if !input.ReadOnly {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys", repo)
+	in := &keyInput{
+		Key:   input.Key,
+		Label: input.Title,
+	}
+	out := new(deployKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/deploy-keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys?%s", user.Login, encodeListOptions(opts))
+	out := new(sshKeys)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertSSHKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys", user.Login)
+	in := &keyInput{
+		Key:   input.Key,
+		Label: input.Title,
+	}
+	out := new(sshKey)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertSSHKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type deployKeys struct {
+	pagination
+	Values []*deployKey `json:"values"`
+}
+
+type deployKey struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	Label     string    `json:"label"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+type sshKeys struct {
+	pagination
+	Values []*sshKey `json:"values"`
+}
+
+type sshKey struct {
+	UUID      string    `json:"uuid"`
+	Key       string    `json:"key"`
+	Label     string    `json:"label"`
+	CreatedOn time.Time `json:"created_on"`
+}
+
+type keyInput struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+func convertDeployKeyList(from *deployKeys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertDeployKey(v))
+	}
+	return to
+}
+
+func convertDeployKey(from *deployKey) *scm.Key {
+	return &scm.Key{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Label,
+		Key:      from.Key,
+		ReadOnly: true,
+		Created:  from.CreatedOn,
+	}
+}
+
+func convertSSHKeyList(from *sshKeys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertSSHKey(v))
+	}
+	return to
+}
+
+func convertSSHKey(from *sshKey) *scm.Key {
+	return &scm.Key{
+		ID:      from.UUID,
+		Title:   from.Label,
+		Key:     from.Key,
+		Created: from.CreatedOn,
+	}
+}
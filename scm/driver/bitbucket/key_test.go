@@ -0,0 +1,213 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys/123").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Keys.FindDeployKey(context.Background(), "atlassian/stash-example-plugin", "123")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_keys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Keys.ListDeployKeys(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys").
+		BodyString(`{"key":"ssh-rsa AAA...","label":"drone"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/deploy_key.json")
+
+	input := &scm.KeyInput{
+		Title:    "drone",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Keys.CreateDeployKey(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/deploy_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate_ReadWrite(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Keys.CreateDeployKey(context.Background(), "atlassian/stash-example-plugin", &scm.KeyInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/deploy-keys/123").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Keys.DeleteDeployKey(context.Background(), "atlassian/stash-example-plugin", "123")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/users/brydzewski/ssh-keys").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/ssh_keys.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Keys.ListKeys(context.Background(), scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/ssh_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/users/brydzewski/ssh-keys").
+		BodyString(`{"key":"ssh-ed25519 AAA...","label":"Work key"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/ssh_key.json")
+
+	input := &scm.KeyInput{
+		Title: "Work key",
+		Key:   "ssh-ed25519 AAA...",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Keys.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/ssh_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyList(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Keys.ListGPGKeys(context.Background(), scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGPGKeyCreate(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Keys.CreateGPGKey(context.Background(), &scm.GPGKeyInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -42,6 +42,71 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+// ListTeams returns the workspace groups. The groups are
+// only available using the 1.0 api, which is not paginated.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups/%s", name)
+	out := []*group{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, slug string) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups?group=%s/%s", name, slug)
+	out := []*group{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertTeam(out[0]), res, nil
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, slug string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, slug string) (*scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups/%s/%s", name, slug)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, slug string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups/%s/%s/members", name, slug)
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertUserList(out), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, slug, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups/%s/%s/members/%s", name, slug, username)
+	// bitbucket requires an empty json object in the
+	// request body.
+	return s.client.do(ctx, "PUT", path, struct{}{}, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, slug, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("1.0/groups/%s/%s/members/%s", name, slug, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, slug, repo string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/permissions-config/groups/%s", repo, slug)
+	in := &permissionInput{Permission: convertFromPermission(perm)}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, slug, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/permissions-config/groups/%s", repo, slug)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func convertOrganizationList(from *organizationList) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from.Values {
@@ -79,6 +144,15 @@ type organization struct {
 	Login string `json:"slug"`
 }
 
+type group struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type permissionInput struct {
+	Permission string `json:"permission"`
+}
+
 func convertOrganization(from *organization) *scm.Organization {
 	return &scm.Organization{
 		Name:   from.Login,
@@ -86,6 +160,23 @@ func convertOrganization(from *organization) *scm.Organization {
 	}
 }
 
+func convertTeamList(from []*group) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+// bitbucket groups do not have an id, and are identified
+// by slug.
+func convertTeam(from *group) *scm.Team {
+	return &scm.Team{
+		Name: from.Name,
+		Slug: from.Slug,
+	}
+}
+
 func convertMembership(from *membership) *scm.Membership {
 	to := new(scm.Membership)
 	to.Active = true
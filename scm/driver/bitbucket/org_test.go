@@ -67,3 +67,200 @@ func TestOrganizationList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrganizationListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/1.0/groups/atlassian").
+		Reply(200).
+		Type("application/json").
+		File("testdata/groups.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "atlassian", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/groups.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationFindTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/1.0/groups").
+		MatchParam("group", "atlassian/developers").
+		Reply(200).
+		Type("application/json").
+		File("testdata/groups.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.FindTeam(context.Background(), "atlassian", "developers")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/group.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationCreateTeam(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Organizations.CreateTeam(context.Background(), "atlassian", &scm.TeamInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationUpdateTeam(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Organizations.UpdateTeam(context.Background(), "atlassian", "developers", &scm.TeamInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationDeleteTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/1.0/groups/atlassian/developers").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.DeleteTeam(context.Background(), "atlassian", "developers")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/1.0/groups/atlassian/developers/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "atlassian", "developers", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.User{}
+	raw, _ := ioutil.ReadFile("testdata/members.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationAddTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/1.0/groups/atlassian/developers/members/brydzewski").
+		BodyString(`{}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.AddTeamMember(context.Background(), "atlassian", "developers", "brydzewski")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationRemoveTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/1.0/groups/atlassian/developers/members/brydzewski").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "atlassian", "developers", "brydzewski")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationAddTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/permissions-config/groups/developers").
+		BodyString(`{"permission":"write"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), "atlassian", "developers", "atlassian/stash-example-plugin", scm.PermissionWrite)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationRemoveTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/permissions-config/groups/developers").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.RemoveTeamRepo(context.Background(), "atlassian", "developers", "atlassian/stash-example-plugin")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
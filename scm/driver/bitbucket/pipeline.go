@@ -0,0 +1,248 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// Bitbucket Pipelines. Pipelines and steps are identified by
+// uuid, and Bitbucket does not support re-running pipelines
+// using the api.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/", repo)
+	in := &pipelineInput{
+		Target: pipelineTargetInput{
+			Type:    "pipeline_ref_target",
+			RefType: "branch",
+			RefName: scm.TrimRef(input.Ref),
+		},
+		Variables: []*pipelineVariable{},
+	}
+	// the pipeline name selects a custom pipeline, which
+	// can only be triggered manually or using the api.
+	if input.Pipeline != "" {
+		in.Target.Selector = &pipelineSelector{
+			Type:    "custom",
+			Pattern: input.Pipeline,
+		}
+	}
+	// the variables are sorted by key to create a stable
+	// request body.
+	keys := []string{}
+	for k := range input.Inputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Variables = append(in.Variables, &pipelineVariable{
+			Key:   k,
+			Value: input.Inputs[k],
+		})
+	}
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPipeline(out), res, err
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/?%s", repo, encodePipelineListOptions(opts))
+	out := new(pipelines)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertPipelineList(out), res, err
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%s", repo, id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("2.0/repositories/%s/pipelines/%s/steps/?pagelen=100", repo, id)
+	steps := new(pipelineSteps)
+	res, err = s.client.do(ctx, "GET", path, nil, steps)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertPipeline(out)
+	run.Jobs = convertPipelineStepList(steps)
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%s/stopPipeline", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines/%s/steps/%s/log", repo, run, job)
+	return s.client.stream(ctx, "GET", path)
+}
+
+type pipelineInput struct {
+	Target    pipelineTargetInput `json:"target"`
+	Variables []*pipelineVariable `json:"variables,omitempty"`
+}
+
+type pipelineTargetInput struct {
+	Type     string            `json:"type"`
+	RefType  string            `json:"ref_type"`
+	RefName  string            `json:"ref_name"`
+	Selector *pipelineSelector `json:"selector,omitempty"`
+}
+
+type pipelineSelector struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+type pipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type pipelines struct {
+	pagination
+	Values []*pipeline `json:"values"`
+}
+
+type pipeline struct {
+	UUID        string        `json:"uuid"`
+	BuildNumber int           `json:"build_number"`
+	State       pipelineState `json:"state"`
+	Target      struct {
+		RefName  string `json:"ref_name"`
+		Selector struct {
+			Type    string `json:"type"`
+			Pattern string `json:"pattern"`
+		} `json:"selector"`
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+	} `json:"target"`
+	Trigger struct {
+		Name string `json:"name"`
+	} `json:"trigger"`
+	Repository struct {
+		Links struct {
+			HTML link `json:"html"`
+		} `json:"links"`
+	} `json:"repository"`
+	CreatedOn   time.Time `json:"created_on"`
+	CompletedOn time.Time `json:"completed_on"`
+}
+
+type pipelineState struct {
+	Name   string `json:"name"`
+	Result struct {
+		Name string `json:"name"`
+	} `json:"result"`
+	Stage struct {
+		Name string `json:"name"`
+	} `json:"stage"`
+}
+
+type pipelineSteps struct {
+	pagination
+	Values []*pipelineStep `json:"values"`
+}
+
+type pipelineStep struct {
+	UUID        string        `json:"uuid"`
+	Name        string        `json:"name"`
+	State       pipelineState `json:"state"`
+	StartedOn   time.Time     `json:"started_on"`
+	CompletedOn time.Time     `json:"completed_on"`
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	params.Set("sort", "-created_on")
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("target.ref_name", scm.TrimRef(opts.Ref))
+	}
+	return params.Encode()
+}
+
+// helper function returns the execution status of a pipeline
+// or step. Completed pipelines and steps are converted using
+// the result.
+func convertPipelineState(from pipelineState) scm.ExecutionStatus {
+	switch from.Name {
+	case "COMPLETED":
+		return scm.ConvertExecutionStatus(from.Result.Name)
+	case "IN_PROGRESS":
+		// paused pipelines are waiting for a manual step.
+		if from.Stage.Name == "PAUSED" {
+			return scm.StatusPending
+		}
+	}
+	return scm.ConvertExecutionStatus(from.Name)
+}
+
+func convertPipelineList(from *pipelines) []*scm.PipelineRun {
+	to := []*scm.PipelineRun{}
+	for _, v := range from.Values {
+		to = append(to, convertPipeline(v))
+	}
+	return to
+}
+
+func convertPipeline(from *pipeline) *scm.PipelineRun {
+	to := &scm.PipelineRun{
+		ID:       from.UUID,
+		Number:   from.BuildNumber,
+		Pipeline: from.Target.Selector.Pattern,
+		Ref:      from.Target.RefName,
+		Sha:      from.Target.Commit.Hash,
+		Event:    from.Trigger.Name,
+		Status:   convertPipelineState(from.State),
+		Created:  from.CreatedOn,
+		Updated:  from.CompletedOn,
+	}
+	if href := from.Repository.Links.HTML.Href; href != "" {
+		to.Link = fmt.Sprintf("%s/pipelines/results/%d", href, from.BuildNumber)
+	}
+	return to
+}
+
+func convertPipelineStepList(from *pipelineSteps) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from.Values {
+		to = append(to, &scm.PipelineJob{
+			ID:       v.UUID,
+			Name:     v.Name,
+			Status:   convertPipelineState(v.State),
+			Started:  v.StartedOn,
+			Finished: v.CompletedOn,
+		})
+	}
+	return to
+}
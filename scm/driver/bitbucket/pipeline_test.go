@@ -0,0 +1,157 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines/").
+		BodyString(`{"target":{"type":"pipeline_ref_target","ref_type":"branch","ref_name":"master","selector":{"type":"custom","pattern":"deploy"}},"variables":[{"key":"ENVIRONMENT","value":"staging"}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.Trigger(context.Background(), "atlassian/stash-example-plugin", &scm.PipelineInput{Pipeline: "deploy", Ref: "refs/heads/master", Inputs: map[string]string{"ENVIRONMENT": "staging"}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/").
+		MatchParam("target.ref_name", "master").
+		MatchParam("sort", "-created_on").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipelines.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.ListRuns(context.Background(), "atlassian/stash-example-plugin", scm.PipelineListOptions{Ref: "master", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/pipelines.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}/steps/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pipeline_steps.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Pipelines.FindRun(context.Background(), "atlassian/stash-example-plugin", "{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/pipeline_steps.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines/{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}/stopPipeline").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Pipelines.CancelRun(context.Background(), "atlassian/stash-example-plugin", "{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestPipelineRetryRun(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Pipelines.RetryRun(context.Background(), "atlassian/stash-example-plugin", "{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines/{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}/steps/{3bd1c8c4-5a4e-4b1e-9f42-1c9d2f7e5a10}/log").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("+ mvn -B verify\n")
+
+	client, _ := New("https://api.bitbucket.org")
+	rc, _, err := client.Pipelines.FindLogs(context.Background(), "atlassian/stash-example-plugin", "{a0c7ab8e-0a5b-4bd5-9f0d-9d5e8c9b6a11}", "{3bd1c8c4-5a4e-4b1e-9f42-1c9d2f7e5a10}")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "+ mvn -B verify\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+}
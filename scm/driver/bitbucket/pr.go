@@ -6,7 +6,10 @@ package bitbucket
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -53,10 +56,154 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	in := &mergeInput{
+		Message:           opts.CommitTitle,
+		CloseSourceBranch: opts.DeleteSourceBranch,
+	}
+	if opts.CommitMessage != "" {
+		if in.Message != "" {
+			in.Message += "\n\n"
+		}
+		in.Message += opts.CommitMessage
+	}
+	switch opts.Method {
+	case scm.MergeMethodMerge:
+		in.MergeStrategy = "merge_commit"
+	case scm.MergeMethodSquash:
+		in.MergeStrategy = "squash"
+	case scm.MergeMethodRebase:
+		in.MergeStrategy = "rebase_fast_forward"
+	case scm.MergeMethodFastForward:
+		in.MergeStrategy = "fast_forward"
+	}
+	// bitbucket does not support merging a specific sha,
+	// so the head of the pull request is compared before
+	// merging. The pull request returns abbreviated hashes.
+	if opts.SHA != "" {
+		pr, res, err := s.Find(ctx, repo, number)
+		if err != nil {
+			return res, err
+		}
+		if pr.Sha == "" || !strings.HasPrefix(opts.SHA, pr.Sha) {
+			return res, scm.ErrConflict
+		}
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/merge", repo, number)
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	return res, nil
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	// declined pull requests cannot be reopened.
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	in := &prUpdateInput{
+		Title:       input.Title,
+		Description: input.Body,
+		Draft:       input.Draft,
+	}
+	if input.Target != "" {
+		in.Destination = new(prDestination)
+		in.Destination.Branch.Name = input.Target
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	// bitbucket does not support team reviewers.
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	out, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	reviewers := []*prReviewer{}
+	for _, v := range out.Reviewers {
+		reviewers = append(reviewers, &prReviewer{UUID: v.UUID})
+	}
+	for _, v := range input.Users {
+		if !containsReviewer(out.Reviewers, v) {
+			reviewers = append(reviewers, convertReviewerInput(v))
+		}
+	}
+	return s.updateReviewers(ctx, repo, number, out, reviewers)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	out, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	reviewers := []*prReviewer{}
+	for _, v := range out.Reviewers {
+		if !matchUser(v, input.Users) {
+			reviewers = append(reviewers, &prReviewer{UUID: v.UUID})
+		}
+	}
+	return s.updateReviewers(ctx, repo, number, out, reviewers)
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return pr.Reviewers, res, nil
+}
+
+func (s *pullService) SetAssignees(ctx context.Context, repo string, number int, users []string) (*scm.Response, error) {
+	// bitbucket does not support pull request assignees.
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) find(ctx context.Context, repo string, number int) (*pr, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+// updateReviewers replaces the pull request reviewers. The
+// title is required when updating the pull request and is
+// sent with the reviewers.
+func (s *pullService) updateReviewers(ctx context.Context, repo string, number int, from *pr, reviewers []*prReviewer) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	in := &prReviewersInput{
+		Title:     from.Title,
+		Reviewers: reviewers,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests", repo)
 	in := new(prInput)
@@ -113,6 +260,12 @@ type reference struct {
 	} `json:"repository"`
 }
 
+type mergeInput struct {
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
 type pr struct {
 	Description string `json:"description"`
 	Links       struct {
@@ -133,11 +286,14 @@ type pr struct {
 		Type string `json:"type"`
 		Hash string `json:"hash"`
 	} `json:"merge_commit"`
-	Source    reference `json:"source"`
-	State     string    `json:"state"`
-	Author    user      `json:"author"`
-	CreatedOn time.Time `json:"created_on"`
-	UpdatedOn time.Time `json:"updated_on"`
+	Source       reference      `json:"source"`
+	State        string         `json:"state"`
+	Draft        bool           `json:"draft"`
+	Author       user           `json:"author"`
+	Reviewers    []*user        `json:"reviewers"`
+	Participants []*participant `json:"participants"`
+	CreatedOn    time.Time      `json:"created_on"`
+	UpdatedOn    time.Time      `json:"updated_on"`
 }
 
 type prs struct {
@@ -145,6 +301,29 @@ type prs struct {
 	Values []*pr `json:"values"`
 }
 
+type prUpdateInput struct {
+	Title       string         `json:"title,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Destination *prDestination `json:"destination,omitempty"`
+	Draft       *bool          `json:"draft,omitempty"`
+}
+
+type prReviewersInput struct {
+	Title     string        `json:"title"`
+	Reviewers []*prReviewer `json:"reviewers"`
+}
+
+type prReviewer struct {
+	UUID      string `json:"uuid,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+type prDestination struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
 type prInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
@@ -169,7 +348,7 @@ func convertPullRequests(from *prs) []*scm.PullRequest {
 }
 
 func convertPullRequest(from *pr) *scm.PullRequest {
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number: from.ID,
 		Title:  from.Title,
 		Body:   from.Description,
@@ -180,6 +359,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Fork:   from.Source.Repository.FullName,
 		Link:   from.Links.HTML.Href,
 		Diff:   from.Links.Diff.Href,
+		Draft:  from.Draft,
 		Closed: from.State != "OPEN",
 		Merged: from.State == "MERGED",
 		Head: scm.Reference{
@@ -200,6 +380,61 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Created: from.CreatedOn,
 		Updated: from.UpdatedOn,
 	}
+	for _, v := range from.Reviewers {
+		to.Reviewers = append(to.Reviewers, convertReviewer(v, from.Participants))
+	}
+	return to
+}
+
+// convertReviewer returns the reviewer with the review state
+// of the matching pull request participant.
+func convertReviewer(from *user, participants []*participant) scm.Reviewer {
+	to := scm.Reviewer{
+		User: scm.User{
+			ID:     from.AccountID,
+			Login:  from.Nickname,
+			Name:   from.DisplayName,
+			Avatar: from.Links.Avatar.Href,
+		},
+	}
+	for _, v := range participants {
+		if v.User.UUID == from.UUID {
+			to.State = convertReviewState(v.State, v.Approved)
+		}
+	}
+	return to
+}
+
+// convertReviewerInput returns the reviewer for the user
+// identifier, which is either a uuid enclosed in curly
+// braces or an account id.
+func convertReviewerInput(from string) *prReviewer {
+	if strings.HasPrefix(from, "{") {
+		return &prReviewer{UUID: from}
+	}
+	return &prReviewer{AccountID: from}
+}
+
+// containsReviewer returns true if the user identifier
+// matches one of the reviewers.
+func containsReviewer(reviewers []*user, id string) bool {
+	for _, v := range reviewers {
+		if matchUser(v, []string{id}) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchUser returns true if the user uuid or account id
+// matches one of the user identifiers.
+func matchUser(from *user, ids []string) bool {
+	for _, v := range ids {
+		if v == from.UUID || v == from.AccountID {
+			return true
+		}
+	}
+	return false
 }
 
 func convertPullRequestComment(from *prComment) *scm.Comment {
@@ -216,3 +451,16 @@ func convertPullRequestComment(from *prComment) *scm.Comment {
 		Updated: from.UpdatedOn,
 	}
 }
+
+// convertMergeError returns a MergeMethodError if the merge
+// failed because the merge strategy is not allowed for the
+// repository.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		apiErr.Status == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "merge strategy") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
+}
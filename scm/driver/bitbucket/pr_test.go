@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -110,6 +111,79 @@ func TestPullMerge(t *testing.T) {
 	}
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/merge").
+		JSON(map[string]interface{}{
+			"message":             "Merged in feature (pull request #1)",
+			"close_source_branch": true,
+			"merge_strategy":      "squash",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Merged in feature (pull request #1)",
+		SHA:                "31c54529bd80a3b2f8c1f5c9d5d2dfdc6c1ba1d2",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "atlassian/atlaskit", 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request merged")
+	}
+}
+
+func TestPullMergeWithOptions_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	opts := scm.PullRequestMergeOptions{SHA: "710db794f15b"}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "atlassian/atlaskit", 1, opts)
+	if !errors.Is(err, scm.ErrConflict) {
+		t.Errorf("Want conflict error, got %v", err)
+	}
+}
+
+func TestPullMergeWithOptions_NotAllowed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/merge").
+		Reply(400).
+		Type("application/json").
+		BodyString(`{"type":"error","error":{"message":"The merge strategy fast_forward is not allowed for this repository."}}`)
+
+	opts := scm.PullRequestMergeOptions{Method: scm.MergeMethodFastForward}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "atlassian/atlaskit", 1, opts)
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	client, _ := New("https://api.bitbucket.org")
 	_, err := client.PullRequests.Close(context.Background(), "atlassian/atlaskit", 1)
@@ -118,6 +192,145 @@ func TestPullClose(t *testing.T) {
 	}
 }
 
+func TestPullReopen(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.Reopen(context.Background(), "atlassian/atlaskit", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"destination": map[string]interface{}{
+				"branch": map[string]string{"name": "master"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.PullRequestUpdateInput{
+		Target: "master",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.PullRequests.Update(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullMarkReady(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]bool{"draft": false}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.MarkReady(context.Background(), "atlassian/atlaskit", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect draft state updated")
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"title": "IOS date picker component duplicate March issue",
+			"reviewers": []map[string]string{
+				{"uuid": "{4f5a1c2e-9b7d-4e3a-8c61-2d0f9e7b3a15}"},
+				{"account_id": "5c7c7b1a0b79db7c3e33eca2"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"5c7c7b1a0b79db7c3e33eca2"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers updated")
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		JSON(map[string]interface{}{
+			"title":     "IOS date picker component duplicate March issue",
+			"reviewers": []map[string]string{},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"{4f5a1c2e-9b7d-4e3a-8c61-2d0f9e7b3a15}"},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers updated")
+	}
+}
+
+func TestPullSetAssignees(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.SetAssignees(context.Background(), "atlassian/atlaskit", 1, []string{"5c7c7b1a0b79db7c3e33eca2"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
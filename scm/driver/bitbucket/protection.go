@@ -0,0 +1,255 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// protectionService implements the BranchProtectionService
+// using Bitbucket branch restrictions. The protection rules
+// for a branch pattern are a set of restrictions, one for
+// each kind, so an update replaces the existing restriction
+// of each kind, creates the missing restrictions, and then
+// removes the restrictions that are no longer required. The
+// changes are reverted if any request fails. Users are
+// identified by account id and teams by group slug.
+type protectionService struct {
+	client *wrapper
+}
+
+type restrictions struct {
+	pagination
+	Values []*restriction `json:"values"`
+}
+
+type restriction struct {
+	ID              int                 `json:"id,omitempty"`
+	Kind            string              `json:"kind"`
+	BranchMatchKind string              `json:"branch_match_kind"`
+	Pattern         string              `json:"pattern"`
+	Value           int                 `json:"value,omitempty"`
+	Users           []*restrictionUser  `json:"users,omitempty"`
+	Groups          []*restrictionGroup `json:"groups,omitempty"`
+}
+
+type restrictionUser struct {
+	AccountID string `json:"account_id"`
+}
+
+type restrictionGroup struct {
+	Slug string `json:"slug"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	out, res, err := s.list(ctx, repo, branch)
+	return convertRestrictions(branch, out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	existing, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return nil, res, err
+	}
+	current := map[string]*restriction{}
+	for _, v := range existing {
+		if _, ok := current[v.Kind]; !ok {
+			current[v.Kind] = v
+		}
+	}
+
+	// undo holds the requests that revert the changes made
+	// so far, in the order they were made.
+	var undo []func()
+	revert := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions", repo)
+	kept := map[int]bool{}
+	out := []*restriction{}
+	for _, in := range convertFromBranchProtection(branch, input) {
+		saved := new(restriction)
+		if prev, ok := current[in.Kind]; ok {
+			delete(current, in.Kind)
+			kept[prev.ID] = true
+			res, err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", path, prev.ID), in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", path, prev.ID), prev, nil)
+			})
+		} else {
+			res, err = s.client.do(ctx, "POST", path, in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, saved.ID), nil, nil)
+			})
+		}
+		out = append(out, saved)
+	}
+
+	for _, v := range existing {
+		if kept[v.ID] {
+			continue
+		}
+		prev := v
+		res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, prev.ID), nil, nil)
+		if err != nil {
+			revert()
+			return nil, res, err
+		}
+		undo = append(undo, func() {
+			in := *prev
+			in.ID = 0
+			s.client.do(ctx, "POST", path, &in, nil)
+		})
+	}
+	return convertRestrictions(branch, out), res, nil
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	out, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out {
+		path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions/%d", repo, v.ID)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// list returns the branch restrictions for the pattern,
+// requesting each page of restrictions.
+func (s *protectionService) list(ctx context.Context, repo, branch string) ([]*restriction, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("pattern", branch)
+	params.Set("pagelen", "100")
+	var to []*restriction
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions?%s", repo, params.Encode())
+		out := new(restrictions)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out.Values {
+			if v.Pattern == branch {
+				to = append(to, v)
+			}
+		}
+		if out.Next == "" {
+			return to, res, nil
+		}
+	}
+}
+
+func convertRestrictions(branch string, from []*restriction) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:         branch,
+		AllowForcePush: true,
+		AllowDeletion:  true,
+	}
+	for _, v := range from {
+		switch v.Kind {
+		case "push":
+			to.Push = convertRestrictionUsers(v)
+		case "restrict_merges":
+			to.Merge = convertRestrictionUsers(v)
+		case "force":
+			to.AllowForcePush = false
+		case "delete":
+			to.AllowDeletion = false
+		case "require_approvals_to_merge":
+			if to.RequiredReviews == nil {
+				to.RequiredReviews = new(scm.RequiredReviews)
+			}
+			to.RequiredReviews.Count = v.Value
+		case "reset_pullrequest_approvals_on_change":
+			if to.RequiredReviews == nil {
+				to.RequiredReviews = new(scm.RequiredReviews)
+			}
+			to.RequiredReviews.DismissStale = true
+		case "require_passing_builds_to_merge":
+			to.RequiredStatusChecks = new(scm.RequiredStatusChecks)
+		}
+	}
+	return to
+}
+
+func convertRestrictionUsers(from *restriction) *scm.BranchRestrictions {
+	to := new(scm.BranchRestrictions)
+	for _, v := range from.Users {
+		to.Users = append(to.Users, v.AccountID)
+	}
+	for _, v := range from.Groups {
+		to.Teams = append(to.Teams, v.Slug)
+	}
+	return to
+}
+
+func convertFromBranchProtection(branch string, from *scm.BranchProtectionInput) []*restriction {
+	var to []*restriction
+	add := func(kind string, value int, users *scm.BranchRestrictions) {
+		v := &restriction{
+			Kind:            kind,
+			BranchMatchKind: "glob",
+			Pattern:         branch,
+			Value:           value,
+		}
+		if users != nil {
+			for _, user := range users.Users {
+				v.Users = append(v.Users, &restrictionUser{AccountID: user})
+			}
+			for _, team := range users.Teams {
+				v.Groups = append(v.Groups, &restrictionGroup{Slug: team})
+			}
+		}
+		to = append(to, v)
+	}
+	if from.Push != nil {
+		add("push", 0, from.Push)
+	}
+	if from.Merge != nil {
+		add("restrict_merges", 0, from.Merge)
+	}
+	if !from.AllowForcePush {
+		add("force", 0, nil)
+	}
+	if !from.AllowDeletion {
+		add("delete", 0, nil)
+	}
+	if v := from.RequiredReviews; v != nil {
+		add("require_approvals_to_merge", v.Count, nil)
+		if v.DismissStale {
+			add("reset_pullrequest_approvals_on_change", 0, nil)
+		}
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		count := len(v.Contexts)
+		if count == 0 {
+			count = 1
+		}
+		add("require_passing_builds_to_merge", count, nil)
+	}
+	return to
+}
@@ -0,0 +1,275 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.BranchProtections.Find(context.Background(), "atlassian/stash-example-plugin", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_restrictions.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values":[]}`)
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		JSON(map[string]interface{}{"kind": "force", "branch_match_kind": "glob", "pattern": "master"}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":2,"kind":"force","branch_match_kind":"glob","pattern":"master"}`)
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		JSON(map[string]interface{}{"kind": "require_approvals_to_merge", "branch_match_kind": "glob", "pattern": "master", "value": 2}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":3,"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"master","value":2}`)
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{Count: 2},
+		AllowDeletion:   true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.BranchProtections.Update(context.Background(), "atlassian/stash-example-plugin", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{
+		Branch:          "master",
+		RequiredReviews: &scm.RequiredReviews{Count: 2},
+		AllowDeletion:   true,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch restrictions created")
+	}
+}
+
+func TestProtectionUpdate_Replace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/2").
+		JSON(map[string]interface{}{"kind": "force", "branch_match_kind": "glob", "pattern": "master"}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":2,"kind":"force","branch_match_kind":"glob","pattern":"master"}`)
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/3").
+		JSON(map[string]interface{}{"kind": "require_approvals_to_merge", "branch_match_kind": "glob", "pattern": "master", "value": 3}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":3,"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"master","value":3}`)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/1").
+		Reply(204)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/4").
+		Reply(204)
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{Count: 3},
+		AllowDeletion:   true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.BranchProtections.Update(context.Background(), "atlassian/stash-example-plugin", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{
+		Branch:          "master",
+		RequiredReviews: &scm.RequiredReviews{Count: 3},
+		AllowDeletion:   true,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch restrictions replaced")
+	}
+}
+
+func TestProtectionUpdate_Revert(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/3").
+		JSON(map[string]interface{}{"kind": "require_approvals_to_merge", "branch_match_kind": "glob", "pattern": "master", "value": 3}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":3,"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"master","value":3}`)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/1").
+		Reply(204)
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/2").
+		Reply(500).
+		Type("application/json").
+		BodyString(`{"type":"error","error":{"message":"Something went wrong"}}`)
+
+	// the deleted restriction is created again and the
+	// replaced restriction is restored.
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		JSON(map[string]interface{}{
+			"kind":              "push",
+			"branch_match_kind": "glob",
+			"pattern":           "master",
+			"users":             []map[string]string{{"account_id": "5c0e7a1f8a8a2b49e5a3a5a2"}},
+			"groups":            []map[string]string{{"slug": "developers"}},
+		}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":6,"kind":"push","branch_match_kind":"glob","pattern":"master"}`)
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/3").
+		JSON(map[string]interface{}{"id": 3, "kind": "require_approvals_to_merge", "branch_match_kind": "glob", "pattern": "master", "value": 2}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":3,"kind":"require_approvals_to_merge","branch_match_kind":"glob","pattern":"master","value":2}`)
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{Count: 3},
+		AllowForcePush:  true,
+		AllowDeletion:   true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.BranchProtections.Update(context.Background(), "atlassian/stash-example-plugin", "master", input)
+	if err == nil {
+		t.Errorf("Expect error when a restriction cannot be removed")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch restrictions reverted")
+	}
+}
+
+func TestProtectionFind_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values":[{"id":2,"kind":"force","pattern":"master"}],"next":"https://api.bitbucket.org/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions?page=2"}`)
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "master").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values":[{"id":5,"kind":"delete","pattern":"master"}]}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.BranchProtections.Find(context.Background(), "atlassian/stash-example-plugin", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{Branch: "master"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("pattern", "release/*").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions/5").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.BranchProtections.Delete(context.Background(), "atlassian/stash-example-plugin", "release/*")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect branch restriction deleted")
+	}
+}
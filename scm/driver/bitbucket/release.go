@@ -1,11 +1,22 @@
 package bitbucket
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// releaseService implements the ReleaseService. Bitbucket
+// does not support releases, and release assets are stored
+// as repository downloads, which are not associated with a
+// release tag. The asset is identified by the file name.
 type releaseService struct {
 	client *wrapper
 }
@@ -41,3 +52,122 @@ func (s *releaseService) Update(ctx context.Context, repo string, id int, input
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/downloads?%s", repo, encodeListOptions(opts))
+	out := new(downloads)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertDownloadList(out), res, err
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	// the upload endpoint does not return the download, so
+	// the asset is returned from the input.
+	path := fmt.Sprintf("2.0/repositories/%s/downloads", repo)
+	in := &downloadInput{
+		Name:        input.Name,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Body:        input.Body,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.ReleaseAsset{
+		ID:          input.Name,
+		Name:        input.Name,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+	}, res, nil
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	// the download is redirected to a signed storage url,
+	// which is requested without credentials.
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("2.0/repositories/%s/downloads/%s", repo, id),
+	}
+	return s.client.streamRedirect(ctx, req)
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/downloads/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type downloads struct {
+	pagination
+	Values []*download `json:"values"`
+}
+
+type download struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedOn time.Time `json:"created_on"`
+	Links     struct {
+		Self link `json:"self"`
+	} `json:"links"`
+}
+
+// downloadInput is the multipart body of a download
+// upload. The file is streamed and is not buffered in memory.
+type downloadInput struct {
+	Name        string
+	ContentType string
+	Size        int64
+	Body        io.Reader
+}
+
+// encode returns the multipart body, the content type and
+// the content length of the upload. The content length is
+// zero, and the body is sent chunked, if the file size is
+// unknown.
+func (in *downloadInput) encode() (io.Reader, string, int64) {
+	contentType := in.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+		"name":     "files",
+		"filename": in.Name,
+	}))
+	header.Set("Content-Type", contentType)
+
+	// the part headers and closing boundary are written to
+	// the buffer, and the file is streamed between them.
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	_, _ = w.CreatePart(header)
+	n := buf.Len()
+	w.Close()
+	head := bytes.NewReader(buf.Bytes()[:n])
+	tail := bytes.NewReader(buf.Bytes()[n:])
+
+	var size int64
+	if in.Size > 0 {
+		size = int64(buf.Len()) + in.Size
+	}
+	return io.MultiReader(head, in.Body, tail), w.FormDataContentType(), size
+}
+
+func convertDownloadList(from *downloads) []*scm.ReleaseAsset {
+	to := []*scm.ReleaseAsset{}
+	for _, v := range from.Values {
+		to = append(to, convertDownload(v))
+	}
+	return to
+}
+
+func convertDownload(from *download) *scm.ReleaseAsset {
+	return &scm.ReleaseAsset{
+		ID:      from.Name,
+		Name:    from.Name,
+		Size:    from.Size,
+		Link:    from.Links.Self.Href,
+		Created: from.CreatedOn,
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseListAssets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/downloads").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/downloads.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.ListAssets(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReleaseAsset{}
+	raw, _ := ioutil.ReadFile("testdata/downloads.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	// the download is streamed in a multipart body with the
+	// content length of the complete body set.
+	matcher := gock.NewMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil || int64(len(body)) != req.ContentLength {
+			return false, err
+		}
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			return false, err
+		}
+		part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+		if err != nil {
+			return false, err
+		}
+		file, _ := ioutil.ReadAll(part)
+		return part.FormName() == "files" &&
+			part.FileName() == "example.zip" &&
+			string(file) == "hello world", nil
+	})
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/downloads").
+		SetMatcher(matcher).
+		Reply(201)
+
+	input := &scm.ReleaseAssetInput{
+		Name:        "example.zip",
+		ContentType: "application/zip",
+		Size:        11,
+		Body:        struct{ io.Reader }{strings.NewReader("hello world")},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.UploadAsset(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.ReleaseAsset{
+		ID:          "example.zip",
+		Name:        "example.zip",
+		ContentType: "application/zip",
+		Size:        11,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseDownloadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/downloads/example.zip").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client, _ := New("https://api.bitbucket.org")
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", "example.zip")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDownloadAsset_Redirect(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/downloads/example.zip").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(302).
+		SetHeader("Location", "https://bbuseruploads.s3.amazonaws.com/example.zip?Signature=abc")
+
+	// the token must not be sent to the storage host, which
+	// rejects signed urls that also carry credentials.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://bbuseruploads.s3.amazonaws.com").
+		Get("/example.zip").
+		MatchParam("Signature", "abc").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client, _ := New("https://api.bitbucket.org")
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", "example.zip")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDeleteAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/downloads/example.zip").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	res, err := client.Releases.DeleteAsset(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", "example.zip")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
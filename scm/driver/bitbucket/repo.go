@@ -36,6 +36,33 @@ type repository struct {
 	} `json:"links"`
 }
 
+type repositoryInput struct {
+	SCM         string `json:"scm"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   bool   `json:"is_private"`
+}
+
+type repositoryUpdateInput struct {
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	IsPrivate   *bool        `json:"is_private,omitempty"`
+	Mainbranch  *branchInput `json:"mainbranch,omitempty"`
+}
+
+type branchInput struct {
+	Name string `json:"name"`
+}
+
+type forkInput struct {
+	Name      string          `json:"name,omitempty"`
+	Workspace *workspaceInput `json:"workspace,omitempty"`
+}
+
+type workspaceInput struct {
+	Slug string `json:"slug"`
+}
+
 type bitbucketPermission string
 
 const (
@@ -284,6 +311,119 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Create creates a new repository.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// bitbucket requires the workspace, which defaults to
+	// the workspace of the authenticated user.
+	namespace := input.Namespace
+	if namespace == "" {
+		user, res, err := s.client.Users.Find(ctx)
+		if err != nil {
+			return nil, res, err
+		}
+		namespace = user.Login
+	}
+	repo := scm.Join(namespace, input.Name)
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	in := &repositoryInput{
+		SCM:         "git",
+		Name:        input.Name,
+		Description: input.Description,
+		IsPrivate:   input.Visibility != scm.VisibilityPublic,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil || !input.AutoInit {
+		return convertRepository(out), res, err
+	}
+
+	// bitbucket does not initialize repositories, so a
+	// readme is committed to create the default branch.
+	params := &scm.ContentParams{
+		Branch:  input.Branch,
+		Message: "Initial commit",
+		Data:    []byte("# " + input.Name + "\n"),
+	}
+	res, err = s.client.Contents.Create(ctx, repo, "README.md", params)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.Find(ctx, repo)
+}
+
+// Fork forks a repository.
+func (s *repositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/forks", repo)
+	in := &forkInput{Name: input.Name}
+	if input.Namespace != "" {
+		in.Workspace = &workspaceInput{Slug: input.Namespace}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates a repository.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	if input.Archived != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	in := &repositoryUpdateInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		private := input.Visibility != scm.VisibilityPublic
+		in.IsPrivate = &private
+	}
+	if input.Branch != "" {
+		in.Mainbranch = &branchInput{Name: input.Branch}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Delete deletes a repository.
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Transfer transfers a repository to a workspace.
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListCollaborators returns the users with explicit
+// permission to the repository.
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/permissions-config/users?%s", repo, encodeListOptions(opts))
+	out := new(collaborators)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCollaboratorList(out), res, err
+}
+
+// AddCollaborator grants a user permission to the
+// repository. The user is identified by account id.
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/permissions-config/users/%s", repo, username)
+	in := &permissionInput{Permission: convertFromPermission(perm)}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// RemoveCollaborator revokes a user permission to the
+// repository. The user is identified by account id.
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/permissions-config/users/%s", repo, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -493,3 +633,48 @@ func convertFromState(from scm.State) string {
 		return "FAILED"
 	}
 }
+
+type collaborators struct {
+	pagination
+	Values []*collaborator `json:"values"`
+}
+
+type collaborator struct {
+	Permission string `json:"permission"`
+	User       user   `json:"user"`
+}
+
+func convertCollaboratorList(from *collaborators) []*scm.Collaborator {
+	to := []*scm.Collaborator{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Collaborator{
+			User:       *convertUser(&v.User),
+			Permission: convertPermission(v.Permission),
+		})
+	}
+	return to
+}
+
+func convertPermission(from string) scm.Permission {
+	switch from {
+	case "admin":
+		return scm.PermissionAdmin
+	case "write":
+		return scm.PermissionWrite
+	case "read":
+		return scm.PermissionRead
+	default:
+		return scm.PermissionUndefined
+	}
+}
+
+func convertFromPermission(from scm.Permission) string {
+	switch from {
+	case scm.PermissionAdmin:
+		return "admin"
+	case scm.PermissionWrite:
+		return "write"
+	default:
+		return "read"
+	}
+}
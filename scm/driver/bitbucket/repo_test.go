@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -61,6 +62,9 @@ func TestRepositoryFind_NotFound(t *testing.T) {
 	if got, want := err.Error(), "Repository dev/null not found"; got != want {
 		t.Errorf("Want error message %q, got %q", want, got)
 	}
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Expect error matches scm.ErrNotFound")
+	}
 }
 
 func TestRepositoryPerms(t *testing.T) {
@@ -407,6 +411,262 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin").
+		BodyString(`{"scm":"git","name":"stash-example-plugin","is_private":true}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/src").
+		Reply(201)
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:  "atlassian",
+		Name:       "stash-example-plugin",
+		Visibility: scm.VisibilityPrivate,
+		Branch:     "master",
+		AutoInit:   true,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestRepositoryCreate_UserNamespace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/stash-example-plugin").
+		BodyString(`{"scm":"git","name":"stash-example-plugin","is_private":false}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Name:       "stash-example-plugin",
+		Visibility: scm.VisibilityPublic,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestRepositoryCreate_Template(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.RepositoryInput{
+		Namespace: "atlassian",
+		Name:      "stash-example-plugin",
+		Template:  "atlassian/template",
+	}
+	_, _, err := client.Repositories.Create(context.Background(), input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brydzewski/stash-example-plugin/forks").
+		BodyString(`{"workspace":{"slug":"atlassian"}}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Fork(context.Background(), "brydzewski/stash-example-plugin", &scm.ForkInput{Namespace: "atlassian"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin").
+		BodyString(`{"description":"example plugin","is_private":true,"mainbranch":{"name":"master"}}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryUpdateInput{
+		Description: "example plugin",
+		Visibility:  scm.VisibilityPrivate,
+		Branch:      "master",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.Update(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate_Archived(t *testing.T) {
+	archived := true
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Repositories.Update(context.Background(), "atlassian/stash-example-plugin", &scm.RepositoryUpdateInput{Archived: &archived})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.Delete(context.Background(), "atlassian/stash-example-plugin")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Repositories.Transfer(context.Background(), "atlassian/stash-example-plugin", "brydzewski")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryListCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/permissions-config/users").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Repositories.ListCollaborators(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/permissions-config/users/557058:2a6349dc").
+		BodyString(`{"permission":"read"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.AddCollaborator(context.Background(), "atlassian/stash-example-plugin", "557058:2a6349dc", scm.PermissionRead)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/permissions-config/users/557058:2a6349dc").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.RemoveCollaborator(context.Background(), "atlassian/stash-example-plugin", "557058:2a6349dc")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -6,6 +6,9 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -23,9 +26,296 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := convertReviewCommentInput(input)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	// reviews are represented by the pull request
+	// participants that approved or requested changes.
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertParticipantList(out.Participants), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	var res *scm.Response
+	var err error
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	for _, v := range input.Comments {
+		in := convertReviewCommentInput(v)
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if input.Body != "" {
+		in := new(prCommentInput)
+		in.Content.Raw = input.Body
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	switch input.State {
+	case scm.ReviewStateApproved:
+		path = fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/approve", repo, number)
+	case scm.ReviewStateChangesRequested:
+		path = fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/request-changes", repo, number)
+	default:
+		return &scm.PullRequestReview{
+			Body:  input.Body,
+			State: input.State,
+			Sha:   input.Sha,
+		}, res, nil
+	}
+	out := new(participant)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertParticipant(out)
+	to.Body = input.Body
+	to.Sha = input.Sha
+	return to, res, nil
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	// bitbucket only permits users to remove their own
+	// approval.
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	// threads are represented by the inline comments and
+	// their replies, which reference the parent comment.
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := new(reviewComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	err = copyPagination(out.pagination, res)
+	return convertReviewThreadList(out.Values), res, err
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	id, err := strconv.Atoi(thread)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := new(prReplyInput)
+	in.Content.Raw = input.Body
+	in.Parent.ID = id
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out), res, err
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%s/resolve", repo, number, thread)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%s/resolve", repo, number, thread)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type prParticipants struct {
+	Participants []*participant `json:"participants"`
+}
+
+type participant struct {
+	User           user      `json:"user"`
+	Role           string    `json:"role"`
+	Approved       bool      `json:"approved"`
+	State          string    `json:"state"`
+	ParticipatedOn time.Time `json:"participated_on"`
+}
+
+type prReviewCommentInput struct {
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Inline struct {
+		Path      string `json:"path"`
+		From      int    `json:"from,omitempty"`
+		To        int    `json:"to,omitempty"`
+		StartFrom int    `json:"start_from,omitempty"`
+		StartTo   int    `json:"start_to,omitempty"`
+	} `json:"inline"`
+}
+
+type prReplyInput struct {
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Parent struct {
+		ID int `json:"id"`
+	} `json:"parent"`
+}
+
+type reviewComments struct {
+	pagination
+	Values []*reviewComment `json:"values"`
+}
+
+type reviewComment struct {
+	ID      int `json:"id"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Inline *struct {
+		Path      string `json:"path"`
+		From      int    `json:"from"`
+		To        int    `json:"to"`
+		StartFrom int    `json:"start_from"`
+		StartTo   int    `json:"start_to"`
+	} `json:"inline"`
+	Parent *struct {
+		ID int `json:"id"`
+	} `json:"parent"`
+	Resolution *struct {
+		Type string `json:"type"`
+	} `json:"resolution"`
+	Deleted bool `json:"deleted"`
+	User    user `json:"user"`
+	Links   struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+func convertParticipantList(from []*participant) []*scm.PullRequestReview {
+	to := []*scm.PullRequestReview{}
+	for _, v := range from {
+		if review := convertParticipant(v); review.State != scm.ReviewStateUnknown {
+			to = append(to, review)
+		}
+	}
+	return to
+}
+
+func convertParticipant(from *participant) *scm.PullRequestReview {
+	return &scm.PullRequestReview{
+		State: convertReviewState(from.State, from.Approved),
+		Author: scm.User{
+			ID:     from.User.AccountID,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
+		Submitted: from.ParticipatedOn,
+	}
+}
+
+func convertReviewState(state string, approved bool) scm.ReviewState {
+	switch {
+	case state == "approved", approved:
+		return scm.ReviewStateApproved
+	case state == "changes_requested":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// convertReviewCommentInput returns the inline comment input.
+// The from line is used for comments on the left side of the
+// diff, and the to line for comments on the right side.
+func convertReviewCommentInput(from *scm.ReviewInput) *prReviewCommentInput {
+	to := new(prReviewCommentInput)
+	to.Content.Raw = from.Body
+	to.Inline.Path = from.Path
+	start := 0
+	if from.StartLine != from.Line {
+		start = from.StartLine
+	}
+	if from.Side == scm.ReviewSideLeft {
+		to.Inline.From = from.Line
+		to.Inline.StartFrom = start
+	} else {
+		to.Inline.To = from.Line
+		to.Inline.StartTo = start
+	}
+	return to
+}
+
+// convertReviewThreadList groups the inline comments by the
+// root comment of the thread. Replies are matched to the
+// root comment by walking the parent comments.
+func convertReviewThreadList(from []*reviewComment) []*scm.ReviewThread {
+	comments := map[int]*reviewComment{}
+	for _, v := range from {
+		comments[v.ID] = v
+	}
+	threads := map[int]*scm.ReviewThread{}
+	to := []*scm.ReviewThread{}
+	for _, v := range from {
+		root := v
+		for root.Parent != nil && comments[root.Parent.ID] != nil {
+			root = comments[root.Parent.ID]
+		}
+		if root.Inline == nil {
+			continue
+		}
+		thread, ok := threads[root.ID]
+		if !ok {
+			first := convertReviewComment(root)
+			thread = &scm.ReviewThread{
+				ID:        strconv.Itoa(root.ID),
+				Path:      first.Path,
+				Line:      first.Line,
+				StartLine: first.StartLine,
+				Side:      first.Side,
+				Resolved:  root.Resolution != nil,
+				Comments:  []*scm.Review{},
+			}
+			threads[root.ID] = thread
+			to = append(to, thread)
+		}
+		if !v.Deleted {
+			thread.Comments = append(thread.Comments, convertReviewComment(v))
+		}
+	}
+	return to
+}
+
+func convertReviewComment(from *reviewComment) *scm.Review {
+	to := &scm.Review{
+		ID:   from.ID,
+		Body: from.Content.Raw,
+		Link: from.Links.HTML.Href,
+		Author: scm.User{
+			ID:     from.User.AccountID,
+			Login:  from.User.Nickname,
+			Name:   from.User.DisplayName,
+			Avatar: from.User.Links.Avatar.Href,
+		},
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+	if inline := from.Inline; inline != nil {
+		to.Path = inline.Path
+		if inline.To != 0 {
+			to.Line = inline.To
+			to.StartLine = inline.StartTo
+			to.Side = scm.ReviewSideRight
+		} else {
+			to.Line = inline.From
+			to.StartLine = inline.StartFrom
+			to.Side = scm.ReviewSideLeft
+		}
+	}
+	return to
+}
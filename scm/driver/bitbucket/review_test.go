@@ -6,9 +6,14 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -26,9 +31,43 @@ func TestReviewList(t *testing.T) {
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Nit: typo"},
+			"inline": map[string]interface{}{
+				"path":     "README.md",
+				"to":       12,
+				"start_to": 10,
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Nit: typo",
+		Path:      "README.md",
+		Line:      12,
+		StartLine: 10,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Create(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -38,3 +77,199 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participants.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.ListReviews(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/participants.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Nit: typo"},
+			"inline":  map[string]interface{}{"path": "README.md", "to": 6},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "LGTM"},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/approve").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.PullRequestReviewInput{
+		Body:  "LGTM",
+		State: scm.ReviewStateApproved,
+		Comments: []*scm.ReviewInput{
+			{Path: "README.md", Line: 6, Body: "Nit: typo"},
+		},
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comments created and pull request approved")
+	}
+
+	want := new(scm.PullRequestReview)
+	raw, _ := ioutil.ReadFile("testdata/participant.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit_ChangesRequested(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/request-changes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.PullRequestReviewInput{
+		State: scm.ReviewStateChangesRequested,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Submit(context.Background(), "atlassian/atlaskit", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect changes requested")
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	_, _, err := NewDefault().Reviews.Dismiss(context.Background(), "", 0, 0, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		MatchParam("pagelen", "30").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.ListThreads(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Fixed, thanks"},
+			"parent":  map[string]int{"id": 451112853},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Reply(context.Background(), "atlassian/atlaskit", 1, "451112853", &scm.CommentInput{Body: "Fixed, thanks"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reply created")
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/451112853/resolve").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Resolve(context.Background(), "atlassian/atlaskit", 1, "451112853")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comment thread resolved")
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/451112853/resolve").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Unresolve(context.Background(), "atlassian/atlaskit", 1, "451112853")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comment thread unresolved")
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// secretService implements the SecretService using Bitbucket
+// Pipelines variables. Variables are updated and deleted by
+// uuid, which is found by name.
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.list(ctx, path, opts)
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.create(ctx, path, input)
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.update(ctx, path, input)
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pipelines_config/variables/", repo)
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, workspace string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/", workspace)
+	return s.list(ctx, path, opts)
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, workspace string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/", workspace)
+	return s.create(ctx, path, input)
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, workspace string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/", workspace)
+	return s.update(ctx, path, input)
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, workspace, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/pipelines-config/variables/", workspace)
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path, res, err := s.envPath(ctx, repo, env)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.list(ctx, path, opts)
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path, res, err := s.envPath(ctx, repo, env)
+	if err != nil {
+		return res, err
+	}
+	return s.create(ctx, path, input)
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path, res, err := s.envPath(ctx, repo, env)
+	if err != nil {
+		return res, err
+	}
+	return s.update(ctx, path, input)
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	path, res, err := s.envPath(ctx, repo, env)
+	if err != nil {
+		return res, err
+	}
+	return s.delete(ctx, path, name)
+}
+
+func (s *secretService) list(ctx context.Context, path string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	out := new(variables)
+	res, err := s.client.do(ctx, "GET", path+"?"+encodeListOptions(opts), nil, out)
+	copyPagination(out.pagination, res)
+	return convertVariableList(out), res, err
+}
+
+func (s *secretService) create(ctx context.Context, path string, input *scm.SecretInput) (*scm.Response, error) {
+	in := &variableInput{
+		Key:     input.Name,
+		Value:   input.Value,
+		Secured: input.Masked,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *secretService) update(ctx context.Context, path string, input *scm.SecretInput) (*scm.Response, error) {
+	uuid, res, err := s.find(ctx, path, input.Name)
+	if err != nil {
+		return res, err
+	}
+	in := &variableInput{
+		Key:     input.Name,
+		Value:   input.Value,
+		Secured: input.Masked,
+	}
+	return s.client.do(ctx, "PUT", path+uuid, in, nil)
+}
+
+func (s *secretService) delete(ctx context.Context, path, name string) (*scm.Response, error) {
+	uuid, res, err := s.find(ctx, path, name)
+	if err != nil {
+		return res, err
+	}
+	return s.client.do(ctx, "DELETE", path+uuid, nil, nil)
+}
+
+// helper function returns the uuid of the named variable,
+// requesting each page of variables until it is found.
+func (s *secretService) find(ctx context.Context, path, name string) (string, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out := new(variables)
+		res, err := s.client.do(ctx, "GET", path+"?"+encodeListOptions(opts), nil, out)
+		if err != nil {
+			return "", res, err
+		}
+		for _, v := range out.Values {
+			if v.Key == name {
+				return v.UUID, res, nil
+			}
+		}
+		if out.Next == "" {
+			return "", res, scm.ErrNotFound
+		}
+		opts.Page++
+	}
+}
+
+// helper function returns the variables path of the named
+// deployment environment. Environments are identified by
+// uuid, which is found by name.
+func (s *secretService) envPath(ctx context.Context, repo, env string) (string, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/environments/?pagelen=100", repo)
+	out := new(environments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return "", res, err
+	}
+	for _, v := range out.Values {
+		if v.Name == env {
+			path = fmt.Sprintf("2.0/repositories/%s/deployments_config/environments/%s/variables/", repo, v.UUID)
+			return path, res, nil
+		}
+	}
+	return "", res, scm.ErrNotFound
+}
+
+type variables struct {
+	pagination
+	Values []*variable `json:"values"`
+}
+
+type variable struct {
+	UUID    string `json:"uuid"`
+	Key     string `json:"key"`
+	Secured bool   `json:"secured"`
+}
+
+type variableInput struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+type environments struct {
+	pagination
+	Values []*environment `json:"values"`
+}
+
+type environment struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+func convertVariableList(from *variables) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Secret{
+			Name:   v.Key,
+			Masked: v.Secured,
+		})
+	}
+	return to
+}
@@ -0,0 +1,160 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Secrets.ListRepoSecrets(context.Background(), "atlassian/stash-example-plugin", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/variables.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		BodyString(`{"key":"AWS_SECRET_ACCESS_KEY","value":"s3cr3t","secured":true}`).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.CreateRepoSecret(context.Background(), "atlassian/stash-example-plugin", &scm.SecretInput{Name: "AWS_SECRET_ACCESS_KEY", Value: "s3cr3t", Masked: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestSecretUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/{2f0e1f73-0c1c-4d53-9b59-3a4c7a2ddc19}").
+		BodyString(`{"key":"AWS_SECRET_ACCESS_KEY","value":"s3cr3t","secured":true}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.UpdateRepoSecret(context.Background(), "atlassian/stash-example-plugin", &scm.SecretInput{Name: "AWS_SECRET_ACCESS_KEY", Value: "s3cr3t", Masked: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestSecretDeleteNotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pipelines_config/variables/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.DeleteRepoSecret(context.Background(), "atlassian/stash-example-plugin", "UNKNOWN")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestOrgSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/pipelines-config/variables/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/variables.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Secrets.ListOrgSecrets(context.Background(), "atlassian", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/variables.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestEnvSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/environments/").
+		Reply(200).
+		Type("application/json").
+		File("testdata/environments.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/deployments_config/environments/{5b8e2c3f-7e1d-4a25-8d6c-61a3d4a5b7f0}/variables/").
+		BodyString(`{"key":"AWS_SECRET_ACCESS_KEY","value":"s3cr3t","secured":true}`).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Secrets.CreateEnvSecret(context.Background(), "atlassian/stash-example-plugin", "Production", &scm.SecretInput{Name: "AWS_SECRET_ACCESS_KEY", Value: "s3cr3t", Masked: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -73,6 +73,14 @@ type emails struct {
 	Values []*email `json:"values"`
 }
 
+func convertUserList(from []*user) []*scm.User {
+	to := []*scm.User{}
+	for _, v := range from {
+		to = append(to, convertUser(v))
+	}
+	return to
+}
+
 func convertUser(from *user) *scm.User {
 	return &scm.User{
 		Avatar: fmt.Sprintf("https://bitbucket.org/account/%s/avatar/32/", from.Username),
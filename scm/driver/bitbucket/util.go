@@ -5,6 +5,7 @@
 package bitbucket
 
 import (
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -149,3 +150,17 @@ func copyPagination(from pagination, to *scm.Response) error {
 	to.Page.Next, _ = strconv.Atoi(page)
 	return nil
 }
+
+func encodeCheckListOptions(opts scm.CheckListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	if opts.Name != "" {
+		params.Set("q", fmt.Sprintf("title=%q", opts.Name))
+	}
+	return params.Encode()
+}
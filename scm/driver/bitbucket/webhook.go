@@ -72,6 +72,14 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		if hook != nil {
 			hook.(*scm.IssueCommentHook).Action = scm.ActionDelete
 		}
+	case "pullrequest:approved":
+		hook, err = s.parsePullRequestReviewHook(data, scm.ActionSubmit, scm.ReviewStateApproved)
+	case "pullrequest:unapproved":
+		hook, err = s.parsePullRequestReviewHook(data, scm.ActionDismiss, scm.ReviewStateDismissed)
+	case "pullrequest:changes_request_created":
+		hook, err = s.parsePullRequestReviewHook(data, scm.ActionSubmit, scm.ReviewStateChangesRequested)
+	case "pullrequest:changes_request_removed":
+		hook, err = s.parsePullRequestReviewHook(data, scm.ActionDismiss, scm.ReviewStateDismissed)
 	case "repo:commit_status_updated", "repo:commit_status_created":
 		hook, err = s.parsePipelineHook(data)
 	}
@@ -142,6 +150,18 @@ func (s *webhookService) parsePullRequestHook(data []byte) (*scm.PullRequestHook
 	}
 }
 
+func (s *webhookService) parsePullRequestReviewHook(data []byte, action scm.Action, state scm.ReviewState) (*scm.PullRequestReviewHook, error) {
+	dst := new(prReviewHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	hook := convertPullRequestReviewHook(dst)
+	hook.Action = action
+	hook.Review.State = state
+	return hook, nil
+}
+
 func (s *webhookService) parsePipelineHook(data []byte) (*scm.PipelineHook, error) {
 	dst := new(pipelineHook)
 	err := json.Unmarshal(data, dst)
@@ -382,6 +402,19 @@ type (
 		Actor       webhookActor      `json:"actor"`
 	}
 
+	prReviewHook struct {
+		PullRequest    pr                `json:"pullrequest"`
+		Repository     webhookRepository `json:"repository"`
+		Actor          webhookActor      `json:"actor"`
+		Approval       *prReviewEvent    `json:"approval"`
+		ChangesRequest *prReviewEvent    `json:"changes_request"`
+	}
+
+	prReviewEvent struct {
+		Date time.Time    `json:"date"`
+		User webhookActor `json:"user"`
+	}
+
 	webhookRepository struct {
 		Scm   string `json:"scm"`
 		Name  string `json:"name"`
@@ -926,6 +959,37 @@ func convertPullRequestHook(src *webhook) *scm.PullRequestHook {
 	}
 }
 
+func convertPullRequestReviewHook(src *prReviewHook) *scm.PullRequestReviewHook {
+	from := convertPullRequestHook(&webhook{
+		PullRequest: src.PullRequest,
+		Repository:  src.Repository,
+		Actor:       src.Actor,
+	})
+	dst := &scm.PullRequestReviewHook{
+		Repo:        from.Repo,
+		PullRequest: from.PullRequest,
+		Sender:      from.Sender,
+		Review: scm.PullRequestReview{
+			Sha:    src.PullRequest.Source.Commit.Hash,
+			Author: from.Sender,
+		},
+	}
+	event := src.Approval
+	if event == nil {
+		event = src.ChangesRequest
+	}
+	if event != nil {
+		dst.Review.Submitted = event.Date
+		dst.Review.Author = scm.User{
+			ID:     event.User.UUID,
+			Login:  event.User.Username,
+			Name:   event.User.DisplayName,
+			Avatar: event.User.Links.Avatar.Href,
+		}
+	}
+	return dst
+}
+
 func convertPrCommentHook(src *prCommentHook) *scm.IssueCommentHook {
 	namespace, _ := scm.Split(src.Repository.FullName)
 	dst := scm.IssueCommentHook{
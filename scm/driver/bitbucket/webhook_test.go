@@ -100,6 +100,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_updated.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pullrequest:approved",
+			before: "testdata/webhooks/pr_approved.json",
+			after:  "testdata/webhooks/pr_approved.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
 		// pull request fulfilled (merged)
 		{
 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
@@ -0,0 +1,117 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// checksService implements the ChecksService using Gitea
+// commit statuses. The check run name is the status
+// context. Gitea does not support check suites or
+// annotations, and a commit status is updated by creating
+// a new status with the same context for the commit. Gitea
+// cannot filter statuses by context, so the name filter is
+// applied to each page of results.
+type checksService struct {
+	client *wrapper
+}
+
+type commitStatus struct {
+	ID          int64     `json:"id"`
+	State       string    `json:"status"`
+	TargetURL   string    `json:"target_url"`
+	Description string    `json:"description"`
+	Context     string    `json:"context"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/statuses/%s?%s", repo, ref, encodeListOptions(scm.ListOptions{Page: opts.Page, Size: opts.Size}))
+	out := []*commitStatus{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitStatusList(out, ref, opts.Name), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/statuses/%s", repo, input.HeadSHA)
+	in := &statusInput{
+		State:     convertFromState(input.State),
+		TargetURL: input.DetailsURL,
+		Context:   input.Name,
+	}
+	if output := input.Output; output != nil {
+		in.Description = output.Title
+		if in.Description == "" {
+			in.Description = output.Summary
+		}
+	}
+	out := new(commitStatus)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommitStatus(out, input.HeadSHA), res, err
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	return s.CreateCheckRun(ctx, repo, input)
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func convertCommitStatusList(from []*commitStatus, sha, name string) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from {
+		if name != "" && v.Context != name {
+			continue
+		}
+		to = append(to, convertCommitStatus(v, sha))
+	}
+	return to
+}
+
+func convertCommitStatus(from *commitStatus, sha string) *scm.CheckRun {
+	to := &scm.CheckRun{
+		ID:         strconv.FormatInt(from.ID, 10),
+		Name:       from.Context,
+		HeadSHA:    sha,
+		DetailsURL: from.TargetURL,
+		State:      convertState(from.State),
+		Started:    from.CreatedAt,
+		Completed:  from.UpdatedAt,
+	}
+	if from.Description != "" {
+		to.Output = &scm.CheckRunOutput{
+			Title: from.Description,
+		}
+	}
+	return to
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		BodyString(`{"state":"success","target_url":"https://example.com","description":"Build has completed successfully","context":"continuous-integration/drone"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	in := &scm.CheckRunInput{
+		Name:       "continuous-integration/drone",
+		HeadSHA:    "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		DetailsURL: "https://example.com",
+		State:      scm.StateSuccess,
+		Output: &scm.CheckRunOutput{
+			Title: "Build has completed successfully",
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Checks.CreateCheckRun(context.Background(), "jcitizen/my-repo", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
+	client, _ := New("https://try.gitea.io")
+	opts := scm.CheckListOptions{Page: 1, Size: 30}
+	got, _, err := client.Checks.ListCheckRuns(context.Background(), "jcitizen/my-repo", "6dcb09b5b57875f334f61aebed695e2e4193db5e", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/check_runs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCheckSuites(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Checks.ListCheckSuites(context.Background(), "jcitizen/my-repo", "master", scm.CheckListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -7,7 +7,11 @@ package gitea
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -26,6 +30,28 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v1/repos/%s/raw/%s/%s", repo, scm.TrimRef(ref), path)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	// the etag of the raw response is the blob sha, and the
+	// commit sha is not returned.
+	return &scm.RawContent{
+		Path:   path,
+		Size:   size,
+		BlobID: strings.Trim(strings.TrimPrefix(res.Header.Get("ETag"), "W/"), `"`),
+		Body:   body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v1/repos/%s/archive/%s.%s", repo, scm.TrimRef(ref), format)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -38,6 +64,81 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return nil, scm.ErrNotSupported
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// gitea does not reject the file changes if the branch
+	// was updated, so the branch head is compared to the
+	// parent commit before committing.
+	ref := input.Branch
+	if input.Parent != "" {
+		branch, res, err := s.client.Git.FindBranch(ctx, repo, input.Branch)
+		if err != nil {
+			return nil, res, err
+		}
+		if branch.Sha != input.Parent {
+			return nil, res, scm.ErrConflict
+		}
+		ref = input.Parent
+	}
+
+	// the file changes require the sha of the blob being
+	// updated, deleted or moved, which is found in the tree
+	// of the branch.
+	current, res, err := s.client.GitData.FindTree(ctx, repo, ref, true)
+	if err != nil {
+		return nil, res, err
+	}
+	blobs := map[string]string{}
+	for _, v := range current.Entries {
+		blobs[v.Path] = v.Sha
+	}
+
+	in := &changeFilesInput{
+		Branch:    input.Branch,
+		Message:   input.Message,
+		Author:    convertIdentityInput(input.Author),
+		Committer: convertIdentityInput(input.Author),
+		Files:     []*changeFileInput{},
+	}
+	for _, v := range input.Actions {
+		file := &changeFileInput{
+			Path:    v.Path,
+			Content: base64.StdEncoding.EncodeToString(v.Data),
+		}
+		switch v.Action {
+		case scm.FileActionCreate:
+			file.Operation = "create"
+		case scm.FileActionUpdate:
+			file.Operation = "update"
+			file.Sha = blobs[v.Path]
+		case scm.FileActionDelete:
+			file.Operation = "delete"
+			file.Sha = blobs[v.Path]
+			file.Content = ""
+		case scm.FileActionMove:
+			// a moved file is updated from the previous path,
+			// and the content is preserved if not provided.
+			file.Operation = "update"
+			file.FromPath = v.PreviousPath
+			file.Sha = blobs[v.PreviousPath]
+			if v.Data == nil {
+				blob, res, err := s.client.GitData.FindBlob(ctx, repo, file.Sha)
+				if err != nil {
+					return nil, res, err
+				}
+				file.Content = base64.StdEncoding.EncodeToString(blob.Content)
+			}
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+		in.Files = append(in.Files, file)
+	}
+
+	path := fmt.Sprintf("api/v1/repos/%s/contents", repo)
+	out := new(changeFiles)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertFileCommit(&out.Commit), res, err
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s?ref=%s", repo, path, ref)
 	out := []*content{}
@@ -98,3 +98,119 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/trees/main").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/blobs/45b983be36b73c0788dc9cbcb76cbb80fc7bb057").
+		Reply(200).
+		Type("application/json").
+		File("testdata/blob.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents").
+		BodyString(`{"branch":"main","message":"update docs\n","author":{"name":"Jane Doe","email":"jane.doe@example.com"},"committer":{"name":"Jane Doe","email":"jane.doe@example.com"},"files":[{"operation":"update","path":"README.md","content":"IyBHaXRlYQ==","sha":"44b4fc6d56897b048c772eb4087f854f46256132"},{"operation":"update","path":"docs/guide.md","from_path":"docs/index.md","content":"Q29udGVudCBvZiB0aGUgYmxvYg==","sha":"45b983be36b73c0788dc9cbcb76cbb80fc7bb057"}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/file_changes.json")
+
+	input := &scm.CommitInput{
+		Branch:  "main",
+		Message: "update docs\n",
+		Author: scm.Signature{
+			Name:  "Jane Doe",
+			Email: "jane.doe@example.com",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionUpdate, Path: "README.md", Data: []byte("# Gitea")},
+			{Action: scm.FileActionMove, Path: "docs/guide.md", PreviousPath: "docs/index.md"},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Contents.Commit(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/file_changes.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/raw/master/README.md").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		SetHeader("ETag", `"3b18e512dba79e4c8300dd08aeb37f8e728b8dad"`).
+		BodyString("hello world\n")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Contents.FindRaw(context.Background(), "go-gitea/gitea", "README.md", "refs/heads/master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path:   "README.md",
+		Size:   12,
+		BlobID: "3b18e512dba79e4c8300dd08aeb37f8e728b8dad",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/archive/master.tar.gz").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client, _ := New("https://try.gitea.io")
+	rc, _, err := client.Contents.Archive(context.Background(), "go-gitea/gitea", "refs/heads/master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
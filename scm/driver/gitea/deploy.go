@@ -0,0 +1,39 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo string, id int64) (*scm.Deployment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListStatus(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo string, id int64, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
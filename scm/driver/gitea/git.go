@@ -21,6 +21,11 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return nil, scm.ErrNotSupported
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -36,6 +41,23 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommitInfo(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// gitea creates an annotated tag if a message is
+	// provided. The tagger is the authenticated user.
+	path := fmt.Sprintf("api/v1/repos/%s/tags", repo)
+	in := &tagInput{
+		TagName: params.Name,
+		Target:  params.Sha,
+		Message: params.Message,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	name = scm.TrimRef(name)
 	path := fmt.Sprintf("api/v1/repos/%s/git/refs/tags/%s", repo, url.PathEscape(name))
@@ -87,6 +109,10 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -134,6 +160,13 @@ type (
 			URL  string `json:"url"`
 		} `json:"object"`
 	}
+
+	// gitea tag input object.
+	tagInput struct {
+		TagName string `json:"tag_name"`
+		Target  string `json:"target"`
+		Message string `json:"message,omitempty"`
+	}
 )
 
 //
@@ -120,6 +120,32 @@ func TestGitFindBranch(t *testing.T) {
 	}
 }
 
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/branches/feature").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "go-gitea/gitea", "feature")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.UpdateRef(context.Background(), "go-gitea/gitea", &scm.ReferenceUpdateInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -175,6 +201,50 @@ func TestGitFindTag(t *testing.T) {
 	}
 }
 
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/tags").
+		BodyString(`{"tag_name":"v1.0.0","target":"f05f642b892d59a0a9ef6a31f6c905a24b5db13a","message":"Release 1.0.0"}`).
+		Reply(201).
+		Type("application/json")
+
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+		Message: "Release 1.0.0",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Git.DeleteTag(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestGitListTags(t *testing.T) {
 	defer gock.Off()
 
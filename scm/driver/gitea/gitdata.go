@@ -0,0 +1,262 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// gitDataService implements the GitDataService. Gitea
+// does not support creating git objects, so commits are
+// created from the tree entries using the file changes
+// endpoint.
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/git/blobs/%s", repo, sha)
+	out := new(blob)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBlob(out), res, nil
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/git/trees/%s", repo, sha)
+	if recursive {
+		path += "?recursive=true"
+	}
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTree(out), res, err
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	if input.Tree != "" || len(input.Parents) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+
+	// the file changes require the sha of the blob being
+	// updated or deleted, which is found in the tree of the
+	// parent commit.
+	ref := input.Branch
+	if len(input.Parents) != 0 {
+		ref = input.Parents[0]
+	}
+	current, res, err := s.FindTree(ctx, repo, ref, true)
+	if err != nil {
+		return nil, res, err
+	}
+	blobs := map[string]string{}
+	for _, v := range current.Entries {
+		blobs[v.Path] = v.Sha
+	}
+
+	in := &changeFilesInput{
+		Branch:    input.Branch,
+		Message:   input.Message,
+		Author:    convertIdentityInput(input.Author),
+		Committer: convertIdentityInput(input.Committer),
+		Files:     []*changeFileInput{},
+	}
+	if !input.Author.Date.IsZero() || !input.Committer.Date.IsZero() {
+		in.Dates = &commitDatesInput{
+			Author:    input.Author.Date,
+			Committer: input.Committer.Date,
+		}
+	}
+	for _, v := range input.Entries {
+		if v.Sha != "" && !v.Delete {
+			// entries that reference an existing blob
+			// cannot be written using file changes.
+			return nil, nil, scm.ErrNotSupported
+		}
+		file := &changeFileInput{
+			Path: v.Path,
+			Sha:  blobs[v.Path],
+		}
+		switch {
+		case v.Delete:
+			file.Operation = "delete"
+		case file.Sha != "":
+			file.Operation = "update"
+			file.Content = base64.StdEncoding.EncodeToString(v.Content)
+		default:
+			file.Operation = "create"
+			file.Content = base64.StdEncoding.EncodeToString(v.Content)
+		}
+		in.Files = append(in.Files, file)
+	}
+
+	path := fmt.Sprintf("api/v1/repos/%s/contents", repo)
+	out := new(changeFiles)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertFileCommit(&out.Commit), res, err
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea blob object.
+	blob struct {
+		Sha      string `json:"sha"`
+		Size     int64  `json:"size"`
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+	}
+
+	// gitea tree object.
+	tree struct {
+		Sha       string       `json:"sha"`
+		Tree      []*treeEntry `json:"tree"`
+		Truncated bool         `json:"truncated"`
+	}
+
+	// gitea tree entry object.
+	treeEntry struct {
+		Path string `json:"path"`
+		Mode string `json:"mode"`
+		Type string `json:"type"`
+		Sha  string `json:"sha"`
+		Size int64  `json:"size"`
+	}
+
+	// gitea file changes input object.
+	changeFilesInput struct {
+		Branch    string             `json:"branch,omitempty"`
+		Message   string             `json:"message"`
+		Author    *identityInput     `json:"author,omitempty"`
+		Committer *identityInput     `json:"committer,omitempty"`
+		Dates     *commitDatesInput  `json:"dates,omitempty"`
+		Files     []*changeFileInput `json:"files"`
+	}
+
+	// gitea file change input object.
+	changeFileInput struct {
+		Operation string `json:"operation"`
+		Path      string `json:"path"`
+		FromPath  string `json:"from_path,omitempty"`
+		Content   string `json:"content,omitempty"`
+		Sha       string `json:"sha,omitempty"`
+	}
+
+	// gitea identity input object.
+	identityInput struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	// gitea commit dates input object.
+	commitDatesInput struct {
+		Author    time.Time `json:"author"`
+		Committer time.Time `json:"committer"`
+	}
+
+	// gitea file changes response object.
+	changeFiles struct {
+		Commit fileCommit `json:"commit"`
+	}
+
+	// gitea file commit object.
+	fileCommit struct {
+		Sha     string `json:"sha"`
+		HTMLURL string `json:"html_url"`
+		Message string `json:"message"`
+		Author  struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+		Committer struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"committer"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertBlob(src *blob) *scm.Blob {
+	dst := &scm.Blob{
+		Sha:     src.Sha,
+		Size:    src.Size,
+		Content: []byte(src.Content),
+	}
+	if src.Encoding == "base64" {
+		dst.Content, _ = base64.StdEncoding.DecodeString(src.Content)
+	}
+	return dst
+}
+
+func convertTree(src *tree) *scm.Tree {
+	dst := &scm.Tree{
+		Sha:       src.Sha,
+		Entries:   []*scm.TreeEntry{},
+		Truncated: src.Truncated,
+	}
+	for _, v := range src.Tree {
+		dst.Entries = append(dst.Entries, &scm.TreeEntry{
+			Path: v.Path,
+			Mode: v.Mode,
+			Type: v.Type,
+			Sha:  v.Sha,
+			Size: v.Size,
+		})
+	}
+	return dst
+}
+
+func convertIdentityInput(src scm.Signature) *identityInput {
+	if src.Name == "" && src.Email == "" {
+		return nil
+	}
+	return &identityInput{
+		Name:  src.Name,
+		Email: src.Email,
+	}
+}
+
+func convertFileCommit(src *fileCommit) *scm.Commit {
+	return &scm.Commit{
+		Sha:     src.Sha,
+		Message: src.Message,
+		Link:    src.HTMLURL,
+		Author: scm.Signature{
+			Name:  src.Author.Name,
+			Email: src.Author.Email,
+			Date:  src.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  src.Committer.Name,
+			Email: src.Committer.Email,
+			Date:  src.Committer.Date,
+		},
+	}
+}
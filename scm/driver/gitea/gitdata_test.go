@@ -0,0 +1,131 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGitDataFindBlob(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15").
+		Reply(200).
+		Type("application/json").
+		File("testdata/blob.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.GitData.FindBlob(context.Background(), "go-gitea/gitea", "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Blob)
+	raw, _ := ioutil.ReadFile("testdata/blob.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDataFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/trees/c43399cad8766ee521b873a32c1652407c5a4630").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.GitData.FindTree(context.Background(), "go-gitea/gitea", "c43399cad8766ee521b873a32c1652407c5a4630", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitDataCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/trees/c43399cad8766ee521b873a32c1652407c5a4630").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents").
+		BodyString(`{"branch":"main","message":"update docs\n","author":{"name":"Jane Doe","email":"jane.doe@example.com"},"files":[{"operation":"update","path":"README.md","content":"IyBHaXRlYQ==","sha":"44b4fc6d56897b048c772eb4087f854f46256132"},{"operation":"create","path":"docs/hello.md","content":"aGVsbG8="},{"operation":"delete","path":"docs/index.md","sha":"45b983be36b73c0788dc9cbcb76cbb80fc7bb057"}]}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/file_changes.json")
+
+	input := &scm.GitCommitInput{
+		Message: "update docs\n",
+		Parents: []string{"c43399cad8766ee521b873a32c1652407c5a4630"},
+		Branch:  "main",
+		Author: scm.Signature{
+			Name:  "Jane Doe",
+			Email: "jane.doe@example.com",
+		},
+		Entries: []*scm.TreeEntryInput{
+			{Path: "README.md", Content: []byte("# Gitea")},
+			{Path: "docs/hello.md", Content: []byte("hello")},
+			{Path: "docs/index.md", Delete: true},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.GitData.CreateCommit(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/file_changes.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitDataCreateTree(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.GitData.CreateTree(context.Background(), "go-gitea/gitea", &scm.TreeInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -33,15 +34,23 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitea
 	client.Linker = &linker{base.String()}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -62,7 +71,16 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
-	if in != nil {
+	switch content := in.(type) {
+	case nil:
+	case *assetInput:
+		body, contentType, size := content.encode()
+		req.Body = body
+		req.ContentLength = size
+		req.Header = map[string][]string{
+			"Content-Type": {contentType},
+		}
+	default:
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in)
 		req.Header = map[string][]string{
@@ -78,12 +96,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		return res, errors.New(
-			http.StatusText(res.Status),
-		)
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -101,3 +120,72 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
+
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// download streams the file at the given url. The request is
+// authenticated only if the url has the same origin as the
+// API, so that credentials are never sent to a third-party
+// host. Other urls are requested without credentials using
+// the default http client.
+func (c *wrapper) download(ctx context.Context, rawurl string) (io.ReadCloser, *scm.Response, error) {
+	uri, err := c.BaseURL.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uri.Scheme == c.BaseURL.Scheme && uri.Host == c.BaseURL.Host {
+		return c.stream(ctx, "GET", rawurl)
+	}
+	client := &scm.Client{BaseURL: uri}
+	res, err := client.Do(ctx, &scm.Request{Method: "GET", Path: uri.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// newError returns an API error from the gitea error
+// response.
+func newError(res *scm.Response) error {
+	raw, _ := ioutil.ReadAll(res.Body)
+	from := new(struct {
+		Message string `json:"message"`
+	})
+	json.Unmarshal(raw, from)
+	return &scm.APIError{
+		Status:  res.Status,
+		Message: from.Message,
+		Header:  res.Header,
+		Raw:     raw,
+		Err: errors.New(
+			http.StatusText(res.Status),
+		),
+	}
+}
@@ -44,14 +44,50 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: input.Assignees,
+		Milestone: int64(input.Milestone),
+		Closed:    input.State == "closed",
+	}
+	if len(input.Labels) != 0 {
+		labels := &labelService{s.client}
+		ids, res, err := labels.findIDs(ctx, repo, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Labels = ids
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	// the issue labels cannot be edited with the issue, and
+	// are replaced before the issue is updated.
+	if input.Labels != nil {
+		labels := &labelService{s.client}
+		res, err := labels.SetIssueLabels(ctx, repo, number, input.Labels)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueEditInput{
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: int64(input.Milestone),
+		State:     input.State,
+	}
+	if input.Assignees != nil {
+		in.Assignees = &input.Assignees
+	}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
 	in := &issueCommentInput{
@@ -62,13 +98,31 @@ func (s *issueService) CreateComment(ctx context.Context, repo string, index int
 	return convertIssueComment(out), res, err
 }
 
+func (s *issueService) UpdateComment(ctx context.Context, repo string, index, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssueComment(out), res, err
+}
+
 func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments/%d", repo, index, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueEditInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueEditInput{State: "open"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -86,16 +140,18 @@ func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*sc
 type (
 	// gitea issue response object.
 	issue struct {
-		ID          int       `json:"id"`
-		Number      int       `json:"number"`
-		User        user      `json:"user"`
-		Title       string    `json:"title"`
-		Body        string    `json:"body"`
-		State       string    `json:"state"`
-		Labels      []string  `json:"labels"`
-		Comments    int       `json:"comments"`
-		Created     time.Time `json:"created_at"`
-		Updated     time.Time `json:"updated_at"`
+		ID          int        `json:"id"`
+		Number      int        `json:"number"`
+		User        user       `json:"user"`
+		Title       string     `json:"title"`
+		Body        string     `json:"body"`
+		State       string     `json:"state"`
+		Labels      []string   `json:"labels"`
+		Assignees   []user     `json:"assignees"`
+		Milestone   *milestone `json:"milestone"`
+		Comments    int        `json:"comments"`
+		Created     time.Time  `json:"created_at"`
+		Updated     time.Time  `json:"updated_at"`
 		PullRequest *struct {
 			Merged   bool        `json:"merged"`
 			MergedAt interface{} `json:"merged_at"`
@@ -104,8 +160,21 @@ type (
 
 	// gitea issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string   `json:"title"`
+		Body      string   `json:"body"`
+		Labels    []int64  `json:"labels,omitempty"`
+		Assignees []string `json:"assignees,omitempty"`
+		Milestone int64    `json:"milestone,omitempty"`
+		Closed    bool     `json:"closed,omitempty"`
+	}
+
+	// gitea issue edit request object.
+	issueEditInput struct {
+		Title     string    `json:"title,omitempty"`
+		Body      string    `json:"body,omitempty"`
+		Assignees *[]string `json:"assignees,omitempty"`
+		Milestone int64     `json:"milestone,omitempty"`
+		State     string    `json:"state,omitempty"`
 	}
 
 	// gitea issue comment response object.
@@ -137,15 +206,21 @@ func convertIssueList(from []*issue) []*scm.Issue {
 }
 
 func convertIssue(from *issue) *scm.Issue {
+	var assignees []scm.User
+	for _, v := range from.Assignees {
+		assignees = append(assignees, *convertUser(&v))
+	}
 	return &scm.Issue{
-		Number:  from.Number,
-		Title:   from.Title,
-		Body:    from.Body,
-		Link:    "", // TODO construct the link to the issue.
-		Closed:  from.State == "closed",
-		Author:  *convertUser(&from.User),
-		Created: from.Created,
-		Updated: from.Updated,
+		Number:    from.Number,
+		Title:     from.Title,
+		Body:      from.Body,
+		Link:      "", // TODO construct the link to the issue.
+		Closed:    from.State == "closed",
+		Author:    *convertUser(&from.User),
+		Assignees: assignees,
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.Created,
+		Updated:   from.Updated,
 	}
 }
 
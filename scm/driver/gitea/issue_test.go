@@ -99,11 +99,103 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		MatchParam("page", "1").
+		MatchParam("limit", "50").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id":1,"name":"bug","color":"ee0701"}]`)
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/issues/1/labels").
+		JSON(map[string][]int64{"labels": {1}}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`[]`)
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"assignees": []string{"janedoe"},
+			"milestone": 1,
+			"state":     "open",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Labels:    []string{"bug"},
+		Assignees: []string{"janedoe"},
+		Milestone: 1,
+		State:     "open",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.Update(context.Background(), "go-gitea/gitea", 1, &input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
@@ -189,6 +281,36 @@ func TestIssueCommentCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCommentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/comments/1").
+		JSON(map[string]string{"body": "what?"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/comment.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.UpdateComment(context.Background(), "go-gitea/gitea", 1, 1, &scm.CommentInput{Body: "what?"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueCommentDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,164 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys?%s", repo, encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	in := &keyInput{
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{ArmoredPublicKey: input.Key}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea public key object.
+	key struct {
+		ID       int       `json:"id"`
+		Title    string    `json:"title"`
+		Key      string    `json:"key"`
+		ReadOnly bool      `json:"read_only"`
+		Created  time.Time `json:"created_at"`
+	}
+
+	// gitea public key input object.
+	keyInput struct {
+		Title    string `json:"title"`
+		Key      string `json:"key"`
+		ReadOnly bool   `json:"read_only,omitempty"`
+	}
+
+	// gitea gpg key object.
+	gpgKey struct {
+		ID        int    `json:"id"`
+		KeyID     string `json:"key_id"`
+		PublicKey string `json:"public_key"`
+		Emails    []struct {
+			Email string `json:"email"`
+		} `json:"emails"`
+		Created time.Time `json:"created_at"`
+		Expires time.Time `json:"expires_at"`
+	}
+
+	// gitea gpg key input object.
+	gpgKeyInput struct {
+		ArmoredPublicKey string `json:"armored_public_key"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:       strconv.Itoa(src.ID),
+		Title:    src.Title,
+		Key:      src.Key,
+		ReadOnly: src.ReadOnly,
+		Created:  src.Created,
+	}
+}
+
+func convertGPGKeyList(src []*gpgKey) []*scm.GPGKey {
+	dst := []*scm.GPGKey{}
+	for _, v := range src {
+		dst = append(dst, convertGPGKey(v))
+	}
+	return dst
+}
+
+func convertGPGKey(src *gpgKey) *scm.GPGKey {
+	dst := &scm.GPGKey{
+		ID:      strconv.Itoa(src.ID),
+		KeyID:   src.KeyID,
+		Key:     src.PublicKey,
+		Emails:  []string{},
+		Created: src.Created,
+		Expires: src.Expires,
+	}
+	for _, v := range src.Emails {
+		dst.Emails = append(dst.Emails, v.Email)
+	}
+	return dst
+}
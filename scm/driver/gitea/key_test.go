@@ -0,0 +1,239 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.FindDeployKey(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/keys").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.ListDeployKeys(context.Background(), "go-gitea/gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/keys").
+		BodyString(`{"title":"drone","key":"ssh-rsa AAA...","read_only":true}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title:    "drone",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.CreateDeployKey(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/keys/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Keys.DeleteDeployKey(context.Background(), "go-gitea/gitea", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/keys").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.ListKeys(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/user/keys").
+		BodyString(`{"title":"drone","key":"ssh-rsa AAA..."}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title: "drone",
+		Key:   "ssh-rsa AAA...",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/gpg_keys").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/gpg_keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.ListGPGKeys(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := ioutil.ReadFile("testdata/gpg_keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/user/gpg_keys").
+		BodyString(`{"armored_public_key":"-----BEGIN PGP PUBLIC KEY BLOCK-----"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/gpg_key.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Keys.CreateGPGKey(context.Background(), &scm.GPGKeyInput{Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.GPGKey)
+	raw, _ := ioutil.ReadFile("testdata/gpg_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -43,6 +44,90 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrgList(out), res, err
 }
 
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/teams?%s", name, encodeListOptions(opts))
+	out := []*team{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, id string) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s", id)
+	out := new(team)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/teams", name)
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Permission:  convertFromPermission(input.Permission),
+	}
+	out := new(team)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, id string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.Permission != scm.PermissionUndefined {
+		in.Permission = convertFromPermission(input.Permission)
+	}
+	// gitea requires the team name, which is found using
+	// the current team when the team is not renamed.
+	if in.Name == "" {
+		current, res, err := s.FindTeam(ctx, name, id)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Name = current.Name
+	}
+	path := fmt.Sprintf("api/v1/teams/%s", id)
+	out := new(team)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s", id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, id string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s/members?%s", id, encodeListOptions(opts))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertUserList(out), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, id, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s/members/%s", id, username)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, id, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s/members/%s", id, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// AddTeamRepo adds the repository to the team. Gitea grants
+// the team permission to all team repositories, and the
+// permission is ignored.
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, id, repo string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s/repos/%s", id, repo)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, id, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s/repos/%s", id, repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type permissions struct {
 	IsOwner             bool `json:"is_owner"`
 	IsAdmin             bool `json:"is_admin"`
@@ -64,6 +149,19 @@ type org struct {
 	Avatar string `json:"avatar_url"`
 }
 
+type team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Permission  string `json:"permission"`
+}
+
+type teamInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Permission  string `json:"permission,omitempty"`
+}
+
 //
 // native data structure conversion
 //
@@ -83,6 +181,22 @@ func convertOrg(from *org) *scm.Organization {
 	}
 }
 
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          strconv.Itoa(from.ID),
+		Name:        from.Name,
+		Description: from.Description,
+	}
+}
+
 func (s *organizationService) checkMembership(ctx context.Context, name, username string) bool {
 	path := fmt.Sprintf("api/v1/orgs/%s/members/%s", name, username)
 	res, err := s.client.do(ctx, "GET", path, nil, nil)
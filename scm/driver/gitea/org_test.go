@@ -94,3 +94,245 @@ func TestOrgList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrgListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/go-gitea/teams").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "go-gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/teams.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgFindTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.FindTeam(context.Background(), "go-gitea", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgCreateTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/go-gitea/teams").
+		BodyString(`{"name":"developers","description":"Gitea developers","permission":"write"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.CreateTeam(context.Background(), "go-gitea", &scm.TeamInput{Name: "developers", Description: "Gitea developers", Permission: scm.PermissionWrite})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgUpdateTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/teams/2").
+		BodyString(`{"name":"developers","description":"Gitea developers"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.UpdateTeam(context.Background(), "go-gitea", "2", &scm.TeamInput{Description: "Gitea developers"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/team.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrgDeleteTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/teams/2").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.DeleteTeam(context.Background(), "go-gitea", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestOrgListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/2/members").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "go-gitea", "2", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.User{}
+	raw, _ := ioutil.ReadFile("testdata/members.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgAddTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/teams/2/members/jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.AddTeamMember(context.Background(), "go-gitea", "2", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrgRemoveTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/teams/2/members/jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "go-gitea", "2", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrgAddTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/teams/2/repos/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), "go-gitea", "2", "go-gitea/gitea", scm.PermissionWrite)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrgRemoveTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/teams/2/repos/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.RemoveTeamRepo(context.Background(), "go-gitea", "2", "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// Gitea Actions workflow runs. Gitea does not support
+// cancelling or re-running workflow runs using the api.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	// the workflow dispatch endpoint does not return the
+	// workflow run that is created.
+	path := fmt.Sprintf("api/v1/repos/%s/actions/workflows/%s/dispatches", repo, input.Pipeline)
+	in := &workflowDispatchInput{
+		Ref:    input.Ref,
+		Inputs: input.Inputs,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return nil, res, err
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/runs?%s", repo, encodePipelineListOptions(opts))
+	out := new(workflowRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertWorkflowRunList(out), res, err
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/runs/%s", repo, id)
+	out := new(workflowRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("api/v1/repos/%s/actions/runs/%s/jobs", repo, id)
+	jobs := new(workflowJobList)
+	res, err = s.client.do(ctx, "GET", path, nil, jobs)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertWorkflowRun(out)
+	run.Jobs = convertWorkflowJobList(jobs)
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/jobs/%s/logs", repo, job)
+	return s.client.stream(ctx, "GET", path)
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea workflow dispatch input object.
+	workflowDispatchInput struct {
+		Ref    string            `json:"ref"`
+		Inputs map[string]string `json:"inputs,omitempty"`
+	}
+
+	// gitea workflow run list object.
+	workflowRunList struct {
+		TotalCount   int            `json:"total_count"`
+		WorkflowRuns []*workflowRun `json:"workflow_runs"`
+	}
+
+	// gitea workflow run object.
+	workflowRun struct {
+		ID           int64     `json:"id"`
+		RunNumber    int       `json:"run_number"`
+		DisplayTitle string    `json:"display_title"`
+		Path         string    `json:"path"`
+		HeadBranch   string    `json:"head_branch"`
+		HeadSha      string    `json:"head_sha"`
+		Event        string    `json:"event"`
+		Status       string    `json:"status"`
+		Conclusion   string    `json:"conclusion"`
+		HTMLURL      string    `json:"html_url"`
+		StartedAt    time.Time `json:"started_at"`
+		CompletedAt  time.Time `json:"completed_at"`
+	}
+
+	// gitea workflow job list object.
+	workflowJobList struct {
+		TotalCount int            `json:"total_count"`
+		Jobs       []*workflowJob `json:"jobs"`
+	}
+
+	// gitea workflow job object.
+	workflowJob struct {
+		ID          int64     `json:"id"`
+		Name        string    `json:"name"`
+		Status      string    `json:"status"`
+		Conclusion  string    `json:"conclusion"`
+		HTMLURL     string    `json:"html_url"`
+		StartedAt   time.Time `json:"started_at"`
+		CompletedAt time.Time `json:"completed_at"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("branch", scm.TrimRef(opts.Ref))
+	}
+	return params.Encode()
+}
+
+// helper function returns the execution status of a workflow
+// run or job. Completed runs and jobs are converted using the
+// conclusion.
+func convertWorkflowStatus(status, conclusion string) scm.ExecutionStatus {
+	if status == "completed" {
+		return scm.ConvertExecutionStatus(conclusion)
+	}
+	return scm.ConvertExecutionStatus(status)
+}
+
+func convertWorkflowRunList(src *workflowRunList) []*scm.PipelineRun {
+	dst := []*scm.PipelineRun{}
+	for _, v := range src.WorkflowRuns {
+		dst = append(dst, convertWorkflowRun(v))
+	}
+	return dst
+}
+
+func convertWorkflowRun(src *workflowRun) *scm.PipelineRun {
+	return &scm.PipelineRun{
+		ID:       strconv.FormatInt(src.ID, 10),
+		Number:   src.RunNumber,
+		Pipeline: src.Path,
+		Ref:      src.HeadBranch,
+		Sha:      src.HeadSha,
+		Event:    src.Event,
+		Status:   convertWorkflowStatus(src.Status, src.Conclusion),
+		Link:     src.HTMLURL,
+		Created:  src.StartedAt,
+		Updated:  src.CompletedAt,
+	}
+}
+
+func convertWorkflowJobList(src *workflowJobList) []*scm.PipelineJob {
+	dst := []*scm.PipelineJob{}
+	for _, v := range src.Jobs {
+		dst = append(dst, &scm.PipelineJob{
+			ID:       strconv.FormatInt(v.ID, 10),
+			Name:     v.Name,
+			Status:   convertWorkflowStatus(v.Status, v.Conclusion),
+			Link:     v.HTMLURL,
+			Started:  v.StartedAt,
+			Finished: v.CompletedAt,
+		})
+	}
+	return dst
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/actions/workflows/build.yml/dispatches").
+		BodyString(`{"ref":"main","inputs":{"environment":"staging"}}`).
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Pipelines.Trigger(context.Background(), "go-gitea/gitea", &scm.PipelineInput{Pipeline: "build.yml", Ref: "main", Inputs: map[string]string{"environment": "staging"}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs").
+		MatchParam("branch", "main").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workflow_runs.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.ListRuns(context.Background(), "go-gitea/gitea", scm.PipelineListOptions{Ref: "refs/heads/main", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/workflow_runs.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs/42").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workflow_run.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/runs/42/jobs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workflow_jobs.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Pipelines.FindRun(context.Background(), "go-gitea/gitea", "42")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/workflow_run.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Pipelines.CancelRun(context.Background(), "go-gitea/gitea", "42")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/jobs/101/logs").
+		Reply(200).
+		Type("text/plain").
+		BodyString("2024-05-02T10:11:15.0000000Z Set up job\n")
+
+	client, _ := New("https://try.gitea.io")
+	rc, _, err := client.Pipelines.FindLogs(context.Background(), "go-gitea/gitea", "42", "101")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "2024-05-02T10:11:15.0000000Z Set up job\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+}
@@ -6,7 +6,11 @@ package gitea
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -73,14 +77,128 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.R
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, index int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	in := &mergeInput{
+		Do:                 "merge",
+		Title:              opts.CommitTitle,
+		Message:            opts.CommitMessage,
+		HeadCommitID:       opts.SHA,
+		DeleteBranchMerged: opts.DeleteSourceBranch,
+	}
+	switch opts.Method {
+	case scm.MergeMethodSquash:
+		in.Do = "squash"
+	case scm.MergeMethodRebase:
+		in.Do = "rebase"
+	case scm.MergeMethodFastForward:
+		in.Do = "fast-forward-only"
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, index)
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	return res, nil
+}
+
 func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prUpdateInput{State: "open"}
+	res, err := s.client.do(ctx, "PATCH", path, in, nil)
+	return res, err
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, index, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, index, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, index int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	in := &prUpdateInput{
+		Title: input.Title,
+		Body:  input.Body,
+		Base:  input.Target,
+	}
+	// the draft state is derived from the pull request
+	// title, which is required to change the draft state.
+	if input.Draft != nil {
+		if in.Title == "" {
+			current, res, err := s.Find(ctx, repo, index)
+			if err != nil {
+				return nil, res, err
+			}
+			in.Title = current.Title
+		}
+		in.Title = convertDraftTitle(in.Title, *input.Draft)
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	out := new(pr)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, index int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, index)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, index int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, index)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return pr.Reviewers, res, nil
+}
+
+func (s *pullService) SetAssignees(ctx context.Context, repo string, index int, users []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prAssigneesInput{Assignees: users}
+	if in.Assignees == nil {
+		in.Assignees = []string{}
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 //
 // native data structures
 //
 
+// draftPrefix matches the default title prefixes that mark
+// a pull request as work in progress.
+var draftPrefix = regexp.MustCompile(`(?i)^\s*(wip:|\[wip\])\s*`)
+
+type mergeInput struct {
+	Do                 string `json:"Do"`
+	Title              string `json:"MergeTitleField,omitempty"`
+	Message            string `json:"MergeMessageField,omitempty"`
+	HeadCommitID       string `json:"head_commit_id,omitempty"`
+	DeleteBranchMerged bool   `json:"delete_branch_after_merge,omitempty"`
+}
+
 type pr struct {
 	ID         int        `json:"id"`
 	Number     int        `json:"number"`
@@ -104,6 +222,8 @@ type pr struct {
 		Name  string `json:"name"`
 		Color string `json:"color"`
 	} `json:"labels"`
+	Assignees          []*user `json:"assignees"`
+	RequestedReviewers []*user `json:"requested_reviewers"`
 }
 
 type reference struct {
@@ -112,6 +232,22 @@ type reference struct {
 	Sha  string     `json:"sha"`
 }
 
+type prUpdateInput struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Base  string `json:"base,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+type prReviewersInput struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+type prAssigneesInput struct {
+	Assignees []string `json:"assignees"`
+}
+
 type prInput struct {
 	Title string `json:"title"`
 	Body  string `json:"body"`
@@ -139,23 +275,37 @@ func convertPullRequest(src *pr) *scm.PullRequest {
 			Color: label.Color,
 		})
 	}
+	var reviewers []scm.Reviewer
+	for _, v := range src.RequestedReviewers {
+		reviewers = append(reviewers, scm.Reviewer{
+			User:  *convertUser(v),
+			State: scm.ReviewStatePending,
+		})
+	}
+	var assignees []scm.User
+	for _, v := range src.Assignees {
+		assignees = append(assignees, *convertUser(v))
+	}
 	return &scm.PullRequest{
-		Number:  src.Number,
-		Title:   src.Title,
-		Body:    src.Body,
-		Sha:     src.Head.Sha,
-		Source:  src.Head.Name,
-		Target:  src.Base.Name,
-		Link:    src.HTMLURL,
-		Diff:    src.DiffURL,
-		Fork:    src.Base.Repo.FullName,
-		Ref:     fmt.Sprintf("refs/pull/%d/head", src.Number),
-		Closed:  src.State == "closed",
-		Author:  *convertUser(&src.User),
-		Merged:  src.Merged,
-		Created: src.Created,
-		Updated: src.Updated,
-		Labels:  labels,
+		Number:    src.Number,
+		Title:     src.Title,
+		Body:      src.Body,
+		Sha:       src.Head.Sha,
+		Source:    src.Head.Name,
+		Target:    src.Base.Name,
+		Link:      src.HTMLURL,
+		Diff:      src.DiffURL,
+		Fork:      src.Base.Repo.FullName,
+		Ref:       fmt.Sprintf("refs/pull/%d/head", src.Number),
+		Draft:     draftPrefix.MatchString(src.Title),
+		Closed:    src.State == "closed",
+		Author:    *convertUser(&src.User),
+		Merged:    src.Merged,
+		Created:   src.Created,
+		Updated:   src.Updated,
+		Labels:    labels,
+		Reviewers: reviewers,
+		Assignees: assignees,
 	}
 }
 
@@ -171,3 +321,26 @@ func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 		Updated: src.Updated,
 	}
 }
+
+// convertMergeError returns a MergeMethodError if the merge
+// failed because the merge style is not allowed for the
+// repository.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		apiErr.Status == http.StatusMethodNotAllowed &&
+		strings.Contains(strings.ToLower(apiErr.Message), "merge style") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
+}
+
+// convertDraftTitle adds or removes the work in progress
+// prefix from the pull request title.
+func convertDraftTitle(title string, draft bool) string {
+	title = draftPrefix.ReplaceAllString(title, "")
+	if draft {
+		return "WIP: " + title
+	}
+	return title
+}
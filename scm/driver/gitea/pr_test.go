@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -109,6 +110,148 @@ func TestPullRequestClose(t *testing.T) {
 	}
 }
 
+func TestPullRequestReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/pulls/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.Reopen(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullRequestUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		JSON(map[string]string{"base": "master"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := scm.PullRequestUpdateInput{
+		Target: "master",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.Update(context.Background(), "jcitizen/my-repo", 1, &input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestConvertToDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		JSON(map[string]string{"title": "WIP: Add License File"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.ConvertToDraft(context.Background(), "jcitizen/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect draft title updated")
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers":      {"jsmith"},
+			"team_reviewers": {"owners"},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+		Teams: []string{"owners"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers requested")
+	}
+}
+
+func TestPullRequestRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/jcitizen/my-repo/pulls/1/requested_reviewers").
+		JSON(map[string][]string{"reviewers": {"jsmith"}}).
+		Reply(204)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers removed")
+	}
+}
+
+func TestPullRequestSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		JSON(map[string][]string{"assignees": {"jsmith"}}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.SetAssignees(context.Background(), "jcitizen/my-repo", 1, []string{"jsmith"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect assignees updated")
+	}
+}
+
 func TestPullRequestMerge(t *testing.T) {
 	defer gock.Off()
 
@@ -124,6 +267,54 @@ func TestPullRequestMerge(t *testing.T) {
 	}
 }
 
+func TestPullRequestMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		JSON(map[string]interface{}{
+			"Do":                        "rebase",
+			"head_commit_id":            "2a8f2bb59f21e1ec2bb74fd2cdee1d7a8ad2d2b6",
+			"delete_branch_after_merge": true,
+		}).
+		Reply(200).
+		Type("application/json")
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodRebase,
+		SHA:                "2a8f2bb59f21e1ec2bb74fd2cdee1d7a8ad2d2b6",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "go-gitea/gitea", 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request merged")
+	}
+}
+
+func TestPullRequestMergeWithOptions_NotAllowed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		Reply(405).
+		Type("application/json").
+		BodyString(`{"message":"Invalid merge style","url":"https://try.gitea.io/api/swagger"}`)
+
+	opts := scm.PullRequestMergeOptions{Method: scm.MergeMethodSquash}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "go-gitea/gitea", 1, opts)
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+	}
+}
+
 //
 // pull request change sub-tests
 //
@@ -0,0 +1,128 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// protectionService implements the BranchProtectionService
+// using Gitea branch protections. Gitea never permits force
+// pushes to or deletion of a protected branch, and does not
+// support code owner reviews.
+type protectionService struct {
+	client *wrapper
+}
+
+type branchProtection struct {
+	BranchName              string   `json:"branch_name"`
+	RuleName                string   `json:"rule_name"`
+	EnablePush              bool     `json:"enable_push"`
+	EnablePushWhitelist     bool     `json:"enable_push_whitelist"`
+	PushWhitelistUsernames  []string `json:"push_whitelist_usernames"`
+	PushWhitelistTeams      []string `json:"push_whitelist_teams"`
+	EnableMergeWhitelist    bool     `json:"enable_merge_whitelist"`
+	MergeWhitelistUsernames []string `json:"merge_whitelist_usernames"`
+	MergeWhitelistTeams     []string `json:"merge_whitelist_teams"`
+	EnableStatusCheck       bool     `json:"enable_status_check"`
+	StatusCheckContexts     []string `json:"status_check_contexts"`
+	RequiredApprovals       int      `json:"required_approvals"`
+	DismissStaleApprovals   bool     `json:"dismiss_stale_approvals"`
+	BlockOnOutdatedBranch   bool     `json:"block_on_outdated_branch"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branch_protections/%s", repo, branch)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertBranchProtection(out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	in := convertFromBranchProtection(branch, input)
+	path := fmt.Sprintf("api/v1/repos/%s/branch_protections/%s", repo, branch)
+	out := new(branchProtection)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if errors.Is(err, scm.ErrNotFound) {
+		path = fmt.Sprintf("api/v1/repos/%s/branch_protections", repo)
+		res, err = s.client.do(ctx, "POST", path, in, out)
+	}
+	return convertBranchProtection(out), res, err
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/branch_protections/%s", repo, branch)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func convertBranchProtection(from *branchProtection) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch: from.RuleName,
+	}
+	if to.Branch == "" {
+		to.Branch = from.BranchName
+	}
+	switch {
+	case !from.EnablePush:
+		to.Push = new(scm.BranchRestrictions)
+	case from.EnablePushWhitelist:
+		to.Push = &scm.BranchRestrictions{
+			Users: from.PushWhitelistUsernames,
+			Teams: from.PushWhitelistTeams,
+		}
+	}
+	if from.EnableMergeWhitelist {
+		to.Merge = &scm.BranchRestrictions{
+			Users: from.MergeWhitelistUsernames,
+			Teams: from.MergeWhitelistTeams,
+		}
+	}
+	if from.EnableStatusCheck {
+		to.RequiredStatusChecks = &scm.RequiredStatusChecks{
+			Strict:   from.BlockOnOutdatedBranch,
+			Contexts: from.StatusCheckContexts,
+		}
+	}
+	if from.RequiredApprovals > 0 {
+		to.RequiredReviews = &scm.RequiredReviews{
+			Count:        from.RequiredApprovals,
+			DismissStale: from.DismissStaleApprovals,
+		}
+	}
+	return to
+}
+
+func convertFromBranchProtection(branch string, from *scm.BranchProtectionInput) *branchProtection {
+	to := &branchProtection{
+		BranchName: branch,
+		RuleName:   branch,
+		EnablePush: true,
+	}
+	if v := from.Push; v != nil {
+		to.EnablePushWhitelist = true
+		to.PushWhitelistUsernames = append([]string{}, v.Users...)
+		to.PushWhitelistTeams = append([]string{}, v.Teams...)
+		to.EnablePush = len(v.Users) != 0 || len(v.Teams) != 0
+	}
+	if v := from.Merge; v != nil {
+		to.EnableMergeWhitelist = true
+		to.MergeWhitelistUsernames = append([]string{}, v.Users...)
+		to.MergeWhitelistTeams = append([]string{}, v.Teams...)
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		to.EnableStatusCheck = true
+		to.StatusCheckContexts = append([]string{}, v.Contexts...)
+		to.BlockOnOutdatedBranch = v.Strict
+	}
+	if v := from.RequiredReviews; v != nil {
+		to.RequiredApprovals = v.Count
+		to.DismissStaleApprovals = v.DismissStale
+	}
+	return to
+}
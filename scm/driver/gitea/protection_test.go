@@ -0,0 +1,104 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.BranchProtections.Find(context.Background(), "go-gitea/gitea", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionUpdate_Create(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"Not Found"}`)
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branch_protections").
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch_protection.json")
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{
+			Count:        1,
+			DismissStale: true,
+		},
+		RequiredStatusChecks: &scm.RequiredStatusChecks{
+			Strict:   true,
+			Contexts: []string{"continuous-integration/drone"},
+		},
+		Push: &scm.BranchRestrictions{
+			Users: []string{"gitea"},
+			Teams: []string{"owners"},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.BranchProtections.Update(context.Background(), "go-gitea/gitea", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/branch_protection.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/branch_protections/master").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.BranchProtections.Delete(context.Background(), "go-gitea/gitea", "master")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -1,8 +1,15 @@
 package gitea
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -126,6 +133,105 @@ type Attachment struct {
 	DownloadURL   string    `json:"browser_download_url"`
 }
 
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("api/v1/repos/%s/%s/releases/%d/assets?%s", namespace, name, rel.ID, encodeListOptions(opts))
+	out := []*Attachment{}
+	res, err = s.client.do(ctx, "GET", path, nil, &out)
+	return convertAttachmentList(out), res, err
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("name", input.Name)
+	path := fmt.Sprintf("api/v1/repos/%s/%s/releases/%d/assets?%s", namespace, name, rel.ID, params.Encode())
+	in := &assetInput{
+		Name:        input.Name,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Body:        input.Body,
+	}
+	out := new(Attachment)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertAttachment(out), res, err
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("api/v1/repos/%s/%s/releases/%d/assets/%s", namespace, name, rel.ID, id)
+	out := new(Attachment)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.download(ctx, out.DownloadURL)
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("api/v1/repos/%s/%s/releases/%d/assets/%s", namespace, name, rel.ID, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// assetInput is the multipart body of a release asset
+// upload. The file is streamed and is not buffered in memory.
+type assetInput struct {
+	Name        string
+	ContentType string
+	Size        int64
+	Body        io.Reader
+}
+
+// encode returns the multipart body, the content type and
+// the content length of the upload. The content length is
+// zero, and the body is sent chunked, if the file size is
+// unknown.
+func (in *assetInput) encode() (io.Reader, string, int64) {
+	contentType := in.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+		"name":     "attachment",
+		"filename": in.Name,
+	}))
+	header.Set("Content-Type", contentType)
+
+	// the part headers and closing boundary are written to
+	// the buffer, and the file is streamed between them.
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	_, _ = w.CreatePart(header)
+	n := buf.Len()
+	w.Close()
+	head := bytes.NewReader(buf.Bytes()[:n])
+	tail := bytes.NewReader(buf.Bytes()[n:])
+
+	var size int64
+	if in.Size > 0 {
+		size = int64(buf.Len()) + in.Size
+	}
+	return io.MultiReader(head, in.Body, tail), w.FormDataContentType(), size
+}
+
 func convertRelease(src *release) *scm.Release {
 	return &scm.Release{
 		ID:          int(src.ID),
@@ -155,3 +261,21 @@ func releaseListOptionsToGiteaListOptions(in scm.ReleaseListOptions) ListOptions
 		PageSize: in.Size,
 	}
 }
+
+func convertAttachmentList(src []*Attachment) []*scm.ReleaseAsset {
+	dst := []*scm.ReleaseAsset{}
+	for _, v := range src {
+		dst = append(dst, convertAttachment(v))
+	}
+	return dst
+}
+
+func convertAttachment(src *Attachment) *scm.ReleaseAsset {
+	return &scm.ReleaseAsset{
+		ID:      strconv.FormatInt(src.ID, 10),
+		Name:    src.Name,
+		Size:    src.Size,
+		Link:    src.DownloadURL,
+		Created: src.Created.ValueOrZero(),
+	}
+}
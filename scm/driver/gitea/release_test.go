@@ -1,12 +1,19 @@
 package gitea
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -350,3 +357,215 @@ func TestReleaseDeleteByTag(t *testing.T) {
 	}
 
 }
+
+func TestReleaseListAssets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/1/assets").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/attachments.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.ListAssets(context.Background(), "go-gitea/gitea", "v1.0.0", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReleaseAsset{}
+	raw, _ := ioutil.ReadFile("testdata/attachments.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	// the asset is streamed in a multipart body with the
+	// content length of the complete body set.
+	matcher := gock.NewMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if req.Method != "POST" {
+			return true, nil
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil || int64(len(body)) != req.ContentLength {
+			return false, err
+		}
+		_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if err != nil {
+			return false, err
+		}
+		part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+		if err != nil {
+			return false, err
+		}
+		file, _ := ioutil.ReadAll(part)
+		return part.FormName() == "attachment" &&
+			part.FileName() == "example.zip" &&
+			string(file) == "hello world", nil
+	})
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/releases/1/assets").
+		MatchParam("name", "example.zip").
+		SetMatcher(matcher).
+		Reply(201).
+		Type("application/json").
+		File("testdata/attachment.json")
+
+	input := &scm.ReleaseAssetInput{
+		Name:        "example.zip",
+		ContentType: "application/zip",
+		Size:        11,
+		Body:        struct{ io.Reader }{strings.NewReader("hello world")},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Releases.UploadAsset(context.Background(), "go-gitea/gitea", "v1.0.0", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/attachment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseDownloadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/1/assets/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/attachment.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/attachments/9a2c5e6f-8d4b-4e1f-b3a7-6c0d2e1f4a5b").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client, _ := New("https://try.gitea.io")
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "go-gitea/gitea", "v1.0.0", "3")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDownloadAsset_External(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/1/assets/3").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(200).
+		Type("application/json").
+		JSON(map[string]interface{}{
+			"id":                   3,
+			"name":                 "gitea.tar.gz",
+			"browser_download_url": "https://cdn.example.com/attachments/gitea.tar.gz",
+		})
+
+	// the token must not be sent to the third-party host
+	// that stores the attachment.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://cdn.example.com").
+		Get("/attachments/gitea.tar.gz").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client, _ := New("https://try.gitea.io")
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "go-gitea/gitea", "v1.0.0", "3")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDeleteAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/releases/1/assets/3").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Releases.DeleteAsset(context.Background(), "go-gitea/gitea", "v1.0.0", "3")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
@@ -148,6 +148,129 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return s.generate(ctx, input)
+	}
+	path := "api/v1/user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("api/v1/orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Private:       isPrivate(input.Visibility),
+		AutoInit:      input.AutoInit,
+		DefaultBranch: input.Branch,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// generate creates a new repository from a template
+// repository. Gitea requires the owner of the generated
+// repository, which defaults to the authenticated user.
+func (s *repositoryService) generate(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	owner := input.Namespace
+	if owner == "" {
+		user, res, err := s.client.Users.Find(ctx)
+		if err != nil {
+			return nil, res, err
+		}
+		owner = user.Login
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/generate", input.Template)
+	in := &repositoryGenerateInput{
+		Owner:         owner,
+		Name:          input.Name,
+		Description:   input.Description,
+		Private:       isPrivate(input.Visibility),
+		DefaultBranch: input.Branch,
+		GitContent:    true,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/forks", repo)
+	in := &forkInput{
+		Organization: input.Namespace,
+		Name:         input.Name,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	in := &repositoryUpdateInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		Archived:      input.Archived,
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		private := isPrivate(input.Visibility)
+		in.Private = &private
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/transfer", repo)
+	in := &transferInput{NewOwner: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// ListCollaborators returns the repository collaborators.
+// Gitea does not return the collaborator permission, which
+// is requested for each collaborator.
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/collaborators?%s", repo, encodeListOptions(opts))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := []*scm.Collaborator{}
+	for _, v := range out {
+		path := fmt.Sprintf("api/v1/repos/%s/collaborators/%s/permission", repo, userLogin(v))
+		perm := new(collaboratorPerm)
+		if _, err := s.client.do(ctx, "GET", path, nil, perm); err != nil {
+			return nil, res, err
+		}
+		to = append(to, &scm.Collaborator{
+			User:       *convertUser(v),
+			Permission: convertPermission(perm.Permission),
+		})
+	}
+	return to, res, nil
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/collaborators/%s", repo, username)
+	in := &collaboratorInput{Permission: convertFromPermission(perm)}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/collaborators/%s", repo, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -171,6 +294,55 @@ type (
 		Archived      bool      `json:"archived"`
 	}
 
+	// gitea repository creation request.
+	repositoryInput struct {
+		Name          string `json:"name"`
+		Description   string `json:"description,omitempty"`
+		Private       bool   `json:"private"`
+		AutoInit      bool   `json:"auto_init"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+	}
+
+	// gitea repository generation request.
+	repositoryGenerateInput struct {
+		Owner         string `json:"owner"`
+		Name          string `json:"name"`
+		Description   string `json:"description,omitempty"`
+		Private       bool   `json:"private"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+		GitContent    bool   `json:"git_content"`
+	}
+
+	// gitea repository update request.
+	repositoryUpdateInput struct {
+		Name          string `json:"name,omitempty"`
+		Description   string `json:"description,omitempty"`
+		Private       *bool  `json:"private,omitempty"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+		Archived      *bool  `json:"archived,omitempty"`
+	}
+
+	// gitea fork request.
+	forkInput struct {
+		Organization string `json:"organization,omitempty"`
+		Name         string `json:"name,omitempty"`
+	}
+
+	// gitea transfer request.
+	transferInput struct {
+		NewOwner string `json:"new_owner"`
+	}
+
+	// gitea collaborator permission resource.
+	collaboratorPerm struct {
+		Permission string `json:"permission"`
+	}
+
+	// gitea collaborator request.
+	collaboratorInput struct {
+		Permission string `json:"permission"`
+	}
+
 	// gitea permissions details.
 	perm struct {
 		Admin bool `json:"admin"`
@@ -240,6 +412,12 @@ func convertRepository(src *repository) *scm.Repository {
 	}
 }
 
+// isPrivate returns true if the repository visibility is
+// private or internal.
+func isPrivate(src scm.Visibility) bool {
+	return src == scm.VisibilityPrivate || src == scm.VisibilityInternal
+}
+
 func convertPerm(src perm) *scm.Perm {
 	return &scm.Perm{
 		Push:  src.Push,
@@ -248,6 +426,30 @@ func convertPerm(src perm) *scm.Perm {
 	}
 }
 
+func convertPermission(src string) scm.Permission {
+	switch src {
+	case "owner", "admin":
+		return scm.PermissionAdmin
+	case "write":
+		return scm.PermissionWrite
+	case "read":
+		return scm.PermissionRead
+	default:
+		return scm.PermissionUndefined
+	}
+}
+
+func convertFromPermission(src scm.Permission) string {
+	switch src {
+	case scm.PermissionAdmin:
+		return "admin"
+	case scm.PermissionWrite:
+		return "write"
+	default:
+		return "read"
+	}
+}
+
 func convertHookList(src []*hook) []*scm.Hook {
 	var dst []*scm.Hook
 	for _, v := range src {
@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -109,6 +110,9 @@ func TestRepoNotFound(t *testing.T) {
 	} else if got, want := err.Error(), "Not Found"; got != want {
 		t.Errorf("Want error %q, got %q", want, got)
 	}
+	if !errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Expect error matches scm.ErrNotFound")
+	}
 }
 
 //
@@ -230,6 +234,267 @@ func TestHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepoCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/go-gitea/repos").
+		BodyString(`{"name":"gitea","description":"Git with a cup of tea","private":true,"auto_init":true,"default_branch":"master"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:   "go-gitea",
+		Name:        "gitea",
+		Description: "Git with a cup of tea",
+		Visibility:  scm.VisibilityPrivate,
+		Branch:      "master",
+		AutoInit:    true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoCreate_Template(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/template/generate").
+		BodyString(`{"owner":"jcitizen","name":"gitea","private":false,"git_content":true}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Name:       "gitea",
+		Visibility: scm.VisibilityPublic,
+		Template:   "go-gitea/template",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestRepoFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/gitea/forks").
+		BodyString(`{"organization":"go-gitea","name":"gitea"}`).
+		Reply(202).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.ForkInput{
+		Namespace: "go-gitea",
+		Name:      "gitea",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Fork(context.Background(), "jcitizen/gitea", input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		BodyString(`{"name":"gitea","private":true,"archived":true}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	archived := true
+	input := &scm.RepositoryUpdateInput{
+		Name:       "gitea",
+		Visibility: scm.VisibilityPrivate,
+		Archived:   &archived,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Update(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.Delete(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/gitea/transfer").
+		BodyString(`{"new_owner":"go-gitea"}`).
+		Reply(202).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.Transfer(context.Background(), "jcitizen/gitea", "go-gitea")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoListCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/collaborators").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/collaborators/jcitizen/permission").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborator_perm.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.ListCollaborators(context.Background(), "go-gitea/gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestRepoAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/collaborators/jcitizen").
+		BodyString(`{"permission":"admin"}`).
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.AddCollaborator(context.Background(), "go-gitea/gitea", "jcitizen", scm.PermissionAdmin)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestRepoRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/collaborators/jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.RemoveCollaborator(context.Background(), "go-gitea/gitea", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
@@ -6,6 +6,8 @@ package gitea
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -29,3 +31,144 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*prReview{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPullRequestReviewList(out), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := &prReviewInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Event:    convertFromReviewState(input.State),
+	}
+	for _, v := range input.Comments {
+		in.Comments = append(in.Comments, &prReviewCommentInput{
+			Path:        v.Path,
+			Body:        v.Body,
+			NewPosition: v.Line,
+		})
+	}
+	out := new(prReview)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPullRequestReview(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &prReviewDismissInput{Message: message}
+	out := new(prReview)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPullRequestReview(out), res, err
+}
+
+//
+// native data structures
+//
+
+type prReview struct {
+	ID          int       `json:"id"`
+	User        user      `json:"user"`
+	Body        string    `json:"body"`
+	CommitID    string    `json:"commit_id"`
+	State       string    `json:"state"`
+	Dismissed   bool      `json:"dismissed"`
+	HTMLURL     string    `json:"html_url"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type prReviewInput struct {
+	Body     string                  `json:"body,omitempty"`
+	CommitID string                  `json:"commit_id,omitempty"`
+	Event    string                  `json:"event,omitempty"`
+	Comments []*prReviewCommentInput `json:"comments,omitempty"`
+}
+
+type prReviewCommentInput struct {
+	Path        string `json:"path"`
+	Body        string `json:"body"`
+	NewPosition int    `json:"new_position"`
+}
+
+type prReviewDismissInput struct {
+	Message string `json:"message"`
+}
+
+//
+// native data structure conversion
+//
+
+func convertPullRequestReviewList(src []*prReview) []*scm.PullRequestReview {
+	dst := []*scm.PullRequestReview{}
+	for _, v := range src {
+		dst = append(dst, convertPullRequestReview(v))
+	}
+	return dst
+}
+
+func convertPullRequestReview(src *prReview) *scm.PullRequestReview {
+	dst := &scm.PullRequestReview{
+		ID:        src.ID,
+		Body:      src.Body,
+		State:     convertReviewState(src.State),
+		Sha:       src.CommitID,
+		Link:      src.HTMLURL,
+		Author:    *convertUser(&src.User),
+		Submitted: src.SubmittedAt,
+	}
+	if src.Dismissed {
+		dst.State = scm.ReviewStateDismissed
+	}
+	return dst
+}
+
+func convertReviewState(src string) scm.ReviewState {
+	switch src {
+	case "PENDING":
+		return scm.ReviewStatePending
+	case "COMMENT":
+		return scm.ReviewStateCommented
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "REQUEST_CHANGES":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// convertFromReviewState returns the review event for the
+// review state. The review is left pending if the event is
+// empty.
+func convertFromReviewState(src scm.ReviewState) string {
+	switch src {
+	case scm.ReviewStateApproved:
+		return "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	case scm.ReviewStateCommented:
+		return "COMMENT"
+	default:
+		return ""
+	}
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -6,9 +6,14 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -42,3 +47,89 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviews.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.ListReviews(context.Background(), "jcitizen/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		JSON(map[string]interface{}{
+			"body":      "LGTM",
+			"commit_id": "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"event":     "APPROVED",
+			"comments": []map[string]interface{}{
+				{"path": "README.md", "body": "Nit: typo", "new_position": 6},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review.json")
+
+	input := &scm.PullRequestReviewInput{
+		Body:  "LGTM",
+		State: scm.ReviewStateApproved,
+		Sha:   "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		Comments: []*scm.ReviewInput{
+			{Path: "README.md", Line: 6, Body: "Nit: typo"},
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.Submit(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequestReview)
+	raw, _ := ioutil.ReadFile("testdata/pr_review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/3/dismissals").
+		JSON(map[string]string{"message": "Outdated"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Reviews.Dismiss(context.Background(), "jcitizen/my-repo", 1, 3, "Outdated")
+	if err != nil {
+		t.Error(err)
+	}
+}
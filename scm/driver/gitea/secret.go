@@ -0,0 +1,117 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// secretService implements the SecretService using Gitea
+// Actions secrets. Gitea does not support environment
+// secrets, and secrets are always masked.
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets?%s", repo, encodeListOptions(opts))
+	out := []*secret{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSecretList(out), res, err
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets/%s", repo, input.Name)
+	in := &secretInput{Data: input.Value}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateRepoSecret(ctx, repo, input)
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/actions/secrets/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets?%s", org, encodeListOptions(opts))
+	out := []*secret{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSecretList(out), res, err
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets/%s", org, input.Name)
+	in := &secretInput{Data: input.Value}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateOrgSecret(ctx, org, input)
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/actions/secrets/%s", org, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea secret object.
+	secret struct {
+		Name    string    `json:"name"`
+		Created time.Time `json:"created_at"`
+	}
+
+	// gitea secret input object.
+	secretInput struct {
+		Data string `json:"data"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertSecretList(src []*secret) []*scm.Secret {
+	dst := []*scm.Secret{}
+	for _, v := range src {
+		dst = append(dst, convertSecret(v))
+	}
+	return dst
+}
+
+func convertSecret(src *secret) *scm.Secret {
+	return &scm.Secret{
+		Name:    src.Name,
+		Masked:  true,
+		Created: src.Created,
+	}
+}
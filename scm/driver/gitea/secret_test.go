@@ -0,0 +1,129 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/actions/secrets").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/secrets.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Secrets.ListRepoSecrets(context.Background(), "go-gitea/gitea", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/actions/secrets/DEPLOY_TOKEN").
+		BodyString(`{"data":"s3cr3t"}`).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Secrets.CreateRepoSecret(context.Background(), "go-gitea/gitea", &scm.SecretInput{Name: "DEPLOY_TOKEN", Value: "s3cr3t"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestSecretDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/actions/secrets/DEPLOY_TOKEN").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Secrets.DeleteRepoSecret(context.Background(), "go-gitea/gitea", "DEPLOY_TOKEN")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestOrgSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/go-gitea/actions/secrets").
+		Reply(200).
+		Type("application/json").
+		File("testdata/secrets.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Secrets.ListOrgSecrets(context.Background(), "go-gitea", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgSecretUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/orgs/go-gitea/actions/secrets/DEPLOY_TOKEN").
+		BodyString(`{"data":"s3cr3t"}`).
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Secrets.UpdateOrgSecret(context.Background(), "go-gitea", &scm.SecretInput{Name: "DEPLOY_TOKEN", Value: "s3cr3t"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+func TestEnvSecretList(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Secrets.ListEnvSecrets(context.Background(), "go-gitea/gitea", "production", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -54,6 +54,14 @@ type user struct {
 // native data structure conversion
 //
 
+func convertUserList(src []*user) []*scm.User {
+	dst := []*scm.User{}
+	for _, v := range src {
+		dst = append(dst, convertUser(v))
+	}
+	return dst
+}
+
 func convertUser(src *user) *scm.User {
 	return &scm.User{
 		Login:  userLogin(src),
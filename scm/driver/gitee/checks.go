@@ -0,0 +1,51 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type checksService struct {
+	client *wrapper
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
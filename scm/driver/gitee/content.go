@@ -8,6 +8,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"net/url"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -28,6 +31,32 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	// the raw endpoint does not return the file sha, which is
+	// only available from the contents endpoint together with
+	// the encoded file contents.
+	endpoint := fmt.Sprintf("repos/%s/raw/%s?ref=%s", repo, path, url.QueryEscape(ref))
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	to := &scm.RawContent{
+		Path: path,
+		Size: size,
+		Body: body,
+	}
+	return to, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	endpoint := fmt.Sprintf("repos/%s/tarball?ref=%s", repo, url.QueryEscape(ref))
+	if format == scm.ArchiveFormatZip {
+		endpoint = fmt.Sprintf("repos/%s/zipball?ref=%s", repo, url.QueryEscape(ref))
+	}
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	endpoint := fmt.Sprintf("repos/%s/contents/%s", repo, path)
 	in := &contentCreateUpdate{
@@ -87,6 +116,10 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	endpoint := fmt.Sprintf("repos/%s/contents/%s?ref=%s", repo, path, ref)
 	out := []*content{}
@@ -198,3 +198,62 @@ func TestContentList(t *testing.T) {
 
 	t.Run("Request", testRequest(res))
 }
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/raw/README.md").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		BodyString("hello world\n")
+
+	client := NewDefault()
+	got, _, err := client.Contents.FindRaw(context.Background(), "kit101/drone-yml-test", "README.md", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path: "README.md",
+		Size: 12,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/tarball").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client := NewDefault()
+	rc, _, err := client.Contents.Archive(context.Background(), "kit101/drone-yml-test", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
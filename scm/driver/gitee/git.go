@@ -26,6 +26,10 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return res, err
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -40,6 +44,25 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// gitee does not support lightweight tags, and the
+	// tagger is the authenticated user.
+	if params.Message == "" {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("repos/%s/tags", repo)
+	in := &tagCreate{
+		Refs:       params.Sha,
+		TagName:    params.Name,
+		TagMessage: params.Message,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	tags, res, err := s.ListTags(ctx, repo, scm.ListOptions{})
 	if err != nil {
@@ -94,11 +117,21 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type branchCreate struct {
 	Refs       string `json:"refs"`
 	BranchName string `json:"branch_name"`
 }
 
+type tagCreate struct {
+	Refs       string `json:"refs"`
+	TagName    string `json:"tag_name"`
+	TagMessage string `json:"tag_message"`
+}
+
 type branch struct {
 	//Links         string `json:"_links"`
 	Name          string `json:"name"`
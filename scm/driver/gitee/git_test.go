@@ -42,6 +42,34 @@ func TestGitCreateBranch(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/tags").
+		BodyString(`{"refs":"b72a4c4a2d838d96a545a42d41d7776ae5566f4a","tag_name":"v1.0.0","tag_message":"release v1.0.0"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	input := scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "b72a4c4a2d838d96a545a42d41d7776ae5566f4a",
+		Message: "release v1.0.0",
+	}
+	res, err := client.Git.CreateTag(context.Background(), "kit101/drone-yml-test", &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 201; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	t.Run("Request", testRequest(res))
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
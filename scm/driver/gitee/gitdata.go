@@ -0,0 +1,39 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
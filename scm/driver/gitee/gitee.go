@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"strconv"
 	"strings"
@@ -31,13 +33,21 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitee
 	client.Linker = &linker{websiteAddress(base)}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &RepositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -79,6 +89,9 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 		return nil, err
 	}
 	defer res.Body.Close()
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
 	// parse the gitee request id.
 	res.ID = res.Header.Get("X-Request-Id")
 
@@ -88,9 +101,7 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -102,6 +113,34 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the gitee request id.
+	res.ID = res.Header.Get("X-Request-Id")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
 // Error represents a Gitee error.
 type Error struct {
 	Message string `json:"message"`
@@ -166,3 +205,19 @@ func populatePageValues(req *scm.Request, resp *scm.Response) {
 		resp.Page.Next = current + 1
 	}
 }
+
+// newError returns an API error from the gitee error
+// response.
+func newError(res *scm.Response) error {
+	raw, _ := ioutil.ReadAll(res.Body)
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	return &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Message:   from.Message,
+		Header:    res.Header,
+		Raw:       raw,
+		Err:       from,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -46,18 +47,31 @@ func (s *issueService) ListComments(ctx context.Context, repo string, number int
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	// gitee issues have a single assignee.
+	if len(input.Assignees) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
 	owner, repoName := scm.Split(repo)
 	path := fmt.Sprintf("repos/%s/issues", owner)
-	in := &issueInput{
-		Repo:  repoName,
-		Title: input.Title,
-		Body:  input.Body,
-	}
+	in := convertIssueInput(repoName, input)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	// gitee issues have a single assignee.
+	if len(input.Assignees) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	in := convertIssueInput(repoName, input)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%s/comments", repo, decodeNumber(number))
 	in := &issueCommentInput{
@@ -68,6 +82,16 @@ func (s *issueService) CreateComment(ctx context.Context, repo string, number in
 	return convertIssueComment(out), res, err
 }
 
+func (s *issueService) UpdateComment(ctx context.Context, repo string, number, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/comments/%d", repo, id)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssueComment(out), res, err
+}
+
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/comments/%d", repo, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -85,6 +109,18 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	owner, repoName := scm.Split(repo)
+	path := fmt.Sprintf("repos/%s/issues/%s", owner, decodeNumber(number))
+	data := map[string]string{
+		"repo":  repoName,
+		"state": "open",
+	}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
 func (s *issueService) Lock(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -217,9 +253,13 @@ type issueStateDetail struct {
 }
 
 type issueInput struct {
-	Repo  string `json:"repo"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Repo      string  `json:"repo"`
+	Title     string  `json:"title,omitempty"`
+	Body      string  `json:"body,omitempty"`
+	Labels    *string `json:"labels,omitempty"`
+	Assignee  *string `json:"assignee,omitempty"`
+	Milestone int     `json:"milestone,omitempty"`
+	State     string  `json:"state,omitempty"`
 }
 
 type issueComment struct {
@@ -247,7 +287,7 @@ func convertIssueList(from []*issue) []*scm.Issue {
 	return to
 }
 func convertIssue(from *issue) *scm.Issue {
-	return &scm.Issue{
+	to := &scm.Issue{
 		Number: encodeNumber(from.Number),
 		Title:  from.Title,
 		Body:   from.Body,
@@ -261,6 +301,49 @@ func convertIssue(from *issue) *scm.Issue {
 		Created: from.CreatedAt,
 		Updated: from.UpdatedAt,
 	}
+	if from.Assignee.Login != "" {
+		to.Assignees = []scm.User{{
+			Login:  from.Assignee.Login,
+			Name:   from.Assignee.Name,
+			Avatar: from.Assignee.AvatarURL,
+		}}
+	}
+	if from.Milestone.Number != 0 {
+		to.Milestone = &scm.Milestone{
+			Number:      from.Milestone.Number,
+			ID:          from.Milestone.ID,
+			Title:       from.Milestone.Title,
+			Description: from.Milestone.Description,
+			Link:        from.Milestone.HtmlURL,
+			State:       from.Milestone.State,
+		}
+	}
+	return to
+}
+
+// convertIssueInput converts the issue input. The labels
+// and assignee are replaced if the list is not nil, and
+// the labels are comma separated.
+func convertIssueInput(repo string, from *scm.IssueInput) *issueInput {
+	to := &issueInput{
+		Repo:      repo,
+		Title:     from.Title,
+		Body:      from.Body,
+		Milestone: from.Milestone,
+		State:     from.State,
+	}
+	if from.Labels != nil {
+		labels := strings.Join(from.Labels, ",")
+		to.Labels = &labels
+	}
+	if from.Assignees != nil {
+		assignee := ""
+		if len(from.Assignees) != 0 {
+			assignee = from.Assignees[0]
+		}
+		to.Assignee = &assignee
+	}
+	return to
 }
 
 func convertIssueCommentList(from []*issueComment) []*scm.Comment {
@@ -190,6 +190,61 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]interface{}{
+			"repo":      "drone-yml-test",
+			"title":     "test issue 1",
+			"labels":    "bug,feature",
+			"assignee":  "kit101",
+			"milestone": 1,
+			"state":     "closed",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "test issue 1",
+		Labels:    []string{"bug", "feature"},
+		Assignees: []string{"kit101"},
+		Milestone: 1,
+		State:     "closed",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "kit101/drone-yml-test", 735267685380, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestIssueUpdate_MultipleAssignees(t *testing.T) {
+	input := scm.IssueInput{
+		Assignees: []string{"kit101", "kit102"},
+	}
+	_, _, err := NewDefault().Issues.Update(context.Background(), "kit101/drone-yml-test", 735267685380, &input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -223,6 +278,40 @@ func TestIssueCreateComment(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestIssueUpdateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/drone-yml-test/issues/comments/6879139").
+		JSON(map[string]string{"body": "it's ok."}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_comment.json")
+
+	input := &scm.CommentInput{
+		Body: "it's ok.",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.UpdateComment(context.Background(), "kit101/drone-yml-test", 735267685380, 6879139, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestIssueDeleteComment(t *testing.T) {
 	defer gock.Off()
 
@@ -265,6 +354,27 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Request", testRequest(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/issues/I4CD5P").
+		JSON(map[string]string{"repo": "drone-yml-test", "state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "kit101/drone-yml-test", 735267685380)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	_, err := NewDefault().Issues.Lock(context.Background(), "kit101/drone-yml-test", 735267685380)
 	if err != scm.ErrNotSupported {
@@ -0,0 +1,134 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// labelService implements the LabelService. Gitee does not
+// support label descriptions.
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: strings.TrimPrefix(input.Color, "#"),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelInput{
+		Name:  input.Name,
+		Color: strings.TrimPrefix(input.Color, "#"),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%s/labels", repo, decodeNumber(number))
+	return s.client.do(ctx, "POST", path, convertLabelsInput(labels), nil)
+}
+
+func (s *labelService) RemoveIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%s/labels", repo, decodeNumber(number))
+	return s.removeLabels(ctx, path, labels)
+}
+
+func (s *labelService) SetIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%s/labels", repo, decodeNumber(number))
+	return s.client.do(ctx, "PUT", path, convertLabelsInput(labels), nil)
+}
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/labels", repo, number)
+	return s.client.do(ctx, "POST", path, convertLabelsInput(labels), nil)
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/labels", repo, number)
+	return s.removeLabels(ctx, path, labels)
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/labels", repo, number)
+	return s.client.do(ctx, "PUT", path, convertLabelsInput(labels), nil)
+}
+
+// removeLabels removes the labels one at a time, since the
+// labels endpoint removes a single label by name.
+func (s *labelService) removeLabels(ctx context.Context, path string, labels []string) (*scm.Response, error) {
+	var res *scm.Response
+	var err error
+	for _, name := range labels {
+		res, err = s.client.do(ctx, "DELETE", path+"/"+url.PathEscape(name), nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type labelInput struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+// convertLabelsInput returns the label names, which gitee
+// expects as a json array in the request body.
+func convertLabelsInput(from []string) []string {
+	if from == nil {
+		return []string{}
+	}
+	return from
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:  from.Name,
+		Color: from.Color,
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/labels/bug").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	client := NewDefault()
+	got, res, err := client.Labels.Find(context.Background(), "kit101/drone-yml-test", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Get("/repos/kit101/drone-yml-test/labels").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	got, _, err := client.Labels.List(context.Background(), "kit101/drone-yml-test", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/labels").
+		JSON(map[string]string{"name": "bug", "color": "d73a4a"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "bug",
+		Color: "#d73a4a",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Labels.Create(context.Background(), "kit101/drone-yml-test", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Patch("/repos/kit101/drone-yml-test/labels/defect").
+		JSON(map[string]string{"name": "bug"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	client := NewDefault()
+	got, _, err := client.Labels.Update(context.Background(), "kit101/drone-yml-test", "defect", &scm.LabelInput{Name: "bug"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test/labels/bug").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Labels.Delete(context.Background(), "kit101/drone-yml-test", "bug")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect label deleted")
+	}
+}
+
+func TestLabelAddIssueLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Post("/repos/kit101/drone-yml-test/issues/I4CD5P/labels").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	_, err := client.Labels.AddIssueLabels(context.Background(), "kit101/drone-yml-test", 735267685380, []string{"bug", "feature"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels added")
+	}
+}
+
+func TestLabelRemovePullRequestLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test/pulls/7/labels/bug").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	gock.New("https://gitee.com/api/v5").
+		Delete("/repos/kit101/drone-yml-test/pulls/7/labels/feature").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, err := client.Labels.RemovePullRequestLabels(context.Background(), "kit101/drone-yml-test", 7, []string{"bug", "feature"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels removed")
+	}
+}
+
+func TestLabelSetPullRequestLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com/api/v5").
+		Put("/repos/kit101/drone-yml-test/pulls/7/labels").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	_, err := client.Labels.SetPullRequestLabels(context.Background(), "kit101/drone-yml-test", 7, []string{"bug", "feature"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels replaced")
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"io"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
@@ -64,6 +64,42 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) Reopen(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) MarkReady(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ConvertToDraft(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) Update(context.Context, string, int, *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RequestReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListReviewers(context.Context, string, int) ([]scm.Reviewer, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetAssignees(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -0,0 +1,27 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type protectionService struct {
+	client *wrapper
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
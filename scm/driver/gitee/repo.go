@@ -110,6 +110,90 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     input.Visibility == scm.VisibilityPrivate || input.Visibility == scm.VisibilityInternal,
+		AutoInit:    input.AutoInit,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *RepositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/forks", repo)
+	in := &forkInput{
+		Organization: input.Namespace,
+		Name:         input.Name,
+		Path:         input.Name,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	if input.Archived != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// gitee requires the repository name, which is found
+	// if the repository is not renamed.
+	name := input.Name
+	if name == "" {
+		current, res, err := s.Find(ctx, repo)
+		if err != nil {
+			return nil, res, err
+		}
+		name = current.Name
+	}
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositoryUpdateInput{
+		Name:          name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+	}
+	if input.Name != "" {
+		in.Path = input.Name
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		private := input.Visibility == scm.VisibilityPrivate || input.Visibility == scm.VisibilityInternal
+		in.Private = &private
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *RepositoryService) Transfer(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *RepositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 type repository struct {
 	ID    int `json:"id"`
 	Owner struct {
@@ -140,6 +224,27 @@ type repository struct {
 	} `json:"permission"`
 }
 
+type repositoryInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+	AutoInit    bool   `json:"auto_init"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string `json:"name"`
+	Path          string `json:"path,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Private       *bool  `json:"private,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+}
+
+type forkInput struct {
+	Organization string `json:"organization,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Path         string `json:"path,omitempty"`
+}
+
 type hook struct {
 	ID        int       `json:"id"`
 	URL       string    `json:"url"`
@@ -29,3 +29,31 @@ func (s *reviewService) Create(context.Context, string, int, *scm.ReviewInput) (
 func (s *reviewService) Delete(context.Context, string, int, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
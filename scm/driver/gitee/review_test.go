@@ -42,3 +42,11 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewSubmit(t *testing.T) {
+	service := new(reviewService)
+	_, _, err := service.Submit(context.Background(), "kit101/drone-yml-test", 1, &scm.PullRequestReviewInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
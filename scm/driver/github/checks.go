@@ -0,0 +1,401 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+// maxAnnotations is the maximum number of annotations
+// GitHub accepts in a single check run request.
+const maxAnnotations = 50
+
+type checksService struct {
+	client *wrapper
+}
+
+type checkRun struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	HeadSHA     string       `json:"head_sha"`
+	ExternalID  string       `json:"external_id"`
+	DetailsURL  string       `json:"details_url"`
+	HTMLURL     string       `json:"html_url"`
+	Status      string       `json:"status"`
+	Conclusion  null.String  `json:"conclusion"`
+	StartedAt   null.Time    `json:"started_at"`
+	CompletedAt null.Time    `json:"completed_at"`
+	Output      *checkOutput `json:"output"`
+	CheckSuite  struct {
+		ID int64 `json:"id"`
+	} `json:"check_suite"`
+}
+
+type checkRunList struct {
+	TotalCount int         `json:"total_count"`
+	CheckRuns  []*checkRun `json:"check_runs"`
+}
+
+type checkRunInput struct {
+	Name        string         `json:"name,omitempty"`
+	HeadSHA     string         `json:"head_sha,omitempty"`
+	ExternalID  string         `json:"external_id,omitempty"`
+	DetailsURL  string         `json:"details_url,omitempty"`
+	Status      string         `json:"status,omitempty"`
+	Conclusion  string         `json:"conclusion,omitempty"`
+	StartedAt   *time.Time     `json:"started_at,omitempty"`
+	CompletedAt *time.Time     `json:"completed_at,omitempty"`
+	Output      *checkOutput   `json:"output,omitempty"`
+	Actions     []*checkAction `json:"actions,omitempty"`
+}
+
+type checkOutput struct {
+	Title       string             `json:"title"`
+	Summary     string             `json:"summary"`
+	Text        string             `json:"text,omitempty"`
+	Annotations []*checkAnnotation `json:"annotations,omitempty"`
+}
+
+type checkAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	StartColumn     int    `json:"start_column,omitempty"`
+	EndColumn       int    `json:"end_column,omitempty"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+	RawDetails      string `json:"raw_details,omitempty"`
+}
+
+type checkAction struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Identifier  string `json:"identifier"`
+}
+
+type checkSuite struct {
+	ID         int64       `json:"id"`
+	HeadBranch string      `json:"head_branch"`
+	HeadSHA    string      `json:"head_sha"`
+	Before     string      `json:"before"`
+	After      string      `json:"after"`
+	Status     string      `json:"status"`
+	Conclusion null.String `json:"conclusion"`
+	App        *app        `json:"app"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+type checkSuiteList struct {
+	TotalCount  int           `json:"total_count"`
+	CheckSuites []*checkSuite `json:"check_suites"`
+}
+
+type checkSuiteInput struct {
+	HeadSHA string `json:"head_sha"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%s", repo, id)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCheckRun(out), res, err
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s/check-runs?%s", repo, ref, encodeCheckListOptions(opts))
+	out := new(checkRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCheckRunList(out.CheckRuns), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%s/annotations?%s", repo, id, encodeListOptions(opts))
+	out := []*checkAnnotation{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCheckAnnotationList(out), res, err
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs", repo)
+	in, batches := convertCheckRunInput(input)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.annotate(ctx, repo, out, input.Output, batches, res)
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%s", repo, id)
+	in, batches := convertCheckRunInput(input)
+	out := new(checkRun)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.annotate(ctx, repo, out, input.Output, batches, res)
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-suites/%s", repo, id)
+	out := new(checkSuite)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCheckSuite(out), res, err
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s/check-suites?%s", repo, ref, encodeCheckListOptions(opts))
+	out := new(checkSuiteList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCheckSuiteList(out.CheckSuites), res, err
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-suites", repo)
+	in := &checkSuiteInput{HeadSHA: input.HeadSHA}
+	out := new(checkSuite)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCheckSuite(out), res, err
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-suites/%s/rerequest", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// annotate adds the remaining annotation batches to the
+// check run. GitHub limits the number of annotations per
+// request, and appends annotations on each update.
+func (s *checksService) annotate(ctx context.Context, repo string, run *checkRun, output *scm.CheckRunOutput, batches [][]*checkAnnotation, res *scm.Response) (*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/check-runs/%d", repo, run.ID)
+	for _, batch := range batches {
+		in := &checkRunInput{
+			Output: &checkOutput{
+				Title:       output.Title,
+				Summary:     output.Summary,
+				Text:        output.Text,
+				Annotations: batch,
+			},
+		}
+		var err error
+		res, err = s.client.do(ctx, "PATCH", path, in, run)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return convertCheckRun(run), res, nil
+}
+
+// convertCheckRunInput converts the check run input to the
+// native representation. Annotations that exceed the GitHub
+// limit are returned in batches, to be added to the check
+// run in subsequent requests.
+func convertCheckRunInput(from *scm.CheckRunInput) (*checkRunInput, [][]*checkAnnotation) {
+	to := &checkRunInput{
+		Name:       from.Name,
+		HeadSHA:    from.HeadSHA,
+		ExternalID: from.ExternalID,
+		DetailsURL: from.DetailsURL,
+	}
+	to.Status, to.Conclusion = convertFromCheckState(from.State)
+	if from.Conclusion != "" {
+		to.Status = "completed"
+		to.Conclusion = from.Conclusion
+	}
+	if !from.Started.IsZero() {
+		to.StartedAt = &from.Started
+	}
+	if !from.Completed.IsZero() {
+		to.CompletedAt = &from.Completed
+	}
+	for _, v := range from.Actions {
+		to.Actions = append(to.Actions, &checkAction{
+			Label:       v.Label,
+			Description: v.Description,
+			Identifier:  v.Identifier,
+		})
+	}
+	if from.Output == nil {
+		return to, nil
+	}
+	var annotations []*checkAnnotation
+	for _, v := range from.Output.Annotations {
+		annotations = append(annotations, convertFromCheckAnnotation(v))
+	}
+	var batches [][]*checkAnnotation
+	for len(annotations) > maxAnnotations {
+		batches = append(batches, annotations[:maxAnnotations])
+		annotations = annotations[maxAnnotations:]
+	}
+	batches = append(batches, annotations)
+	to.Output = &checkOutput{
+		Title:       from.Output.Title,
+		Summary:     from.Output.Summary,
+		Text:        from.Output.Text,
+		Annotations: batches[0],
+	}
+	return to, batches[1:]
+}
+
+func convertFromCheckAnnotation(from *scm.CheckRunAnnotation) *checkAnnotation {
+	to := &checkAnnotation{
+		Path:            from.Path,
+		StartLine:       from.StartLine,
+		EndLine:         from.EndLine,
+		AnnotationLevel: from.Level.String(),
+		Title:           from.Title,
+		Message:         from.Message,
+		RawDetails:      from.RawDetails,
+	}
+	if to.EndLine == 0 {
+		to.EndLine = to.StartLine
+	}
+	// columns are only supported when the annotation
+	// starts and ends on the same line.
+	if to.StartLine == to.EndLine {
+		to.StartColumn = from.StartColumn
+		to.EndColumn = from.EndColumn
+	}
+	if from.Level == scm.AnnotationLevelUnknown {
+		to.AnnotationLevel = "notice"
+	}
+	return to
+}
+
+func convertCheckRunList(from []*checkRun) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from {
+		to = append(to, convertCheckRun(v))
+	}
+	return to
+}
+
+func convertCheckRun(from *checkRun) *scm.CheckRun {
+	to := &scm.CheckRun{
+		ID:         strconv.FormatInt(from.ID, 10),
+		Name:       from.Name,
+		HeadSHA:    from.HeadSHA,
+		ExternalID: from.ExternalID,
+		DetailsURL: from.DetailsURL,
+		Link:       from.HTMLURL,
+		State:      convertCheckState(from.Status, from.Conclusion.String),
+		Conclusion: from.Conclusion.String,
+		Started:    from.StartedAt.ValueOrZero(),
+		Completed:  from.CompletedAt.ValueOrZero(),
+	}
+	if from.CheckSuite.ID != 0 {
+		to.SuiteID = strconv.FormatInt(from.CheckSuite.ID, 10)
+	}
+	if from.Output != nil {
+		to.Output = &scm.CheckRunOutput{
+			Title:   from.Output.Title,
+			Summary: from.Output.Summary,
+			Text:    from.Output.Text,
+		}
+	}
+	return to
+}
+
+func convertCheckAnnotationList(from []*checkAnnotation) []*scm.CheckRunAnnotation {
+	to := []*scm.CheckRunAnnotation{}
+	for _, v := range from {
+		to = append(to, convertCheckAnnotation(v))
+	}
+	return to
+}
+
+func convertCheckAnnotation(from *checkAnnotation) *scm.CheckRunAnnotation {
+	to := &scm.CheckRunAnnotation{
+		Path:        from.Path,
+		StartLine:   from.StartLine,
+		EndLine:     from.EndLine,
+		StartColumn: from.StartColumn,
+		EndColumn:   from.EndColumn,
+		Title:       from.Title,
+		Message:     from.Message,
+		RawDetails:  from.RawDetails,
+	}
+	switch from.AnnotationLevel {
+	case "notice":
+		to.Level = scm.AnnotationLevelNotice
+	case "warning":
+		to.Level = scm.AnnotationLevelWarning
+	case "failure":
+		to.Level = scm.AnnotationLevelFailure
+	}
+	return to
+}
+
+func convertCheckSuiteList(from []*checkSuite) []*scm.CheckSuite {
+	to := []*scm.CheckSuite{}
+	for _, v := range from {
+		to = append(to, convertCheckSuite(v))
+	}
+	return to
+}
+
+func convertCheckSuite(from *checkSuite) *scm.CheckSuite {
+	return &scm.CheckSuite{
+		ID:         strconv.FormatInt(from.ID, 10),
+		HeadBranch: from.HeadBranch,
+		HeadSHA:    from.HeadSHA,
+		Before:     from.Before,
+		After:      from.After,
+		State:      convertCheckState(from.Status, from.Conclusion.String),
+		Conclusion: from.Conclusion.String,
+		App:        convertApp(from.App),
+		Created:    from.CreatedAt,
+		Updated:    from.UpdatedAt,
+	}
+}
+
+// convertCheckState converts the check run status and
+// conclusion to the commit state.
+func convertCheckState(status, conclusion string) scm.State {
+	switch status {
+	case "queued", "requested", "waiting", "pending":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return scm.StateSuccess
+	case "failure", "timed_out", "action_required", "startup_failure":
+		return scm.StateFailure
+	case "cancelled":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
+
+// convertFromCheckState converts the commit state to the
+// check run status and conclusion.
+func convertFromCheckState(from scm.State) (status, conclusion string) {
+	switch from {
+	case scm.StatePending:
+		return "queued", ""
+	case scm.StateRunning:
+		return "in_progress", ""
+	case scm.StateSuccess:
+		return "completed", "success"
+	case scm.StateFailure, scm.StateError:
+		return "completed", "failure"
+	case scm.StateCanceled:
+		return "completed", "cancelled"
+	default:
+		return "", ""
+	}
+}
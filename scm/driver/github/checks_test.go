@@ -0,0 +1,346 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksFindCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/check-runs/128620228").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	client := NewDefault()
+	got, res, err := client.Checks.FindCheckRun(context.Background(), "octocat/hello-world", "128620228")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/master/check-runs").
+		MatchParam("check_name", "Octocoders-linter").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/check_runs.json")
+
+	client := NewDefault()
+	opts := scm.CheckListOptions{Name: "Octocoders-linter", Page: 1, Size: 30}
+	got, res, err := client.Checks.ListCheckRuns(context.Background(), "octocat/hello-world", "master", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/check_runs.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestChecksListAnnotations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/check-runs/128620228/annotations").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_annotations.json")
+
+	client := NewDefault()
+	got, _, err := client.Checks.ListAnnotations(context.Background(), "octocat/hello-world", "128620228", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRunAnnotation{}
+	raw, _ := ioutil.ReadFile("testdata/check_annotations.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-runs").
+		File("testdata/check_run_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	input := &scm.CheckRunInput{
+		Name:       "Octocoders-linter",
+		HeadSHA:    "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		ExternalID: "ci-42",
+		DetailsURL: "https://octocoders.io",
+		State:      scm.StateSuccess,
+		Conclusion: "neutral",
+		Output: &scm.CheckRunOutput{
+			Title:   "Lint",
+			Summary: "2 warnings",
+			Annotations: []*scm.CheckRunAnnotation{
+				{
+					Path:      "README.md",
+					StartLine: 2,
+					Level:     scm.AnnotationLevelWarning,
+					Message:   "Check your spelling for 'banaas'.",
+				},
+			},
+		},
+		Actions: []*scm.CheckRunAction{
+			{Label: "Fix", Description: "Fix the lint errors", Identifier: "fix_errors"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Checks.CreateCheckRun(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksCreateCheckRun_Annotations(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-runs").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/check-runs/128620228").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	output := &scm.CheckRunOutput{Title: "Lint", Summary: "120 warnings"}
+	for i := 0; i < 120; i++ {
+		output.Annotations = append(output.Annotations, &scm.CheckRunAnnotation{
+			Path:      "main.go",
+			StartLine: i + 1,
+			Level:     scm.AnnotationLevelWarning,
+			Message:   fmt.Sprintf("warning %d", i),
+		})
+	}
+
+	in, batches := convertCheckRunInput(&scm.CheckRunInput{Output: output})
+	if got, want := len(in.Output.Annotations), maxAnnotations; got != want {
+		t.Errorf("Want %d annotations in the first request, got %d", want, got)
+	}
+	if got, want := len(batches), 2; got != want {
+		t.Errorf("Want %d annotation batches, got %d", want, got)
+	}
+
+	client := NewDefault()
+	_, _, err := client.Checks.CreateCheckRun(context.Background(), "octocat/hello-world", &scm.CheckRunInput{
+		Name:    "Octocoders-linter",
+		HeadSHA: "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		State:   scm.StateFailure,
+		Output:  output,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect annotations added in batches")
+	}
+}
+
+func TestChecksUpdateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/check-runs/128620228").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_run.json")
+
+	input := &scm.CheckRunInput{
+		State: scm.StateRunning,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Checks.UpdateCheckRun(context.Background(), "octocat/hello-world", "128620228", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.ID, "128620228"; got != want {
+		t.Errorf("Want check run id %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksFindCheckSuite(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/check-suites/118578147").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_suite.json")
+
+	client := NewDefault()
+	got, res, err := client.Checks.FindCheckSuite(context.Background(), "octocat/hello-world", "118578147")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckSuite)
+	raw, _ := ioutil.ReadFile("testdata/check_suite.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksListCheckSuites(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/master/check-suites").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_suites.json")
+
+	client := NewDefault()
+	got, _, err := client.Checks.ListCheckSuites(context.Background(), "octocat/hello-world", "master", scm.CheckListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckSuite{}
+	raw, _ := ioutil.ReadFile("testdata/check_suites.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCreateCheckSuite(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-suites").
+		JSON(map[string]string{"head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/check_suite.json")
+
+	client := NewDefault()
+	input := &scm.CheckSuiteInput{HeadSHA: "ec26c3e57ca3a959ca5aad62de7213c562f8c821"}
+	got, _, err := client.Checks.CreateCheckSuite(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.ID, "118578147"; got != want {
+		t.Errorf("Want check suite id %q, got %q", want, got)
+	}
+}
+
+func TestChecksRerequestCheckSuite(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/check-suites/118578147/rerequest").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Checks.RerequestCheckSuite(context.Background(), "octocat/hello-world", "118578147")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
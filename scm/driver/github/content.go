@@ -8,7 +8,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -31,6 +34,44 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("repos/%s/contents/%s?ref=%s", repo, path, urlEncodedRef),
+		Header: map[string][]string{
+			"Accept": {"application/vnd.github.raw"},
+		},
+	}
+	body, res, err := s.client.streamRequest(ctx, req)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	// NB the etag of the raw response is the blob sha, and the
+	// commit sha is not returned.
+	return &scm.RawContent{
+		Path:   path,
+		Size:   size,
+		BlobID: strings.Trim(strings.TrimPrefix(res.Header.Get("ETag"), "W/"), `"`),
+		Body:   body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	endpoint := fmt.Sprintf("repos/%s/tarball/%s", repo, ref)
+	if format == scm.ArchiveFormatZip {
+		endpoint = fmt.Sprintf("repos/%s/zipball/%s", repo, ref)
+	}
+	// the archive endpoint redirects to a temporary download
+	// url, which is requested without credentials.
+	req := &scm.Request{
+		Method: "GET",
+		Path:   endpoint,
+	}
+	return s.client.streamRedirect(ctx, req)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	endpoint := fmt.Sprintf("repos/%s/contents/%s", repo, path)
 	in := &contentCreateUpdate{
@@ -92,6 +133,51 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// the commit is created using the git data api, which
+	// fast-forwards the branch and fails if the branch was
+	// updated since the parent commit.
+	parent := input.Parent
+	if parent == "" {
+		ref, res, err := s.client.Git.FindBranch(ctx, repo, input.Branch)
+		if err != nil {
+			return nil, res, err
+		}
+		parent = ref.Sha
+	}
+	entries := []*scm.TreeEntryInput{}
+	for _, v := range input.Actions {
+		switch v.Action {
+		case scm.FileActionCreate, scm.FileActionUpdate:
+			entries = append(entries, &scm.TreeEntryInput{Path: v.Path, Content: v.Data})
+		case scm.FileActionDelete:
+			entries = append(entries, &scm.TreeEntryInput{Path: v.Path, Delete: true})
+		case scm.FileActionMove:
+			entry := &scm.TreeEntryInput{Path: v.Path, Content: v.Data}
+			if v.Data == nil {
+				// the moved file references the existing blob
+				// if the content is not replaced.
+				current, res, err := s.Find(ctx, repo, v.PreviousPath, parent)
+				if err != nil {
+					return nil, res, err
+				}
+				entry.Sha = current.BlobID
+			}
+			entries = append(entries, &scm.TreeEntryInput{Path: v.PreviousPath, Delete: true}, entry)
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+	}
+	return s.client.GitData.CreateCommit(ctx, repo, &scm.GitCommitInput{
+		Message:   input.Message,
+		Parents:   []string{parent},
+		Author:    input.Author,
+		Committer: input.Author,
+		Branch:    input.Branch,
+		Entries:   entries,
+	})
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	endpoint := fmt.Sprintf("repos/%s/contents/%s?ref=%s", repo, path, ref)
 	out := []*content{}
@@ -8,9 +8,11 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
 )
@@ -224,6 +226,75 @@ func TestContentDelete(t *testing.T) {
 	}
 }
 
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/commits/7d1b31e74ee336d15cbd21741bc88a537ed063a0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		BodyString(`{"base_tree":"827efc6d56897b048c772eb4087f854f46256132","tree":[{"path":"file.rb","mode":"100644","type":"blob","content":"puts 'hello'"},{"path":"README","mode":"100644","type":"blob","sha":null},{"path":"docs/old.md","mode":"100644","type":"blob","sha":null},{"path":"docs/new.md","mode":"100644","type":"blob","content":"hello"}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		BodyString(`{"message":"my commit message","tree":"9fb037999f264ba9a7fc6274d15fa3ae2ab98312","parents":["7d1b31e74ee336d15cbd21741bc88a537ed063a0"],"author":{"name":"Monalisa Octocat","email":"octocat@github.com"},"committer":{"name":"Monalisa Octocat","email":"octocat@github.com"}}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/master").
+		BodyString(`{"sha":"7638417db6d59f3c431d3e1f261cc637155684cd","force":false}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	input := &scm.CommitInput{
+		Branch:  "master",
+		Message: "my commit message",
+		Parent:  "7d1b31e74ee336d15cbd21741bc88a537ed063a0",
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionCreate, Path: "file.rb", Data: []byte("puts 'hello'")},
+			{Action: scm.FileActionDelete, Path: "README"},
+			{Action: scm.FileActionMove, Path: "docs/new.md", PreviousPath: "docs/old.md", Data: []byte("hello")},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.Commit(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestContentList(t *testing.T) {
 	defer gock.Off()
 
@@ -260,3 +331,104 @@ func TestContentList(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/contents/README").
+		MatchParam("ref", "master").
+		MatchHeader("Accept", "application/vnd.github.raw").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		SetHeader("ETag", `W/"3b18e512dba79e4c8300dd08aeb37f8e728b8dad"`).
+		BodyString("hello world\n")
+
+	client := NewDefault()
+	got, _, err := client.Contents.FindRaw(context.Background(), "octocat/hello-world", "README", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path:   "README",
+		Size:   12,
+		BlobID: "3b18e512dba79e4c8300dd08aeb37f8e728b8dad",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/tarball/master").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client := NewDefault()
+	rc, _, err := client.Contents.Archive(context.Background(), "octocat/hello-world", "master", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
+
+func TestContentArchive_Redirect(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/zipball/master").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(302).
+		SetHeaders(mockHeaders).
+		SetHeader("Location", "https://codeload.github.com/octocat/hello-world/legacy.zip/refs/heads/master?token=abc")
+
+	// the token must not be sent to the download host.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://codeload.github.com").
+		Get("/octocat/hello-world/legacy.zip/refs/heads/master").
+		MatchParam("token", "abc").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/zip").
+		BodyString("archive")
+
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Contents.Archive(context.Background(), "octocat/hello-world", "master", scm.ArchiveFormatZip)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
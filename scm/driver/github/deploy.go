@@ -0,0 +1,178 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo string, id int64) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d", repo, id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments?%s", repo, encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments", repo)
+	in := &deploymentInput{
+		Ref:         input.Ref,
+		Task:        input.Task,
+		Environment: input.Environment,
+		Description: input.Desc,
+		Payload:     input.Payload,
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) ListStatus(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses?%s", repo, id, encodeListOptions(opts))
+	out := []*deployStatus{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeployStatusList(out), res, err
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo string, id int64, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/deployments/%d/statuses", repo, id)
+	in := &deployStatusInput{
+		State:          convertFromDeployState(input.State),
+		Environment:    input.Environment,
+		EnvironmentURL: input.EnvironmentURL,
+		Description:    input.Desc,
+		TargetURL:      input.Target,
+	}
+	out := new(deployStatus)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments?%s", repo, encodeListOptions(opts))
+	out := new(environmentList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertEnvironmentList(out.Environments), res, err
+}
+
+type deployment struct {
+	ID          int64       `json:"id"`
+	Sha         string      `json:"sha"`
+	Ref         string      `json:"ref"`
+	Task        string      `json:"task"`
+	Environment string      `json:"environment"`
+	Description string      `json:"description"`
+	Payload     interface{} `json:"payload"`
+	Creator     user        `json:"creator"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type deploymentInput struct {
+	Ref         string      `json:"ref"`
+	Task        string      `json:"task,omitempty"`
+	Environment string      `json:"environment,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Payload     interface{} `json:"payload,omitempty"`
+}
+
+type environmentList struct {
+	Environments []*environment `json:"environments"`
+}
+
+type environment struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	return &scm.Deployment{
+		ID:          from.ID,
+		Sha:         from.Sha,
+		Ref:         from.Ref,
+		Task:        from.Task,
+		Environment: from.Environment,
+		Desc:        from.Description,
+		Payload:     from.Payload,
+		Author:      *convertUser(&from.Creator),
+		Created:     from.CreatedAt,
+		Updated:     from.UpdatedAt,
+	}
+}
+
+func convertDeployStatusList(from []*deployStatus) []*scm.DeployStatus {
+	to := []*scm.DeployStatus{}
+	for _, v := range from {
+		to = append(to, convertDeployStatus(v))
+	}
+	return to
+}
+
+func convertEnvironmentList(from []*environment) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *environment) *scm.Environment {
+	return &scm.Environment{
+		ID:      from.ID,
+		Name:    from.Name,
+		Link:    from.HTMLURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+// convertDeployState converts the deployment status state.
+// Deployment statuses are queued and in progress in
+// addition to the commit status states.
+func convertDeployState(from string) scm.State {
+	switch from {
+	case "queued":
+		return scm.StatePending
+	case "in_progress":
+		return scm.StateRunning
+	default:
+		return convertState(from)
+	}
+}
+
+func convertFromDeployState(from scm.State) string {
+	switch from {
+	case scm.StateRunning:
+		return "in_progress"
+	default:
+		return convertFromState(from)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/deployments/1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Find(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/deployments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchParam("ref", "topic-branch").
+		MatchParam("environment", "production").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deploys.json")
+
+	opts := scm.DeploymentListOptions{
+		Ref:         "topic-branch",
+		Environment: "production",
+		Page:        1,
+		Size:        30,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.List(context.Background(), "octocat/hello-world", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := ioutil.ReadFile("testdata/deploys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/deployments").
+		JSON(map[string]interface{}{
+			"ref":         "topic-branch",
+			"task":        "deploy",
+			"environment": "production",
+			"description": "Deploy request from hubot",
+			"payload":     map[string]string{"deploy": "migrate"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	input := &scm.DeploymentInput{
+		Ref:         "topic-branch",
+		Task:        "deploy",
+		Environment: "production",
+		Desc:        "Deploy request from hubot",
+		Payload:     map[string]string{"deploy": "migrate"},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentListStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/deployments/1/statuses").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deploy_statuses.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListStatus(context.Background(), "octocat/hello-world", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployStatus{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/deployments/1/statuses").
+		JSON(map[string]string{
+			"state":           "in_progress",
+			"environment":     "production",
+			"environment_url": "https://example.netlify.com",
+			"log_url":         "https://example.com/deployment/42/output",
+			"description":     "Deployment started.",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deployment.json")
+
+	input := &scm.DeployStatusInput{
+		State:          scm.StateRunning,
+		Desc:           "Deployment started.",
+		Target:         "https://example.com/deployment/42/output",
+		Environment:    "production",
+		EnvironmentURL: "https://example.netlify.com",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.CreateStatus(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployStatus)
+	raw, _ := ioutil.ReadFile("testdata/deployment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentListEnvironments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/environments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/environments.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListEnvironments(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Environment{}
+	raw, _ := ioutil.ReadFile("testdata/environments.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -25,6 +26,11 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches/%s", repo, name)
 	out := new(branch)
@@ -39,6 +45,39 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// an annotated tag is a tag object, which is created
+	// before the tag reference.
+	sha := params.Sha
+	if params.Message != "" {
+		path := fmt.Sprintf("repos/%s/git/tags", repo)
+		in := &tagInput{
+			Tag:     params.Name,
+			Message: params.Message,
+			Object:  params.Sha,
+			Type:    "commit",
+			Tagger:  convertSignatureInput(params.Tagger),
+		}
+		out := new(tagObject)
+		res, err := s.client.do(ctx, "POST", path, in, out)
+		if err != nil {
+			return res, err
+		}
+		sha = out.Sha
+	}
+	path := fmt.Sprintf("repos/%s/git/refs", repo)
+	in := &createBranch{
+		Ref: scm.ExpandRef(params.Name, "refs/tags"),
+		Sha: sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/tags/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/git/ref/tags/%s", repo, name)
 	out := new(ref)
@@ -87,11 +126,56 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	name := strings.TrimPrefix(scm.ExpandRef(params.Name, "refs/heads"), "refs/")
+	// github does not reject the update if the reference
+	// does not match the expected sha, so the reference is
+	// compared before updating. This is a best-effort check
+	// since the reference may be updated between the two
+	// requests.
+	if params.OldSha != "" {
+		path := fmt.Sprintf("repos/%s/git/ref/%s", repo, name)
+		out := new(ref)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		if out.Object.Sha != params.OldSha {
+			return nil, res, scm.ErrConflict
+		}
+	}
+	path := fmt.Sprintf("repos/%s/git/refs/%s", repo, name)
+	in := &refInput{
+		Sha:   params.Sha,
+		Force: params.Force,
+	}
+	out := new(ref)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRef(out), res, err
+}
+
 type createBranch struct {
 	Ref string `json:"ref"`
 	Sha string `json:"sha"`
 }
 
+type refInput struct {
+	Sha   string `json:"sha"`
+	Force bool   `json:"force"`
+}
+
+type tagInput struct {
+	Tag     string          `json:"tag"`
+	Message string          `json:"message"`
+	Object  string          `json:"object"`
+	Type    string          `json:"type"`
+	Tagger  *signatureInput `json:"tagger,omitempty"`
+}
+
+type tagObject struct {
+	Sha string `json:"sha"`
+}
+
 type branch struct {
 	Name      string `json:"name"`
 	Commit    commit `json:"commit"`
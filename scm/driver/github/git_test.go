@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -138,6 +139,187 @@ func TestGitCreateBranch(t *testing.T) {
 	}
 }
 
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/heads/featureA").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "octocat/hello-world", "featureA")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/tags").
+		BodyString(`{"tag":"v0.0.1","message":"initial version","object":"c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c","type":"commit","tagger":{"name":"Monalisa Octocat","email":"octocat@github.com","date":"2014-11-07T22:01:45Z"}}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_create.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		BodyString(`{"ref":"refs/tags/v0.0.1","sha":"940bd336248efae0f9ee5bc7b2d5c985887b16ac"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_create.json")
+
+	params := &scm.TagInput{
+		Name:    "v0.0.1",
+		Sha:     "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Message: "initial version",
+		Tagger: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+			Date:  time.Date(2014, time.November, 7, 22, 1, 45, 0, time.UTC),
+		},
+	}
+
+	client := NewDefault()
+	res, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitCreateTag_Lightweight(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		BodyString(`{"ref":"refs/tags/v0.0.1","sha":"c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_create.json")
+
+	params := &scm.TagInput{
+		Name: "v0.0.1",
+		Sha:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+
+	client := NewDefault()
+	_, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/tags/v0.0.1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "octocat/hello-world", "v0.0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/ref/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/featureA").
+		BodyString(`{"sha":"7638417db6d59f3c431d3e1f261cc637155684cd","force":true}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "featureA",
+		Sha:    "7638417db6d59f3c431d3e1f261cc637155684cd",
+		OldSha: "aa218f56b14c9653891f9e74264a383fa43fefbd",
+		Force:  true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Git.UpdateRef(context.Background(), "octocat/hello-world", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/ref_update.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitUpdateRef_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/ref/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	params := &scm.ReferenceUpdateInput{
+		Name:   "featureA",
+		Sha:    "7638417db6d59f3c431d3e1f261cc637155684cd",
+		OldSha: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Git.UpdateRef(context.Background(), "octocat/hello-world", params)
+	if err != scm.ErrConflict {
+		t.Errorf("Expect ErrConflict, got %v", err)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,306 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/blobs/%s", repo, sha)
+	out := new(blob)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBlob(out), res, nil
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/blobs", repo)
+	in := &blobInput{
+		Content:  base64.StdEncoding.EncodeToString(input.Content),
+		Encoding: "base64",
+	}
+	out := new(blob)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return &scm.Blob{
+		Sha:     out.Sha,
+		Size:    int64(len(input.Content)),
+		Content: input.Content,
+	}, res, err
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/trees/%s", repo, sha)
+	if recursive {
+		path += "?recursive=1"
+	}
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTree(out), res, err
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/trees", repo)
+	in := &treeInput{
+		BaseTree: input.Base,
+		Tree:     []interface{}{},
+	}
+	for _, v := range input.Entries {
+		in.Tree = append(in.Tree, convertTreeEntryInput(v))
+	}
+	out := new(tree)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTree(out), res, err
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	var res *scm.Response
+	var err error
+
+	// if the tree sha is not provided, the tree is created
+	// from the entries, using the tree of the first parent
+	// commit as the base tree.
+	sha := input.Tree
+	if sha == "" {
+		treeIn := &scm.TreeInput{Entries: input.Entries}
+		if len(input.Parents) != 0 {
+			path := fmt.Sprintf("repos/%s/git/commits/%s", repo, input.Parents[0])
+			parent := new(gitCommit)
+			res, err = s.client.do(ctx, "GET", path, nil, parent)
+			if err != nil {
+				return nil, res, err
+			}
+			treeIn.Base = parent.Tree.Sha
+		}
+		created, res, err := s.CreateTree(ctx, repo, treeIn)
+		if err != nil {
+			return nil, res, err
+		}
+		sha = created.Sha
+	}
+
+	path := fmt.Sprintf("repos/%s/git/commits", repo)
+	in := &gitCommitInput{
+		Message:   input.Message,
+		Tree:      sha,
+		Parents:   input.Parents,
+		Author:    convertSignatureInput(input.Author),
+		Committer: convertSignatureInput(input.Committer),
+	}
+	if in.Parents == nil {
+		in.Parents = []string{}
+	}
+	out := new(gitCommit)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// the branch is fast-forwarded to the new commit, which
+	// fails if the branch was updated since the parent
+	// commit.
+	if input.Branch != "" {
+		_, res, err = s.UpdateRef(ctx, repo, &scm.ReferenceUpdateInput{
+			Name: input.Branch,
+			Sha:  out.Sha,
+		})
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return convertGitCommit(out), res, nil
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
+
+type blob struct {
+	Sha      string `json:"sha"`
+	Size     int64  `json:"size"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type blobInput struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type tree struct {
+	Sha       string       `json:"sha"`
+	Tree      []*treeEntry `json:"tree"`
+	Truncated bool         `json:"truncated"`
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Sha  string `json:"sha"`
+	Size int64  `json:"size"`
+}
+
+type treeInput struct {
+	BaseTree string        `json:"base_tree,omitempty"`
+	Tree     []interface{} `json:"tree"`
+}
+
+type treeEntryInput struct {
+	Path    string  `json:"path"`
+	Mode    string  `json:"mode"`
+	Type    string  `json:"type"`
+	Sha     string  `json:"sha,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+// treeEntryDelete represents a deleted tree entry, which
+// is removed from the base tree using a null sha.
+type treeEntryDelete struct {
+	Path string  `json:"path"`
+	Mode string  `json:"mode"`
+	Type string  `json:"type"`
+	Sha  *string `json:"sha"`
+}
+
+type gitCommit struct {
+	Sha     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Message string `json:"message"`
+	Author  struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"author"`
+	Committer struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"committer"`
+	Tree struct {
+		Sha string `json:"sha"`
+	} `json:"tree"`
+}
+
+type gitCommitInput struct {
+	Message   string          `json:"message"`
+	Tree      string          `json:"tree"`
+	Parents   []string        `json:"parents"`
+	Author    *signatureInput `json:"author,omitempty"`
+	Committer *signatureInput `json:"committer,omitempty"`
+}
+
+type signatureInput struct {
+	Name  string     `json:"name"`
+	Email string     `json:"email"`
+	Date  *time.Time `json:"date,omitempty"`
+}
+
+func convertBlob(from *blob) *scm.Blob {
+	to := &scm.Blob{
+		Sha:     from.Sha,
+		Size:    from.Size,
+		Content: []byte(from.Content),
+	}
+	if from.Encoding == "base64" {
+		to.Content, _ = base64.StdEncoding.DecodeString(from.Content)
+	}
+	return to
+}
+
+func convertTree(from *tree) *scm.Tree {
+	to := &scm.Tree{
+		Sha:       from.Sha,
+		Entries:   []*scm.TreeEntry{},
+		Truncated: from.Truncated,
+	}
+	for _, v := range from.Tree {
+		to.Entries = append(to.Entries, &scm.TreeEntry{
+			Path: v.Path,
+			Mode: v.Mode,
+			Type: v.Type,
+			Sha:  v.Sha,
+			Size: v.Size,
+		})
+	}
+	return to
+}
+
+// convertTreeEntryInput converts the tree entry input. The
+// entry defaults to a regular file if the mode and type
+// are not provided.
+func convertTreeEntryInput(from *scm.TreeEntryInput) interface{} {
+	mode, typ := from.Mode, from.Type
+	if mode == "" {
+		mode = "100644"
+	}
+	if typ == "" {
+		typ = "blob"
+	}
+	if from.Delete {
+		return &treeEntryDelete{
+			Path: from.Path,
+			Mode: mode,
+			Type: typ,
+		}
+	}
+	to := &treeEntryInput{
+		Path: from.Path,
+		Mode: mode,
+		Type: typ,
+		Sha:  from.Sha,
+	}
+	if from.Sha == "" {
+		content := string(from.Content)
+		to.Content = &content
+	}
+	return to
+}
+
+// convertSignatureInput returns the commit signature, or nil
+// if the name and email are not provided, in which case the
+// authenticated user is used.
+func convertSignatureInput(from scm.Signature) *signatureInput {
+	if from.Name == "" && from.Email == "" {
+		return nil
+	}
+	to := &signatureInput{
+		Name:  from.Name,
+		Email: from.Email,
+	}
+	if !from.Date.IsZero() {
+		to.Date = &from.Date
+	}
+	return to
+}
+
+func convertGitCommit(from *gitCommit) *scm.Commit {
+	return &scm.Commit{
+		Sha:     from.Sha,
+		Message: from.Message,
+		Link:    from.HTMLURL,
+		Author: scm.Signature{
+			Name:  from.Author.Name,
+			Email: from.Author.Email,
+			Date:  from.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  from.Committer.Name,
+			Email: from.Committer.Email,
+			Date:  from.Committer.Date,
+		},
+	}
+}
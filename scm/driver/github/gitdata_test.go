@@ -0,0 +1,301 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGitDataFindBlob(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blob.json")
+
+	client := NewDefault()
+	got, res, err := client.GitData.FindBlob(context.Background(), "octocat/hello-world", "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Blob)
+	raw, _ := ioutil.ReadFile("testdata/blob.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataCreateBlob(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/blobs").
+		JSON(map[string]string{
+			"content":  "Q29udGVudCBvZiB0aGUgYmxvYg==",
+			"encoding": "base64",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blob_create.json")
+
+	input := &scm.BlobInput{
+		Content: []byte("Content of the blob"),
+	}
+
+	client := NewDefault()
+	got, res, err := client.GitData.CreateBlob(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Blob)
+	raw, _ := ioutil.ReadFile("testdata/blob.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/9fb037999f264ba9a7fc6274d15fa3ae2ab98312").
+		MatchParam("recursive", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	client := NewDefault()
+	got, res, err := client.GitData.FindTree(context.Background(), "octocat/hello-world", "9fb037999f264ba9a7fc6274d15fa3ae2ab98312", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataCreateTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		BodyString(`{"base_tree":"9fb037999f264ba9a7fc6274d15fa3ae2ab98312","tree":[{"path":"file.rb","mode":"100644","type":"blob","content":"puts 'hello'"},{"path":"subdir/exec_file","mode":"100755","type":"blob","sha":"45b983be36b73c0788dc9cbcb76cbb80fc7bb057"},{"path":"README","mode":"100644","type":"blob","sha":null}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	input := &scm.TreeInput{
+		Base: "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		Entries: []*scm.TreeEntryInput{
+			{Path: "file.rb", Content: []byte("puts 'hello'")},
+			{Path: "subdir/exec_file", Mode: "100755", Sha: "45b983be36b73c0788dc9cbcb76cbb80fc7bb057"},
+			{Path: "README", Delete: true},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.GitData.CreateTree(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		BodyString(`{"message":"my commit message","tree":"827efc6d56897b048c772eb4087f854f46256132","parents":["7d1b31e74ee336d15cbd21741bc88a537ed063a0"],"author":{"name":"Monalisa Octocat","email":"octocat@github.com"}}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	input := &scm.GitCommitInput{
+		Message: "my commit message",
+		Tree:    "827efc6d56897b048c772eb4087f854f46256132",
+		Parents: []string{"7d1b31e74ee336d15cbd21741bc88a537ed063a0"},
+		Author: scm.Signature{
+			Name:  "Monalisa Octocat",
+			Email: "octocat@github.com",
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.GitData.CreateCommit(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataCreateCommit_Entries(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/commits/7d1b31e74ee336d15cbd21741bc88a537ed063a0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		BodyString(`{"base_tree":"827efc6d56897b048c772eb4087f854f46256132","tree":[{"path":"file.rb","mode":"100644","type":"blob","content":"puts 'hello'"}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		BodyString(`{"message":"my commit message","tree":"9fb037999f264ba9a7fc6274d15fa3ae2ab98312","parents":["7d1b31e74ee336d15cbd21741bc88a537ed063a0"]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/master").
+		BodyString(`{"sha":"7638417db6d59f3c431d3e1f261cc637155684cd","force":false}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	input := &scm.GitCommitInput{
+		Message: "my commit message",
+		Parents: []string{"7d1b31e74ee336d15cbd21741bc88a537ed063a0"},
+		Branch:  "master",
+		Entries: []*scm.TreeEntryInput{
+			{Path: "file.rb", Content: []byte("puts 'hello'")},
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.GitData.CreateCommit(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitDataUpdateRef(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/featureA").
+		JSON(map[string]interface{}{
+			"sha":   "aa218f56b14c9653891f9e74264a383fa43fefbd",
+			"force": true,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref_update.json")
+
+	input := &scm.ReferenceUpdateInput{
+		Name:  "featureA",
+		Sha:   "aa218f56b14c9653891f9e74264a383fa43fefbd",
+		Force: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.GitData.UpdateRef(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/ref_update.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
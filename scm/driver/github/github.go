@@ -9,9 +9,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -46,15 +47,23 @@ func NewWithOptionalProxy(uri, proxyURL string) (*scm.Client, error) {
 	client.Driver = scm.DriverGithub
 	client.Linker = &linker{websiteAddress(base)}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &RepositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 
@@ -102,7 +111,17 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
-	if in != nil {
+	switch content := in.(type) {
+	case nil:
+	case *assetInput:
+		// the asset is streamed to the body of the request
+		// to avoid buffering large files in memory.
+		req.Body = content.Body
+		req.ContentLength = content.Size
+		req.Header = map[string][]string{
+			"Content-Type": {content.ContentType},
+		}
+	default:
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in)
 		req.Header = map[string][]string{
@@ -121,26 +140,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// parse the github request id.
 	res.ID = res.Header.Get("X-GitHub-Request-Id")
 
-	// parse the github rate limit details.
-	res.Rate.Limit, _ = strconv.Atoi(
-		res.Header.Get("X-RateLimit-Limit"),
-	)
-	res.Rate.Remaining, _ = strconv.Atoi(
-		res.Header.Get("X-RateLimit-Remaining"),
-	)
-	res.Rate.Reset, _ = strconv.ParseInt(
-		res.Header.Get("X-RateLimit-Reset"), 10, 64,
-	)
-
 	// snapshot the request rate limit
 	c.Client.SetRate(res.Rate)
 
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -152,15 +158,181 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	return c.streamRequest(ctx, req)
+}
+
+// streamRequest wraps the Client.Do function and returns the
+// response body without reading it. The caller is responsible
+// for closing the response body.
+func (c *wrapper) streamRequest(ctx context.Context, req *scm.Request) (io.ReadCloser, *scm.Response, error) {
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the github request id.
+	res.ID = res.Header.Get("X-GitHub-Request-Id")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// streamRedirect wraps the Client.Do function and returns the
+// response body without reading it. A redirect is not followed
+// with the client credentials, and the redirect location is
+// requested with the download function.
+func (c *wrapper) streamRedirect(ctx context.Context, req *scm.Request) (io.ReadCloser, *scm.Response, error) {
+	req.NoRedirect = true
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the github request id.
+	res.ID = res.Header.Get("X-GitHub-Request-Id")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	if location := res.Header.Get("Location"); res.Status >= 300 && res.Status < 400 && location != "" {
+		res.Body.Close()
+		return c.download(ctx, location)
+	}
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// download streams the file at the given url. The request is
+// authenticated only if the url has the same origin as the
+// API, so that credentials are never sent to a third-party
+// host. Other urls are requested without credentials using
+// the default http client.
+func (c *wrapper) download(ctx context.Context, rawurl string) (io.ReadCloser, *scm.Response, error) {
+	uri, err := c.BaseURL.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uri.Scheme == c.BaseURL.Scheme && uri.Host == c.BaseURL.Host {
+		return c.stream(ctx, "GET", rawurl)
+	}
+	client := &scm.Client{BaseURL: uri}
+	res, err := client.Do(ctx, &scm.Request{Method: "GET", Path: uri.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// graphql wraps the do function by posting the query to the
+// GraphQL api and unmarshalling the response data into out.
+func (c *wrapper) graphql(ctx context.Context, query string, vars map[string]interface{}, out interface{}) (*scm.Response, error) {
+	in := &graphqlInput{
+		Query:     query,
+		Variables: vars,
+	}
+	data := &graphqlOutput{Data: out}
+	res, err := c.do(ctx, "POST", graphqlPath(c.BaseURL), in, data)
+	if err != nil {
+		return res, err
+	}
+	if len(data.Errors) != 0 {
+		return res, &Error{Message: data.Errors[0].Message}
+	}
+	return res, nil
+}
+
+// graphqlInput represents a GitHub GraphQL request.
+type graphqlInput struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphqlOutput represents a GitHub GraphQL response. The
+// GraphQL api returns errors with a 200 status code.
+type graphqlOutput struct {
+	Data   interface{} `json:"data"`
+	Errors []struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphqlPath returns the GraphQL endpoint relative to the
+// base url. GitHub Enterprise serves the REST api from
+// /api/v3 and the GraphQL api from /api/graphql.
+func graphqlPath(base *url.URL) string {
+	if strings.HasSuffix(base.Path, "/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
 // Error represents a Github error.
 type Error struct {
 	Message string `json:"message"`
+	Errors  []struct {
+		Resource string `json:"resource"`
+		Field    string `json:"field"`
+		Code     string `json:"code"`
+		Message  string `json:"message"`
+	} `json:"errors"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
 
+// newError returns an API error from the github error
+// response.
+func newError(res *scm.Response) error {
+	raw, _ := ioutil.ReadAll(res.Body)
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	to := &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Message:   from.Message,
+		Header:    res.Header,
+		Raw:       raw,
+		Err:       from,
+	}
+	for _, v := range from.Errors {
+		to.Errors = append(to.Errors, scm.FieldError{
+			Resource: v.Resource,
+			Field:    v.Field,
+			Code:     v.Code,
+			Message:  v.Message,
+		})
+	}
+	return to
+}
+
 // helper function converts the github API url to
 // the website url.
 func websiteAddress(u *url.URL) string {
@@ -46,15 +46,20 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues", repo)
-	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
-	}
+	in := convertIssueInput(input)
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := convertIssueInput(input)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/comments", repo, number)
 	in := &issueCommentInput{
@@ -65,6 +70,16 @@ func (s *issueService) CreateComment(ctx context.Context, repo string, number in
 	return convertIssueComment(out), res, err
 }
 
+func (s *issueService) UpdateComment(ctx context.Context, repo string, number, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/comments/%d", repo, id)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssueComment(out), res, err
+}
+
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/comments/%d", repo, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -78,6 +93,14 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	out := new(issue)
+	res, err := s.client.do(ctx, "PATCH", path, &data, out)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues/%d/lock", repo, number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -105,14 +128,23 @@ type issue struct {
 	Labels []struct {
 		Name string `json:"name"`
 	} `json:"labels"`
-	Locked    bool      `json:"locked"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Assignees []struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"assignees"`
+	Milestone *milestone `json:"milestone"`
+	Locked    bool       `json:"locked"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
 }
 
 type issueInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	Title     string    `json:"title,omitempty"`
+	Body      string    `json:"body,omitempty"`
+	Labels    *[]string `json:"labels,omitempty"`
+	Assignees *[]string `json:"assignees,omitempty"`
+	Milestone int       `json:"milestone,omitempty"`
+	State     string    `json:"state,omitempty"`
 }
 
 type issueComment struct {
@@ -145,6 +177,17 @@ func convertIssueList(from []*issue) []*scm.Issue {
 // helper function to convert from the gogs issue structure to
 // the common issue structure.
 func convertIssue(from *issue) *scm.Issue {
+	var assignees []scm.User
+	for _, v := range from.Assignees {
+		assignees = append(assignees, scm.User{
+			Login:  v.Login,
+			Avatar: v.AvatarURL,
+		})
+	}
+	var milestone *scm.Milestone
+	if from.Milestone != nil {
+		milestone = convertMilestone(from.Milestone)
+	}
 	return &scm.Issue{
 		Number: from.Number,
 		Title:  from.Title,
@@ -161,9 +204,30 @@ func convertIssue(from *issue) *scm.Issue {
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
+		Assignees: assignees,
+		Milestone: milestone,
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+	}
+}
+
+// helper function to convert from the common issue input to
+// the github issue input. The labels and assignees are
+// replaced if the list is not nil.
+func convertIssueInput(from *scm.IssueInput) *issueInput {
+	to := &issueInput{
+		Title:     from.Title,
+		Body:      from.Body,
+		Milestone: from.Milestone,
+		State:     from.State,
+	}
+	if from.Labels != nil {
+		to.Labels = &from.Labels
 	}
+	if from.Assignees != nil {
+		to.Assignees = &from.Assignees
+	}
+	return to
 }
 
 // helper function to convert from the gogs issue comment list
@@ -180,6 +180,49 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1347").
+		JSON(map[string]interface{}{
+			"title":     "Found a bug",
+			"labels":    []string{"bug"},
+			"assignees": []string{"octocat"},
+			"milestone": 1,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Labels:    []string{"bug"},
+		Assignees: []string{"octocat"},
+		Milestone: 1,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "octocat/hello-world", 1347, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -214,6 +257,41 @@ func TestIssueCreateComment(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/comments/1").
+		JSON(map[string]string{"body": "what?"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_comment.json")
+
+	input := &scm.CommentInput{
+		Body: "what?",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.UpdateComment(context.Background(), "octocat/hello-world", 1, 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCommentDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +334,28 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	defer gock.Off()
 
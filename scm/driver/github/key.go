@@ -0,0 +1,150 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys?%s", repo, encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys", repo)
+	in := &keyInput{
+		Title:    input.Title,
+		Key:      input.Key,
+		ReadOnly: input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{ArmoredPublicKey: input.Key}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+type key struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	Key       string    `json:"key"`
+	ReadOnly  bool      `json:"read_only"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only,omitempty"`
+}
+
+type gpgKey struct {
+	ID        int    `json:"id"`
+	KeyID     string `json:"key_id"`
+	PublicKey string `json:"public_key"`
+	Emails    []struct {
+		Email string `json:"email"`
+	} `json:"emails"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type gpgKeyInput struct {
+	ArmoredPublicKey string `json:"armored_public_key"`
+}
+
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: from.ReadOnly,
+		Created:  from.CreatedAt,
+	}
+}
+
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	to := &scm.GPGKey{
+		ID:      strconv.Itoa(from.ID),
+		KeyID:   from.KeyID,
+		Key:     from.PublicKey,
+		Emails:  []string{},
+		Created: from.CreatedAt,
+		Expires: from.ExpiresAt,
+	}
+	for _, v := range from.Emails {
+		to.Emails = append(to.Emails, v.Email)
+	}
+	return to
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels?%s", repo, encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelUpdateInput{
+		NewName:     input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: labels}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *labelService) RemoveIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	var res *scm.Response
+	var err error
+	for _, name := range labels {
+		path := fmt.Sprintf("repos/%s/issues/%d/labels/%s", repo, number, url.PathEscape(name))
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *labelService) SetIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: labels}
+	if in.Labels == nil {
+		in.Labels = []string{}
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// pull requests are issues, and pull request labels are
+// managed using the issue endpoints.
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.AddIssueLabels(ctx, repo, number, labels)
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.RemoveIssueLabels(ctx, repo, number, labels)
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.SetIssueLabels(ctx, repo, number, labels)
+}
+
+type label struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+type labelInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type labelUpdateInput struct {
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type labelsInput struct {
+	Labels []string `json:"labels"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Color:       from.Color,
+		Description: from.Description,
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/labels/bug").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	client := NewDefault()
+	got, res, err := client.Labels.Find(context.Background(), "octocat/hello-world", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/labels").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	got, res, err := client.Labels.List(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/labels").
+		JSON(map[string]string{
+			"name":        "bug",
+			"color":       "f29513",
+			"description": "Something isn't working",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:        "bug",
+		Color:       "#f29513",
+		Description: "Something isn't working",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Labels.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestLabelUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/labels/good first issue").
+		JSON(map[string]string{"new_name": "bug"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name: "bug",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Labels.Update(context.Background(), "octocat/hello-world", "good first issue", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/labels/bug").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Labels.Delete(context.Background(), "octocat/hello-world", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestLabelAddIssueLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1347/labels").
+		JSON(map[string][]string{"labels": {"bug", "enhancement"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/labels.json")
+
+	client := NewDefault()
+	_, err := client.Labels.AddIssueLabels(context.Background(), "octocat/hello-world", 1347, []string{"bug", "enhancement"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels added")
+	}
+}
+
+func TestLabelRemoveIssueLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1347/labels/bug").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1347/labels/enhancement").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	client := NewDefault()
+	_, err := client.Labels.RemoveIssueLabels(context.Background(), "octocat/hello-world", 1347, []string{"bug", "enhancement"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels removed")
+	}
+}
+
+func TestLabelSetPullRequestLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/issues/1347/labels").
+		JSON(map[string][]string{"labels": {}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	client := NewDefault()
+	_, err := client.Labels.SetPullRequestLabels(context.Background(), "octocat/hello-world", 1347, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels replaced")
+	}
+}
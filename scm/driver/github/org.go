@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -55,6 +56,76 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams?%s", name, encodeListOptions(opts))
+	out := []*team{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, slug string) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s", name, slug)
+	out := new(team)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams", name)
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	out := new(team)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, slug string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s", name, slug)
+	in := &teamInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	out := new(team)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, slug string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s", name, slug)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, slug string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/members?%s", name, slug, encodeListOptions(opts))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertUserList(out), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, slug, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", name, slug, username)
+	in := &teamMembershipInput{Role: "member"}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, slug, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", name, slug, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, slug, repo string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s", name, slug, repo)
+	in := &permissionInput{Permission: convertFromPermission(perm)}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, slug, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s", name, slug, repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func convertOrganizationList(from []*organization) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from {
@@ -74,6 +145,26 @@ type membership struct {
 	Organization organization `json:"organization,omitempty"`
 }
 
+type team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
+type teamInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type teamMembershipInput struct {
+	Role string `json:"role"`
+}
+
+type permissionInput struct {
+	Permission string `json:"permission"`
+}
+
 func convertOrganization(from *organization) *scm.Organization {
 	return &scm.Organization{
 		Name:   from.Login,
@@ -107,3 +198,31 @@ func convertMembership(from *membership) *scm.Membership {
 	to.Organization.Avatar = from.Organization.Avatar
 	return to
 }
+
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          strconv.Itoa(from.ID),
+		Name:        from.Name,
+		Slug:        from.Slug,
+		Description: from.Description,
+	}
+}
+
+func convertFromPermission(from scm.Permission) string {
+	switch from {
+	case scm.PermissionAdmin:
+		return "admin"
+	case scm.PermissionWrite:
+		return "push"
+	default:
+		return "pull"
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// GitHub Actions workflow runs.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	// the workflow dispatch endpoint does not return the
+	// workflow run that is created.
+	path := fmt.Sprintf("repos/%s/actions/workflows/%s/dispatches", repo, input.Pipeline)
+	in := &workflowDispatchInput{
+		Ref:    input.Ref,
+		Inputs: input.Inputs,
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	return nil, res, err
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs?%s", repo, encodePipelineListOptions(opts))
+	if opts.Pipeline != "" {
+		path = fmt.Sprintf("repos/%s/actions/workflows/%s/runs?%s", repo, opts.Pipeline, encodePipelineListOptions(opts))
+	}
+	out := new(workflowRunList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertWorkflowRunList(out), res, err
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%s", repo, id)
+	out := new(workflowRun)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertWorkflowRun(out)
+	run.Jobs = []*scm.PipelineJob{}
+	// the jobs are paginated, and every page is requested.
+	for page := 1; page != 0; page = res.Page.Next {
+		path = fmt.Sprintf("repos/%s/actions/runs/%s/jobs?page=%d&per_page=100", repo, id, page)
+		jobs := new(workflowJobList)
+		res, err = s.client.do(ctx, "GET", path, nil, jobs)
+		if err != nil {
+			return nil, res, err
+		}
+		run.Jobs = append(run.Jobs, convertWorkflowJobList(jobs)...)
+	}
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%s/cancel", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%s/rerun", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	// the logs endpoint redirects to a temporary download
+	// url, which is requested without credentials.
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("repos/%s/actions/jobs/%s/logs", repo, job),
+	}
+	return s.client.streamRedirect(ctx, req)
+}
+
+type workflowDispatchInput struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+type workflowRunList struct {
+	TotalCount   int            `json:"total_count"`
+	WorkflowRuns []*workflowRun `json:"workflow_runs"`
+}
+
+type workflowRun struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	RunNumber  int       `json:"run_number"`
+	HeadBranch string    `json:"head_branch"`
+	HeadSha    string    `json:"head_sha"`
+	Event      string    `json:"event"`
+	Status     string    `json:"status"`
+	Conclusion string    `json:"conclusion"`
+	HTMLURL    string    `json:"html_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type workflowJobList struct {
+	TotalCount int            `json:"total_count"`
+	Jobs       []*workflowJob `json:"jobs"`
+}
+
+type workflowJob struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	HTMLURL     string    `json:"html_url"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("branch", scm.TrimRef(opts.Ref))
+	}
+	return params.Encode()
+}
+
+// helper function returns the execution status of a workflow
+// run or job. Completed runs and jobs are converted using the
+// conclusion.
+func convertWorkflowStatus(status, conclusion string) scm.ExecutionStatus {
+	if status == "completed" {
+		return scm.ConvertExecutionStatus(conclusion)
+	}
+	return scm.ConvertExecutionStatus(status)
+}
+
+func convertWorkflowRunList(from *workflowRunList) []*scm.PipelineRun {
+	to := []*scm.PipelineRun{}
+	for _, v := range from.WorkflowRuns {
+		to = append(to, convertWorkflowRun(v))
+	}
+	return to
+}
+
+func convertWorkflowRun(from *workflowRun) *scm.PipelineRun {
+	return &scm.PipelineRun{
+		ID:       strconv.FormatInt(from.ID, 10),
+		Number:   from.RunNumber,
+		Pipeline: from.Name,
+		Ref:      from.HeadBranch,
+		Sha:      from.HeadSha,
+		Event:    from.Event,
+		Status:   convertWorkflowStatus(from.Status, from.Conclusion),
+		Link:     from.HTMLURL,
+		Created:  from.CreatedAt,
+		Updated:  from.UpdatedAt,
+	}
+}
+
+func convertWorkflowJobList(from *workflowJobList) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from.Jobs {
+		to = append(to, &scm.PipelineJob{
+			ID:       strconv.FormatInt(v.ID, 10),
+			Name:     v.Name,
+			Status:   convertWorkflowStatus(v.Status, v.Conclusion),
+			Link:     v.HTMLURL,
+			Started:  v.StartedAt,
+			Finished: v.CompletedAt,
+		})
+	}
+	return to
+}
@@ -0,0 +1,294 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/actions/workflows/build.yml/dispatches").
+		BodyString(`{"ref":"master","inputs":{"environment":"staging"}}`).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, res, err := client.Pipelines.Trigger(context.Background(), "octocat/hello-world", &scm.PipelineInput{Pipeline: "build.yml", Ref: "master", Inputs: map[string]string{"environment": "staging"}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs").
+		MatchParam("branch", "master").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_runs.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.ListRuns(context.Background(), "octocat/hello-world", scm.PipelineListOptions{Ref: "refs/heads/master", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/workflow_runs.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs/30433642").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_run.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs/30433642/jobs").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_jobs.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.FindRun(context.Background(), "octocat/hello-world", "30433642")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/workflow_run.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindRun_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs/30433642").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_run.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs/30433642/jobs").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://api.github.com/repositories/1296269/actions/runs/30433642/jobs?page=2&per_page=100>; rel="next"`).
+		JSON(map[string]interface{}{
+			"total_count": 3,
+			"jobs":        []map[string]interface{}{{"id": 1, "name": "lint"}},
+		})
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/runs/30433642/jobs").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/workflow_jobs.json")
+
+	client := NewDefault()
+	got, _, err := client.Pipelines.FindRun(context.Background(), "octocat/hello-world", "30433642")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	var names []string
+	for _, job := range got.Jobs {
+		names = append(names, job.Name)
+	}
+	if diff := cmp.Diff(names, []string{"lint", "build", "test"}); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/actions/runs/30433642/cancel").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Pipelines.CancelRun(context.Background(), "octocat/hello-world", "30433642")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineRetryRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/actions/runs/30433642/rerun").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Pipelines.RetryRun(context.Background(), "octocat/hello-world", "30433642")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindLogs_Redirect(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/jobs/399444496/logs").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(302).
+		SetHeaders(mockHeaders).
+		SetHeader("Location", "https://pipelines.actions.githubusercontent.com/logs/399444496?sig=abc")
+
+	// the token must not be sent to the storage host, which
+	// rejects signed urls that also carry credentials.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://pipelines.actions.githubusercontent.com").
+		Get("/logs/399444496").
+		MatchParam("sig", "abc").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("text/plain").
+		BodyString("2020-01-20T17:42:40.0000000Z Hello, world!\n")
+
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Pipelines.FindLogs(context.Background(), "octocat/hello-world", "30433642", "399444496")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "2020-01-20T17:42:40.0000000Z Hello, world!\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/jobs/399444496/logs").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		BodyString("2020-01-20T17:42:40.0000000Z Hello, world!\n")
+
+	client := NewDefault()
+	rc, res, err := client.Pipelines.FindLogs(context.Background(), "octocat/hello-world", "30433642", "399444496")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "2020-01-20T17:42:40.0000000Z Hello, world!\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindLogsNotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/jobs/1/logs").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error.json")
+
+	client := NewDefault()
+	_, _, err := client.Pipelines.FindLogs(context.Background(), "octocat/hello-world", "30433642", "1")
+	if err == nil {
+		t.Errorf("Expect Not Found error")
+		return
+	}
+	if got, want := err.Error(), "Not Found"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
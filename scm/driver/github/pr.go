@@ -6,7 +6,10 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -51,6 +54,43 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	in := &prMergeInput{
+		CommitTitle:   opts.CommitTitle,
+		CommitMessage: opts.CommitMessage,
+		SHA:           opts.SHA,
+	}
+	switch opts.Method {
+	case scm.MergeMethodMerge:
+		in.MergeMethod = "merge"
+	case scm.MergeMethodSquash:
+		in.MergeMethod = "squash"
+	case scm.MergeMethodRebase:
+		in.MergeMethod = "rebase"
+	case scm.MergeMethodFastForward:
+		return nil, &scm.MergeMethodError{Method: opts.Method}
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/merge", repo, number)
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	if !opts.DeleteSourceBranch {
+		return res, nil
+	}
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	// the source branch cannot be deleted if the pull
+	// request was opened from a fork.
+	if !strings.EqualFold(pr.Fork, repo) {
+		return res, nil
+	}
+	path = fmt.Sprintf("repos/%s/git/refs/heads/%s", repo, pr.Source)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -58,6 +98,57 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	data := map[string]string{"state": "open"}
+	res, err := s.client.do(ctx, "PATCH", path, &data, nil)
+	return res, err
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	in := &prUpdateInput{
+		Title: input.Title,
+		Body:  input.Body,
+		Base:  input.Target,
+	}
+	out := new(pr)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	if err != nil || input.Draft == nil || *input.Draft == out.Draft {
+		return convertPullRequest(out), res, err
+	}
+	// the draft state cannot be changed with the rest api,
+	// and is changed with a graphql mutation instead.
+	res, err = s.setDraft(ctx, out.NodeID, *input.Draft)
+	if err == nil {
+		out.Draft = *input.Draft
+	}
+	return convertPullRequest(out), res, err
+}
+
+// setDraft changes the draft state of the pull request
+// using the graphql api.
+func (s *pullService) setDraft(ctx context.Context, id string, draft bool) (*scm.Response, error) {
+	mutation := "markPullRequestReadyForReview"
+	if draft {
+		mutation = "convertPullRequestToDraft"
+	}
+	query := fmt.Sprintf("mutation($id: ID!) { %s(input: {pullRequestId: $id}) { clientMutationId } }", mutation)
+	return s.client.graphql(ctx, query, map[string]interface{}{"id": id}, nil)
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls", repo)
 	in := &prInput{
@@ -71,8 +162,54 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &prReviewersInput{
+		Reviewers:     input.Users,
+		TeamReviewers: input.Teams,
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	// reviewers are removed from the requested reviewers
+	// once the review is submitted, so the submitted
+	// reviews are listed to include their review state.
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	reviews, res, err := s.listReviews(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return mergeReviewers(pr.Reviewers, reviews), res, nil
+}
+
+func (s *pullService) SetAssignees(ctx context.Context, repo string, number int, assignees []string) (*scm.Response, error) {
+	// pull request assignees are managed with the issues
+	// api, which replaces the assignees when updating the
+	// issue.
+	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
+	in := &prAssigneesInput{Assignees: assignees}
+	if in.Assignees == nil {
+		in.Assignees = []string{}
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 type pr struct {
 	Number  int    `json:"number"`
+	NodeID  string `json:"node_id"`
 	State   string `json:"state"`
 	Title   string `json:"title"`
 	Body    string `json:"body"`
@@ -109,6 +246,18 @@ type pr struct {
 		Name  string `json:"name"`
 		Color string `json:"color"`
 	} `json:"labels"`
+	Assignees []struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"assignees"`
+	RequestedReviewers []struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"requested_reviewers"`
+	RequestedTeams []struct {
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	} `json:"requested_teams"`
 }
 
 type prInput struct {
@@ -118,6 +267,28 @@ type prInput struct {
 	Base  string `json:"base"`
 }
 
+type prUpdateInput struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Base  string `json:"base,omitempty"`
+}
+
+type prReviewersInput struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+type prAssigneesInput struct {
+	Assignees []string `json:"assignees"`
+}
+
+type prMergeInput struct {
+	CommitTitle   string `json:"commit_title,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+	SHA           string `json:"sha,omitempty"`
+	MergeMethod   string `json:"merge_method,omitempty"`
+}
+
 type file struct {
 	BlobID           string `json:"sha"`
 	Filename         string `json:"filename"`
@@ -128,6 +299,67 @@ type file struct {
 	PreviousFilename string `json:"previous_filename"`
 }
 
+// helper function lists each page of the pull request
+// reviews.
+func (s *pullService) listReviews(ctx context.Context, repo string, number int) ([]*prReview, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	var to []*prReview
+	for {
+		path := fmt.Sprintf("repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+		out := []*prReview{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		to = append(to, out...)
+		if res.Page.Next == 0 {
+			return to, res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// mergeReviewers adds the users that submitted a review to
+// the requested reviewers, with the state of their latest
+// review. A comment does not replace an earlier approval or
+// request for changes, and users that are requested to
+// review again remain pending.
+func mergeReviewers(requested []scm.Reviewer, reviews []*prReview) []scm.Reviewer {
+	to := requested
+	pending := map[string]bool{}
+	for _, v := range requested {
+		if v.User.Login != "" {
+			pending[v.User.Login] = true
+		}
+	}
+	index := map[string]int{}
+	for _, v := range reviews {
+		state := convertReviewState(v.State)
+		if state == scm.ReviewStatePending || state == scm.ReviewStateUnknown {
+			continue
+		}
+		login := v.User.Login
+		if pending[login] {
+			continue
+		}
+		if i, ok := index[login]; ok {
+			if state != scm.ReviewStateCommented || to[i].State == scm.ReviewStateCommented {
+				to[i].State = state
+			}
+			continue
+		}
+		index[login] = len(to)
+		to = append(to, scm.Reviewer{
+			User: scm.User{
+				Login:  login,
+				Avatar: v.User.AvatarURL,
+			},
+			State: state,
+		})
+	}
+	return to
+}
+
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
 	to := []*scm.PullRequest{}
 	for _, v := range from {
@@ -144,6 +376,31 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Color: label.Color,
 		})
 	}
+	// requested reviewers are removed from the pull request
+	// once the review is submitted, and are pending review.
+	var reviewers []scm.Reviewer
+	for _, v := range from.RequestedReviewers {
+		reviewers = append(reviewers, scm.Reviewer{
+			User: scm.User{
+				Login:  v.Login,
+				Avatar: v.AvatarURL,
+			},
+			State: scm.ReviewStatePending,
+		})
+	}
+	for _, v := range from.RequestedTeams {
+		reviewers = append(reviewers, scm.Reviewer{
+			Team:  v.Slug,
+			State: scm.ReviewStatePending,
+		})
+	}
+	var assignees []scm.User
+	for _, v := range from.Assignees {
+		assignees = append(assignees, scm.User{
+			Login:  v.Login,
+			Avatar: v.AvatarURL,
+		})
+	}
 	return &scm.PullRequest{
 		Number: from.Number,
 		Title:  from.Title,
@@ -172,9 +429,11 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
-		Labels:  labels,
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+		Labels:    labels,
+		Reviewers: reviewers,
+		Assignees: assignees,
 	}
 }
 
@@ -196,3 +455,16 @@ func convertChange(from *file) *scm.Change {
 		PrevFilePath: from.PreviousFilename,
 	}
 }
+
+// convertMergeError returns a MergeMethodError if the merge
+// failed because the merge method is not allowed for the
+// repository.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		apiErr.Status == http.StatusMethodNotAllowed &&
+		strings.Contains(apiErr.Message, "not allowed") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
+}
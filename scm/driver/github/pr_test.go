@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -46,6 +47,72 @@ func TestPullFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://api.github.com/repositories/1300192/pulls/1347/reviews?page=2&per_page=100>; rel="next"`).
+		BodyString(`[
+			{"user":{"login":"octocat"},"state":"APPROVED"},
+			{"user":{"login":"hubot"},"state":"COMMENTED"},
+			{"user":{"login":"other_user"},"state":"CHANGES_REQUESTED"}
+		]`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[
+			{"user":{"login":"octocat"},"state":"COMMENTED"},
+			{"user":{"login":"hubot"},"state":"CHANGES_REQUESTED"},
+			{"user":{"login":"monalisa"},"state":"PENDING"}
+		]`)
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.ListReviewers(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []scm.Reviewer{
+		{
+			User:  scm.User{Login: "other_user", Avatar: "https://github.com/images/error/other_user_happy.gif"},
+			State: scm.ReviewStatePending,
+		},
+		{
+			Team:  "justice-league",
+			State: scm.ReviewStatePending,
+		},
+		{
+			User:  scm.User{Login: "octocat"},
+			State: scm.ReviewStateApproved,
+		},
+		{
+			User:  scm.User{Login: "hubot"},
+			State: scm.ReviewStateChangesRequested,
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -133,6 +200,87 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		JSON(map[string]string{
+			"commit_title":   "Add new topic (#1347)",
+			"commit_message": "Squashed",
+			"sha":            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"merge_method":   "squash",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/git/refs/heads/new-topic").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Add new topic (#1347)",
+		CommitMessage:      "Squashed",
+		SHA:                "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.MergeWithOptions(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect source branch deleted")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMergeWithOptions_NotAllowed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/merge").
+		Reply(405).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Rebase merges are not allowed on this repository."}`)
+
+	opts := scm.PullRequestMergeOptions{Method: scm.MergeMethodRebase}
+
+	client := NewDefault()
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "octocat/hello-world", 1347, opts)
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+		return
+	}
+	if got, want := merr.Method, scm.MergeMethodRebase; got != want {
+		t.Errorf("Want merge method %s, got %s", want, got)
+	}
+	if got, want := err.Error(), "merge method rebase is not allowed"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+
+	_, err = client.PullRequests.MergeWithOptions(context.Background(), "octocat/hello-world", 1347, scm.PullRequestMergeOptions{Method: scm.MergeMethodFastForward})
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError for fast-forward, got %v", err)
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -153,6 +301,115 @@ func TestPullClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		JSON(map[string]string{"state": "open"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.Reopen(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		JSON(map[string]string{"base": "master"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	input := scm.PullRequestUpdateInput{
+		Target: "master",
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Update(context.Background(), "octocat/hello-world", 1347, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullConvertToDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("convertPullRequestToDraft").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data":{"convertPullRequestToDraft":{"clientMutationId":null}}}`)
+
+	client := NewDefault()
+	_, err := client.PullRequests.ConvertToDraft(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect draft state changed")
+	}
+}
+
+func TestPullMarkReady_Enterprise(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://example.com").
+		Patch("/api/v3/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"number":1347,"node_id":"MDExOlB1bGxSZXF1ZXN0MQ==","draft":true}`)
+
+	gock.New("https://example.com").
+		Post("/api/graphql").
+		BodyString("markPullRequestReadyForReview").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"data":null,"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by integration"}]}`)
+
+	client, _ := New("https://example.com/api/v3")
+	_, err := client.PullRequests.MarkReady(context.Background(), "octocat/hello-world", 1347)
+	if err == nil {
+		t.Errorf("Expect graphql error")
+	} else if got, want := err.Error(), "Resource not accessible by integration"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -221,3 +478,79 @@ func TestPullListCommits(t *testing.T) {
 	t.Run("Rate", testRate(res))
 	t.Run("Page", testPage(res))
 }
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		JSON(map[string][]string{
+			"reviewers":      {"octocat"},
+			"team_reviewers": {"justice-league"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"octocat"},
+		Teams: []string{"justice-league"},
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.RequestReviewers(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		JSON(map[string][]string{"reviewers": {"octocat"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"octocat"},
+	}
+
+	client := NewDefault()
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review request removed")
+	}
+}
+
+func TestPullSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1347").
+		JSON(map[string][]string{"assignees": {"octocat", "hubot"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.SetAssignees(context.Background(), "octocat/hello-world", 1347, []string{"octocat", "hubot"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect assignees updated")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type protectionService struct {
+	client *wrapper
+}
+
+type protection struct {
+	RequiredStatusChecks *protectionChecks  `json:"required_status_checks"`
+	RequiredReviews      *protectionReviews `json:"required_pull_request_reviews"`
+	Restrictions         *struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+		Apps []struct {
+			Slug string `json:"slug"`
+		} `json:"apps"`
+	} `json:"restrictions"`
+	EnforceAdmins    protectionSetting `json:"enforce_admins"`
+	AllowForcePushes protectionSetting `json:"allow_force_pushes"`
+	AllowDeletions   protectionSetting `json:"allow_deletions"`
+}
+
+type protectionInput struct {
+	RequiredStatusChecks *protectionChecks       `json:"required_status_checks"`
+	RequiredReviews      *protectionReviews      `json:"required_pull_request_reviews"`
+	Restrictions         *protectionRestrictions `json:"restrictions"`
+	EnforceAdmins        bool                    `json:"enforce_admins"`
+	AllowForcePushes     bool                    `json:"allow_force_pushes"`
+	AllowDeletions       bool                    `json:"allow_deletions"`
+}
+
+type protectionChecks struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type protectionReviews struct {
+	DismissStale      bool `json:"dismiss_stale_reviews"`
+	RequireCodeOwners bool `json:"require_code_owner_reviews"`
+	Count             int  `json:"required_approving_review_count"`
+}
+
+type protectionRestrictions struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+	Apps  []string `json:"apps"`
+}
+
+type protectionSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, branch)
+	out := new(protection)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertProtection(branch, out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, branch)
+	in := &protectionInput{
+		EnforceAdmins:    input.EnforceAdmins,
+		AllowForcePushes: input.AllowForcePush,
+		AllowDeletions:   input.AllowDeletion,
+	}
+	if v := input.RequiredStatusChecks; v != nil {
+		in.RequiredStatusChecks = &protectionChecks{
+			Strict:   v.Strict,
+			Contexts: append([]string{}, v.Contexts...),
+		}
+	}
+	if v := input.RequiredReviews; v != nil {
+		in.RequiredReviews = &protectionReviews{
+			DismissStale:      v.DismissStale,
+			RequireCodeOwners: v.RequireCodeOwners,
+			Count:             v.Count,
+		}
+	}
+	if v := input.Push; v != nil {
+		in.Restrictions = &protectionRestrictions{
+			Users: append([]string{}, v.Users...),
+			Teams: append([]string{}, v.Teams...),
+			Apps:  append([]string{}, v.Apps...),
+		}
+	}
+	out := new(protection)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertProtection(branch, out), res, err
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", repo, branch)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func convertProtection(branch string, from *protection) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:         branch,
+		AllowForcePush: from.AllowForcePushes.Enabled,
+		AllowDeletion:  from.AllowDeletions.Enabled,
+		EnforceAdmins:  from.EnforceAdmins.Enabled,
+	}
+	if v := from.RequiredStatusChecks; v != nil {
+		to.RequiredStatusChecks = &scm.RequiredStatusChecks{
+			Strict:   v.Strict,
+			Contexts: v.Contexts,
+		}
+	}
+	if v := from.RequiredReviews; v != nil {
+		to.RequiredReviews = &scm.RequiredReviews{
+			Count:             v.Count,
+			DismissStale:      v.DismissStale,
+			RequireCodeOwners: v.RequireCodeOwners,
+		}
+	}
+	if v := from.Restrictions; v != nil {
+		to.Push = &scm.BranchRestrictions{}
+		for _, user := range v.Users {
+			to.Push.Users = append(to.Push.Users, user.Login)
+		}
+		for _, team := range v.Teams {
+			to.Push.Teams = append(to.Push.Teams, team.Slug)
+		}
+		for _, app := range v.Apps {
+			to.Push.Apps = append(to.Push.Apps, app.Slug)
+		}
+	}
+	return to
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protection.json")
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Find(context.Background(), "octocat/hello-world", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protection.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestProtectionUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/branches/master/protection").
+		File("testdata/protection_update.json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protection.json")
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{
+			Count:             2,
+			DismissStale:      true,
+			RequireCodeOwners: true,
+		},
+		RequiredStatusChecks: &scm.RequiredStatusChecks{
+			Strict:   true,
+			Contexts: []string{"continuous-integration/drone"},
+		},
+		Push: &scm.BranchRestrictions{
+			Users: []string{"octocat"},
+			Teams: []string{"justice-league"},
+			Apps:  []string{"octoapp"},
+		},
+		EnforceAdmins: true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Update(context.Background(), "octocat/hello-world", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protection.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/branches/master/protection").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.BranchProtections.Delete(context.Background(), "octocat/hello-world", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
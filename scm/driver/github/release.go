@@ -3,6 +3,10 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -17,6 +21,7 @@ type release struct {
 	Title       string    `json:"name"`
 	Description string    `json:"body"`
 	Link        string    `json:"html_url,omitempty"`
+	UploadURL   string    `json:"upload_url,omitempty"`
 	Tag         string    `json:"tag_name,omitempty"`
 	Commitish   string    `json:"target_commitish,omitempty"`
 	Draft       bool      `json:"draft"`
@@ -25,6 +30,23 @@ type release struct {
 	Published   null.Time `json:"published_at"`
 }
 
+type releaseAsset struct {
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	ContentType        string    `json:"content_type"`
+	Size               int64     `json:"size"`
+	BrowserDownloadURL string    `json:"browser_download_url"`
+	CreatedAt          null.Time `json:"created_at"`
+}
+
+// assetInput is the streamed body of a release asset
+// upload, which is not encoded as json.
+type assetInput struct {
+	ContentType string
+	Size        int64
+	Body        io.Reader
+}
+
 type releaseInput struct {
 	Title       string `json:"name"`
 	Description string `json:"body"`
@@ -107,6 +129,69 @@ func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag strin
 	return s.Update(ctx, repo, rel.ID, input)
 }
 
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	rel, res, err := s.findByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("repos/%s/releases/%d/assets?%s", repo, rel.ID, encodeListOptions(opts))
+	out := []*releaseAsset{}
+	res, err = s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseAssetList(out), res, err
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	rel, res, err := s.findByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	// assets are uploaded to a separate host, which is
+	// provided by the release as a uri template.
+	params := url.Values{}
+	params.Set("name", input.Name)
+	path := strings.Split(rel.UploadURL, "{")[0] + "?" + params.Encode()
+	in := &assetInput{
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Body:        input.Body,
+	}
+	if in.ContentType == "" {
+		in.ContentType = "application/octet-stream"
+	}
+	out := new(releaseAsset)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertReleaseAsset(out), res, err
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	// the asset is identified by id, which is unique to the
+	// repository, and the release tag is not required.
+	req := &scm.Request{
+		Method: "GET",
+		Path:   fmt.Sprintf("repos/%s/releases/assets/%s", repo, id),
+		Header: map[string][]string{
+			"Accept": {"application/octet-stream"},
+		},
+	}
+	// the asset is redirected to a signed storage url, which
+	// is requested without credentials.
+	return s.client.streamRedirect(ctx, req)
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/assets/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// findByTag returns the native release for the given tag,
+// which includes the upload url.
+func (s *releaseService) findByTag(ctx context.Context, repo string, tag string) (*release, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/releases/tags/%s", repo, tag)
+	out := new(release)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
 func convertReleaseList(from []*release) []*scm.Release {
 	var to []*scm.Release
 	for _, m := range from {
@@ -129,3 +214,22 @@ func convertRelease(from *release) *scm.Release {
 		Published:   from.Published.ValueOrZero(),
 	}
 }
+
+func convertReleaseAssetList(from []*releaseAsset) []*scm.ReleaseAsset {
+	to := []*scm.ReleaseAsset{}
+	for _, v := range from {
+		to = append(to, convertReleaseAsset(v))
+	}
+	return to
+}
+
+func convertReleaseAsset(from *releaseAsset) *scm.ReleaseAsset {
+	return &scm.ReleaseAsset{
+		ID:          strconv.Itoa(from.ID),
+		Name:        from.Name,
+		ContentType: from.ContentType,
+		Size:        from.Size,
+		Link:        from.BrowserDownloadURL,
+		Created:     from.CreatedAt.ValueOrZero(),
+	}
+}
@@ -3,10 +3,14 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -192,3 +196,194 @@ func TestReleaseDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReleaseListAssets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/1/assets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_assets.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.ListAssets(context.Background(), "octocat/hello-world", "v1.0.0", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReleaseAsset{}
+	raw, _ := ioutil.ReadFile("testdata/release_assets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	// the asset must be streamed with the content length
+	// set, since uploads using chunked encoding are rejected.
+	matcher := gock.NewMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if req.ContentLength != 11 {
+			return false, nil
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		return string(body) == "hello world", err
+	})
+
+	gock.New("https://uploads.github.com").
+		Post("/repos/octocat/Hello-World/releases/1/assets").
+		MatchParam("name", "example.zip").
+		MatchHeader("Content-Type", "application/zip").
+		SetMatcher(matcher).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_asset.json")
+
+	input := &scm.ReleaseAssetInput{
+		Name:        "example.zip",
+		ContentType: "application/zip",
+		Size:        11,
+		Body:        struct{ io.Reader }{strings.NewReader("hello world")},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UploadAsset(context.Background(), "octocat/hello-world", "v1.0.0", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/release_asset.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseDownloadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/assets/1").
+		MatchHeader("Accept", "application/octet-stream").
+		Reply(200).
+		Type("application/octet-stream").
+		SetHeaders(mockHeaders).
+		BodyString("hello world")
+
+	client := NewDefault()
+	rc, res, err := client.Releases.DownloadAsset(context.Background(), "octocat/hello-world", "v1.0.0", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseDownloadAsset_Redirect(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/releases/assets/1").
+		MatchHeader("Accept", "application/octet-stream").
+		MatchHeader("Authorization", "Bearer secret").
+		Reply(302).
+		SetHeaders(mockHeaders).
+		SetHeader("Location", "https://objects.githubusercontent.com/assets/1?signature=abc")
+
+	// the token must not be sent to the storage host, which
+	// rejects signed urls that also carry credentials.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Authorization") == "", nil
+	})
+	gock.New("https://objects.githubusercontent.com").
+		Get("/assets/1").
+		MatchParam("signature", "abc").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &transport.BearerToken{Token: "secret"},
+	}
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "octocat/hello-world", "v1.0.0", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDeleteAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/releases/assets/1").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Releases.DeleteAsset(context.Background(), "octocat/hello-world", "v1.0.0", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -66,6 +66,53 @@ type repositoryList struct {
 	Repositories []*repository `json:"repositories"`
 }
 
+type repositoryInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+	Visibility  string `json:"visibility,omitempty"`
+	AutoInit    bool   `json:"auto_init"`
+}
+
+type repositoryGenerateInput struct {
+	Owner       string `json:"owner,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+	Visibility    string `json:"visibility,omitempty"`
+	Archived      *bool  `json:"archived,omitempty"`
+}
+
+type forkInput struct {
+	Organization string `json:"organization,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type transferInput struct {
+	NewOwner string `json:"new_owner"`
+}
+
+type branchRenameInput struct {
+	NewName string `json:"new_name"`
+}
+
+type collaborator struct {
+	user
+	Permissions struct {
+		Admin    bool `json:"admin"`
+		Maintain bool `json:"maintain"`
+		Push     bool `json:"push"`
+		Triage   bool `json:"triage"`
+		Pull     bool `json:"pull"`
+	} `json:"permissions"`
+}
+
 // RepositoryService implements the repository service for
 // the GitHub driver.
 type RepositoryService struct {
@@ -208,9 +255,12 @@ func (s *RepositoryService) CreateStatus(ctx context.Context, repo, ref string,
 }
 
 // CreateDeployStatus creates a new deployment status.
+//
+// Deprecated: use the DeploymentService to create the
+// deployment status.
 func (s *RepositoryService) CreateDeployStatus(ctx context.Context, repo string, input *scm.DeployStatus) (*scm.DeployStatus, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/deployments/%d/statuses", repo, input.Number)
-	in := &deployStatus{
+	in := &deployStatusInput{
 		State:          convertFromState(input.State),
 		Environment:    input.Environment,
 		EnvironmentURL: input.EnvironmentURL,
@@ -248,6 +298,129 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Create creates a new repository.
+func (s *RepositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return s.generate(ctx, input)
+	}
+	path := "user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("orgs/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     isPrivate(input.Visibility),
+		Visibility:  convertFromVisibility(input.Visibility),
+		AutoInit:    input.AutoInit,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+
+	// github does not accept the default branch name when
+	// creating a repository, so the initial branch is
+	// renamed. The first branch pushed to an empty
+	// repository becomes the default branch.
+	if input.AutoInit && input.Branch != "" && input.Branch != out.DefaultBranch {
+		path := fmt.Sprintf("repos/%s/branches/%s/rename", out.FullName, out.DefaultBranch)
+		in := &branchRenameInput{NewName: input.Branch}
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+		out.DefaultBranch = input.Branch
+	}
+	return convertRepository(out), res, nil
+}
+
+// generate creates a new repository from a template
+// repository. The default branch of the template
+// repository is used.
+func (s *RepositoryService) generate(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/generate", input.Template)
+	in := &repositoryGenerateInput{
+		Owner:       input.Namespace,
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     isPrivate(input.Visibility),
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Fork forks a repository.
+func (s *RepositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/forks", repo)
+	in := &forkInput{
+		Organization: input.Namespace,
+		Name:         input.Name,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates a repository.
+func (s *RepositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := &repositoryUpdateInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		Visibility:    convertFromVisibility(input.Visibility),
+		Archived:      input.Archived,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Delete deletes a repository.
+func (s *RepositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Transfer transfers a repository to a user or
+// organization.
+func (s *RepositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/transfer", repo)
+	in := &transferInput{NewOwner: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// ListCollaborators returns a list of users with access to
+// the repository, including access granted through teams
+// and organization membership.
+func (s *RepositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators?%s", repo, encodeListOptions(opts))
+	out := []*collaborator{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCollaboratorList(out), res, err
+}
+
+// AddCollaborator grants a user permission to the
+// repository. If the user is not a member of the
+// organization, an invitation is sent to the user.
+func (s *RepositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators/%s", repo, username)
+	in := &permissionInput{Permission: convertFromPermission(perm)}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// RemoveCollaborator revokes a user permission to the
+// repository.
+func (s *RepositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/collaborators/%s", repo, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the github repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -287,6 +460,24 @@ func convertRepository(from *repository) *scm.Repository {
 	}
 }
 
+// helper function returns true if the repository
+// visibility is private or internal.
+func isPrivate(from scm.Visibility) bool {
+	return from == scm.VisibilityPrivate || from == scm.VisibilityInternal
+}
+
+// helper function to convert from the common visibility
+// to the github visibility, or an empty string if the
+// visibility is undefined.
+func convertFromVisibility(from scm.Visibility) string {
+	switch from {
+	case scm.VisibilityPublic, scm.VisibilityInternal, scm.VisibilityPrivate:
+		return from.String()
+	default:
+		return ""
+	}
+}
+
 func convertHookList(from []*hook) []*scm.Hook {
 	to := []*scm.Hook{}
 	for _, v := range from {
@@ -342,7 +533,18 @@ type status struct {
 }
 
 type deployStatus struct {
-	ID             int64  `json:"id"`
+	ID             int64     `json:"id"`
+	Environment    string    `json:"environment"`
+	EnvironmentURL string    `json:"environment_url"`
+	State          string    `json:"state"`
+	TargetURL      string    `json:"log_url"`
+	Description    string    `json:"description"`
+	Creator        user      `json:"creator"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type deployStatusInput struct {
 	Environment    string `json:"environment"`
 	EnvironmentURL string `json:"environment_url"`
 	State          string `json:"state"`
@@ -370,11 +572,14 @@ func convertStatus(from *status) *scm.Status {
 func convertDeployStatus(from *deployStatus) *scm.DeployStatus {
 	return &scm.DeployStatus{
 		Number:         from.ID,
-		State:          convertState(from.State),
+		State:          convertDeployState(from.State),
 		Desc:           from.Description,
 		Target:         from.TargetURL,
 		Environment:    from.Environment,
 		EnvironmentURL: from.EnvironmentURL,
+		Author:         *convertUser(&from.Creator),
+		Created:        from.CreatedAt,
+		Updated:        from.UpdatedAt,
 	}
 }
 
@@ -405,3 +610,26 @@ func convertFromState(from scm.State) string {
 		return "error"
 	}
 }
+
+func convertCollaboratorList(from []*collaborator) []*scm.Collaborator {
+	to := []*scm.Collaborator{}
+	for _, v := range from {
+		to = append(to, convertCollaborator(v))
+	}
+	return to
+}
+
+func convertCollaborator(from *collaborator) *scm.Collaborator {
+	to := &scm.Collaborator{
+		User: *convertUser(&from.user),
+	}
+	switch {
+	case from.Permissions.Admin:
+		to.Permission = scm.PermissionAdmin
+	case from.Permissions.Maintain, from.Permissions.Push:
+		to.Permission = scm.PermissionWrite
+	case from.Permissions.Triage, from.Permissions.Pull:
+		to.Permission = scm.PermissionRead
+	}
+	return to
+}
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"reflect"
 	"testing"
@@ -452,6 +453,48 @@ func TestRepositoryHookCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookCreate_Validation(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks").
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/error_validation.json")
+
+	in := &scm.HookInput{
+		Name:   "drone",
+		Target: "https://example.com",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Repositories.CreateHook(context.Background(), "octocat/hello-world", in)
+	if !errors.Is(err, scm.ErrValidation) {
+		t.Errorf("Expect validation error, got %v", err)
+		return
+	}
+
+	apiErr := new(scm.APIError)
+	if !errors.As(err, &apiErr) {
+		t.Errorf("Expect API error")
+		return
+	}
+	if got, want := apiErr.Status, 422; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := apiErr.RequestID, "DD0E:6011:12F21A8:1926790:5A2064E2"; got != want {
+		t.Errorf("Want request id %q, got %q", want, got)
+	}
+	want := []scm.FieldError{
+		{Resource: "Hook", Code: "custom", Message: "Hook already exists on this repository"},
+	}
+	if diff := cmp.Diff(apiErr.Errors, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestRepositoryHookUpdate(t *testing.T) {
 	defer gock.Off()
 
@@ -513,6 +556,293 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/orgs/octocat/repos").
+		BodyString(`{"name":"Hello-World","description":"This your first repo!","private":true,"visibility":"private","auto_init":true}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/Hello-World/branches/master/rename").
+		BodyString(`{"new_name":"main"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.RepositoryInput{
+		Namespace:   "octocat",
+		Name:        "Hello-World",
+		Description: "This your first repo!",
+		Visibility:  scm.VisibilityPrivate,
+		Branch:      "main",
+		AutoInit:    true,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+	want.Branch = "main"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryCreate_Template(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/template/generate").
+		BodyString(`{"name":"Hello-World","private":false}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Name:       "Hello-World",
+		Visibility: scm.VisibilityPublic,
+		Template:   "octocat/template",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/forks").
+		BodyString(`{"organization":"octo-org"}`).
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Fork(context.Background(), "octocat/hello-world", &scm.ForkInput{Namespace: "octo-org"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world").
+		BodyString(`{"name":"Hello-World","default_branch":"master","visibility":"public","archived":false}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	archived := false
+	input := &scm.RepositoryUpdateInput{
+		Name:       "Hello-World",
+		Branch:     "master",
+		Visibility: scm.VisibilityPublic,
+		Archived:   &archived,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Update(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.Delete(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/transfer").
+		BodyString(`{"new_owner":"octo-org"}`).
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.Transfer(context.Background(), "octocat/hello-world", "octo-org")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryListCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/collaborators").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/collaborators.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListCollaborators(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/collaborators/hubot").
+		BodyString(`{"permission":"pull"}`).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.AddCollaborator(context.Background(), "octocat/hello-world", "hubot", scm.PermissionRead)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/collaborators/hubot").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.RemoveCollaborator(context.Background(), "octocat/hello-world", "hubot")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -35,9 +36,9 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 	in := &reviewInput{
 		Body:     input.Body,
 		Path:     input.Path,
-		Position: input.Line,
 		CommitID: input.Sha,
 	}
+	in.reviewPosition = convertReviewPosition(input)
 	out := new(review)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertReview(out), res, err
@@ -48,12 +49,122 @@ func (s *reviewService) Delete(ctx context.Context, repo string, number, id int)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*prReview{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPullRequestReviewList(out), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews", repo, number)
+	in := &prReviewInput{
+		CommitID: input.Sha,
+		Body:     input.Body,
+		Event:    convertFromReviewState(input.State),
+	}
+	for _, v := range input.Comments {
+		in.Comments = append(in.Comments, &prReviewCommentInput{
+			Path:           v.Path,
+			Body:           v.Body,
+			reviewPosition: convertReviewPosition(v),
+		})
+	}
+	out := new(prReview)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertPullRequestReview(out), res, err
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/reviews/%d/dismissals", repo, number, id)
+	in := &prReviewDismissInput{Message: message}
+	out := new(prReview)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertPullRequestReview(out), res, err
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	// review threads and their resolution state are only
+	// available from the graphql api, which uses cursor
+	// based pagination. The cursor of the next page is
+	// returned in Page.NextURL, and is passed back in
+	// ListOptions.URL to resume from it.
+	owner, name := scm.Split(repo)
+	vars := map[string]interface{}{
+		"owner":  owner,
+		"name":   name,
+		"number": number,
+		"first":  convertThreadPageSize(opts.Size),
+	}
+	if opts.URL != "" {
+		vars["after"] = opts.URL
+	}
+	out := new(reviewThreadsData)
+	res, err := s.client.graphql(ctx, reviewThreadsQuery, vars, out)
+	if err != nil {
+		return nil, res, err
+	}
+	threads := out.Repository.PullRequest.ReviewThreads
+	if res != nil && threads.PageInfo.HasNextPage {
+		res.Page.NextURL = threads.PageInfo.EndCursor
+	}
+	return convertReviewThreadList(threads.Nodes), res, nil
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	vars := map[string]interface{}{
+		"id":   thread,
+		"body": input.Body,
+	}
+	out := new(reviewThreadReplyData)
+	res, err := s.client.graphql(ctx, reviewThreadReplyMutation, vars, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertReviewThreadComment(out.AddPullRequestReviewThreadReply.Comment), res, nil
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	query := "mutation($id: ID!) { resolveReviewThread(input: {threadId: $id}) { clientMutationId } }"
+	return s.client.graphql(ctx, query, map[string]interface{}{"id": thread}, nil)
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	query := "mutation($id: ID!) { unresolveReviewThread(input: {threadId: $id}) { clientMutationId } }"
+	return s.client.graphql(ctx, query, map[string]interface{}{"id": thread}, nil)
+}
+
+const reviewThreadCommentFields = `databaseId body path url createdAt updatedAt commit { oid } author { login avatarUrl }`
+
+const reviewThreadsQuery = `query($owner: String!, $name: String!, $number: Int!, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviewThreads(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id isResolved path line startLine diffSide
+          comments(first: 100) { nodes { ` + reviewThreadCommentFields + ` } }
+        }
+      }
+    }
+  }
+}`
+
+const reviewThreadReplyMutation = `mutation($id: ID!, $body: String!) {
+  addPullRequestReviewThreadReply(input: {pullRequestReviewThreadId: $id, body: $body}) {
+    comment { ` + reviewThreadCommentFields + ` }
+  }
+}`
+
 type review struct {
-	ID       int    `json:"id"`
-	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
-	Path     string `json:"path"`
-	User     struct {
+	ID        int    `json:"id"`
+	CommitID  string `json:"commit_id"`
+	Position  int    `json:"position"`
+	Line      int    `json:"line"`
+	StartLine int    `json:"start_line"`
+	Side      string `json:"side"`
+	Path      string `json:"path"`
+	User      struct {
 		ID        int    `json:"id"`
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
@@ -67,7 +178,96 @@ type reviewInput struct {
 	Body     string `json:"body"`
 	Path     string `json:"path"`
 	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
+	reviewPosition
+}
+
+// reviewPosition positions a review comment by file line
+// number, or by diff position if explicitly requested.
+type reviewPosition struct {
+	Position  int    `json:"position,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+}
+
+type prReview struct {
+	ID       int    `json:"id"`
+	Body     string `json:"body"`
+	State    string `json:"state"`
+	CommitID string `json:"commit_id"`
+	HTMLURL  string `json:"html_url"`
+	User     struct {
+		ID        int    `json:"id"`
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"user"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type prReviewInput struct {
+	CommitID string                  `json:"commit_id,omitempty"`
+	Body     string                  `json:"body,omitempty"`
+	Event    string                  `json:"event,omitempty"`
+	Comments []*prReviewCommentInput `json:"comments,omitempty"`
+}
+
+type prReviewCommentInput struct {
+	Path string `json:"path"`
+	Body string `json:"body"`
+	reviewPosition
+}
+
+type prReviewDismissInput struct {
+	Message string `json:"message"`
+}
+
+type reviewThread struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"isResolved"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	StartLine  int    `json:"startLine"`
+	DiffSide   string `json:"diffSide"`
+	Comments   struct {
+		Nodes []*reviewThreadComment `json:"nodes"`
+	} `json:"comments"`
+}
+
+type reviewThreadComment struct {
+	DatabaseID int       `json:"databaseId"`
+	Body       string    `json:"body"`
+	Path       string    `json:"path"`
+	URL        string    `json:"url"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	Commit     struct {
+		Oid string `json:"oid"`
+	} `json:"commit"`
+	Author struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatarUrl"`
+	} `json:"author"`
+}
+
+type reviewThreadsData struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				Nodes []*reviewThread `json:"nodes"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+type reviewThreadReplyData struct {
+	AddPullRequestReviewThreadReply struct {
+		Comment *reviewThreadComment `json:"comment"`
+	} `json:"addPullRequestReviewThreadReply"`
 }
 
 func convertReviewList(from []*review) []*scm.Review {
@@ -78,13 +278,20 @@ func convertReviewList(from []*review) []*scm.Review {
 	return to
 }
 
+// convertReview converts the review comment. The line is
+// the file line number, which is consistent with the start
+// line, or the diff position if the comment is not
+// positioned by line.
 func convertReview(from *review) *scm.Review {
 	return &scm.Review{
-		ID:   from.ID,
-		Body: from.Body,
-		Path: from.Path,
-		Line: from.Position,
-		Sha:  from.CommitID,
+		ID:        from.ID,
+		Body:      from.Body,
+		Path:      from.Path,
+		Line:      from.Line,
+		StartLine: from.StartLine,
+		Side:      convertReviewSide(from.Side),
+		Position:  from.Position,
+		Sha:       from.CommitID,
 		Author: scm.User{
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
@@ -93,3 +300,152 @@ func convertReview(from *review) *scm.Review {
 		Updated: from.UpdatedAt,
 	}
 }
+
+func convertReviewThreadList(from []*reviewThread) []*scm.ReviewThread {
+	to := []*scm.ReviewThread{}
+	for _, v := range from {
+		to = append(to, convertReviewThread(v))
+	}
+	return to
+}
+
+func convertReviewThread(from *reviewThread) *scm.ReviewThread {
+	to := &scm.ReviewThread{
+		ID:        from.ID,
+		Path:      from.Path,
+		Line:      from.Line,
+		StartLine: from.StartLine,
+		Side:      convertReviewSide(from.DiffSide),
+		Resolved:  from.IsResolved,
+		Comments:  []*scm.Review{},
+	}
+	for _, v := range from.Comments.Nodes {
+		comment := convertReviewThreadComment(v)
+		comment.Line = to.Line
+		comment.StartLine = to.StartLine
+		comment.Side = to.Side
+		to.Comments = append(to.Comments, comment)
+	}
+	return to
+}
+
+func convertReviewThreadComment(from *reviewThreadComment) *scm.Review {
+	return &scm.Review{
+		ID:   from.DatabaseID,
+		Body: from.Body,
+		Path: from.Path,
+		Sha:  from.Commit.Oid,
+		Link: from.URL,
+		Author: scm.User{
+			Login:  from.Author.Login,
+			Avatar: from.Author.AvatarURL,
+		},
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+// convertReviewPosition returns the position of the review
+// comment. The diff position is used unless a side or start
+// line is given, in which case the comment is positioned by
+// file line number.
+func convertReviewPosition(from *scm.ReviewInput) reviewPosition {
+	if from.Position != 0 {
+		return reviewPosition{Position: from.Position}
+	}
+	to := reviewPosition{
+		Line: from.Line,
+		Side: convertFromReviewSide(from.Side),
+	}
+	if from.StartLine != 0 && from.StartLine != from.Line {
+		to.StartLine = from.StartLine
+		to.StartSide = to.Side
+	}
+	return to
+}
+
+// convertThreadPageSize returns the number of review threads
+// to request, which the graphql api limits to 100.
+func convertThreadPageSize(size int) int {
+	if size <= 0 || size > 100 {
+		return 100
+	}
+	return size
+}
+
+func convertReviewSide(from string) scm.ReviewSide {
+	switch strings.ToUpper(from) {
+	case "LEFT":
+		return scm.ReviewSideLeft
+	case "RIGHT":
+		return scm.ReviewSideRight
+	default:
+		return scm.ReviewSideUnknown
+	}
+}
+
+func convertFromReviewSide(from scm.ReviewSide) string {
+	if from == scm.ReviewSideLeft {
+		return "LEFT"
+	}
+	return "RIGHT"
+}
+
+func convertPullRequestReviewList(from []*prReview) []*scm.PullRequestReview {
+	to := []*scm.PullRequestReview{}
+	for _, v := range from {
+		to = append(to, convertPullRequestReview(v))
+	}
+	return to
+}
+
+func convertPullRequestReview(from *prReview) *scm.PullRequestReview {
+	return &scm.PullRequestReview{
+		ID:    from.ID,
+		Body:  from.Body,
+		State: convertReviewState(from.State),
+		Sha:   from.CommitID,
+		Link:  from.HTMLURL,
+		Author: scm.User{
+			Login:  from.User.Login,
+			Avatar: from.User.AvatarURL,
+		},
+		Submitted: from.SubmittedAt,
+	}
+}
+
+// convertReviewState converts the review state. The rest
+// api returns the state in upper case, and the webhook
+// payload returns the state in lower case.
+func convertReviewState(from string) scm.ReviewState {
+	switch strings.ToLower(from) {
+	case "pending":
+		return scm.ReviewStatePending
+	case "commented":
+		return scm.ReviewStateCommented
+	case "approved":
+		return scm.ReviewStateApproved
+	case "changes_requested":
+		return scm.ReviewStateChangesRequested
+	case "dismissed":
+		return scm.ReviewStateDismissed
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// convertFromReviewState returns the review event for the
+// review state. The review is left pending if the event is
+// empty.
+func convertFromReviewState(from scm.ReviewState) string {
+	switch from {
+	case scm.ReviewStateApproved:
+		return "APPROVE"
+	case scm.ReviewStateChangesRequested:
+		return "REQUEST_CHANGES"
+	case scm.ReviewStateCommented:
+		return "COMMENT"
+	default:
+		return ""
+	}
+}
@@ -84,6 +84,13 @@ func TestReviewCreate(t *testing.T) {
 
 	gock.New("https://api.github.com").
 		Post("/repos/octocat/hello-world/pulls/1/comments").
+		JSON(map[string]interface{}{
+			"body":      "what?",
+			"path":      "file1.txt",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"line":      1,
+			"side":      "RIGHT",
+		}).
 		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -116,6 +123,41 @@ func TestReviewCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestReviewCreate_MultiLine(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/comments").
+		JSON(map[string]interface{}{
+			"body":       "what?",
+			"path":       "file1.txt",
+			"commit_id":  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"line":       12,
+			"side":       "RIGHT",
+			"start_line": 10,
+			"start_side": "RIGHT",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_comment.json")
+
+	input := &scm.ReviewInput{
+		Body:      "what?",
+		Line:      12,
+		StartLine: 10,
+		Side:      scm.ReviewSideRight,
+		Path:      "file1.txt",
+		Sha:       "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+	}
+
+	client := NewDefault()
+	_, _, err := client.Reviews.Create(context.Background(), "octocat/hello-world", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestReviewDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -135,3 +177,239 @@ func TestReviewDelete(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReviewListReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/12/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/pr_reviews.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListReviews(context.Background(), "octocat/hello-world", 12, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviews.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/12/reviews").
+		JSON(map[string]interface{}{
+			"commit_id": "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+			"body":      "Here is the body for the review.",
+			"event":     "APPROVE",
+			"comments": []map[string]interface{}{
+				{"path": "file.md", "position": 6, "body": "Nit: typo"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review.json")
+
+	input := &scm.PullRequestReviewInput{
+		Body:  "Here is the body for the review.",
+		State: scm.ReviewStateApproved,
+		Sha:   "ecdd80bb57125d7ba9641ffaa4d7d2c19d3f3091",
+		Comments: []*scm.ReviewInput{
+			{Path: "file.md", Position: 6, Body: "Nit: typo"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Submit(context.Background(), "octocat/hello-world", 12, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequestReview)
+	raw, _ := ioutil.ReadFile("testdata/pr_review.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/12/reviews/80/dismissals").
+		JSON(map[string]string{"message": "Outdated"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review.json")
+
+	client := NewDefault()
+	_, res, err := client.Reviews.Dismiss(context.Background(), "octocat/hello-world", 12, 80, "Outdated")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("reviewThreads").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review_threads.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListThreads(context.Background(), "octocat/hello-world", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/pr_review_threads.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewListThreads_Page(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"after":"Y3Vyc29yOjE="`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data":{"repository":{"pullRequest":{"reviewThreads":{"pageInfo":{"hasNextPage":true,"endCursor":"Y3Vyc29yOjI="},"nodes":[{"id":"PRRT_2"}]}}}}}`)
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListThreads(context.Background(), "octocat/hello-world", 1, scm.ListOptions{URL: "Y3Vyc29yOjE=", Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 || got[0].ID != "PRRT_2" {
+		t.Errorf("Want the second page of review threads")
+	}
+	if want, got := "Y3Vyc29yOjI=", res.Page.NextURL; want != got {
+		t.Errorf("Want next cursor %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestReviewReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("addPullRequestReviewThreadReply").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review_thread_reply.json")
+
+	input := &scm.CommentInput{
+		Body: "Thanks!",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Reply(context.Background(), "octocat/hello-world", 1, "PRRT_kwDOAJy2Ks5Lp0Vq", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_review_thread_reply.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("resolveReviewThread").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data":{"resolveReviewThread":{"clientMutationId":null}}}`)
+
+	client := NewDefault()
+	_, err := client.Reviews.Resolve(context.Background(), "octocat/hello-world", 1, "PRRT_kwDOAJy2Ks5Lp0Vq")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review thread resolved")
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString("unresolveReviewThread").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"data":{"unresolveReviewThread":{"clientMutationId":null}}}`)
+
+	client := NewDefault()
+	_, err := client.Reviews.Unresolve(context.Background(), "octocat/hello-world", 1, "PRRT_kwDOAJy2Ks5Lp0Vq")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect review thread unresolved")
+	}
+}
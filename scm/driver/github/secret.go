@@ -0,0 +1,212 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+// secretService implements the SecretService. Secret values
+// are encrypted with the public key of the repository or
+// organization using a libsodium sealed box before they are
+// sent to GitHub.
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets?%s", repo, encodeListOptions(opts))
+	return s.list(ctx, path)
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets", repo)
+	return s.put(ctx, path, input, new(secretInput))
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateRepoSecret(ctx, repo, input)
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/actions/secrets/%s", repo, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/actions/secrets?%s", org, encodeListOptions(opts))
+	return s.list(ctx, path)
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/actions/secrets", org)
+	in, res, err := s.visibility(ctx, path, input)
+	if err != nil {
+		return res, err
+	}
+	return s.put(ctx, path, input, in)
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateOrgSecret(ctx, org, input)
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/actions/secrets/%s", org, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments/%s/secrets?%s", repo, env, encodeListOptions(opts))
+	return s.list(ctx, path)
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments/%s/secrets", repo, env)
+	return s.put(ctx, path, input, new(secretInput))
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateEnvSecret(ctx, repo, env, input)
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/environments/%s/secrets/%s", repo, env, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function lists the secrets at the given path.
+func (s *secretService) list(ctx context.Context, path string) ([]*scm.Secret, *scm.Response, error) {
+	out := new(secretList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSecretList(out.Secrets), res, err
+}
+
+// helper function encrypts the secret value with the public
+// key found at the given path, and creates or updates the
+// secret. GitHub creates and updates secrets with the same
+// request.
+func (s *secretService) put(ctx context.Context, path string, input *scm.SecretInput, in *secretInput) (*scm.Response, error) {
+	key := new(publicKey)
+	res, err := s.client.do(ctx, "GET", path+"/public-key", nil, key)
+	if err != nil {
+		return res, err
+	}
+	encrypted, err := encryptSecret(key.Key, input.Value)
+	if err != nil {
+		return nil, err
+	}
+	in.EncryptedValue = encrypted
+	in.KeyID = key.KeyID
+	return s.client.do(ctx, "PUT", path+"/"+input.Name, in, nil)
+}
+
+// helper function returns the visibility of the organization
+// secret, which GitHub requires with every update. The
+// visibility of an existing secret is unchanged unless it is
+// provided, and a new secret is visible to all repositories
+// in the organization.
+func (s *secretService) visibility(ctx context.Context, path string, input *scm.SecretInput) (*secretInput, *scm.Response, error) {
+	in := new(secretInput)
+	if input.Visibility != scm.SecretVisibilityUndefined {
+		in.Visibility = input.Visibility.String()
+		if input.Visibility == scm.SecretVisibilitySelected {
+			ids := []int64{}
+			for _, v := range input.SelectedRepos {
+				id, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return nil, nil, fmt.Errorf("github: invalid repository id %q", v)
+				}
+				ids = append(ids, id)
+			}
+			// an empty list is sent to remove access from all
+			// repositories.
+			in.SelectedRepositoryIDs = &ids
+		}
+		return in, nil, nil
+	}
+	out := new(secret)
+	res, err := s.client.do(ctx, "GET", path+"/"+input.Name, nil, out)
+	switch {
+	case errors.Is(err, scm.ErrNotFound):
+		in.Visibility = scm.SecretVisibilityAll.String()
+	case err != nil:
+		return nil, res, err
+	default:
+		in.Visibility = out.Visibility
+	}
+	return in, res, nil
+}
+
+type secretList struct {
+	TotalCount int       `json:"total_count"`
+	Secrets    []*secret `json:"secrets"`
+}
+
+type secret struct {
+	Name       string    `json:"name"`
+	Visibility string    `json:"visibility"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type secretInput struct {
+	EncryptedValue        string   `json:"encrypted_value"`
+	KeyID                 string   `json:"key_id"`
+	Visibility            string   `json:"visibility,omitempty"`
+	SelectedRepositoryIDs *[]int64 `json:"selected_repository_ids,omitempty"`
+}
+
+type publicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+// helper function encrypts the value using a sealed box with
+// the base64-encoded public key, and returns the base64-encoded
+// result.
+func encryptSecret(key, value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	if len(raw) != 32 {
+		return "", errors.New("github: invalid secret public key")
+	}
+	recipient := new([32]byte)
+	copy(recipient[:], raw)
+	sealed, err := box.SealAnonymous(nil, []byte(value), recipient, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func convertSecretList(from []*secret) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from {
+		to = append(to, convertSecret(v))
+	}
+	return to
+}
+
+func convertSecret(from *secret) *scm.Secret {
+	return &scm.Secret{
+		Name:    from.Name,
+		Masked:  true,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
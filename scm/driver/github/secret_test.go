@@ -0,0 +1,321 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/secrets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/secrets.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListRepoSecrets(context.Background(), "octocat/hello-world", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/actions/secrets/public-key").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{
+			"key_id": "012345678912345678",
+			"key":    base64.StdEncoding.EncodeToString(public[:]),
+		})
+
+	// the encrypted value is random, so the request body is
+	// decrypted with the private key to verify the value.
+	matcher := gock.NewMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if req.Method != "PUT" {
+			return false, nil
+		}
+		in := new(secretInput)
+		if err := json.NewDecoder(req.Body).Decode(in); err != nil {
+			return false, err
+		}
+		sealed, err := base64.StdEncoding.DecodeString(in.EncryptedValue)
+		if err != nil {
+			return false, err
+		}
+		value, ok := box.OpenAnonymous(nil, sealed, public, private)
+		return ok && string(value) == "ghp_secret" && in.KeyID == "012345678912345678", nil
+	})
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/actions/secrets/GH_TOKEN").
+		SetMatcher(matcher).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	input := &scm.SecretInput{
+		Name:  "GH_TOKEN",
+		Value: "ghp_secret",
+	}
+
+	client := NewDefault()
+	res, err := client.Secrets.CreateRepoSecret(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSecretDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/actions/secrets/GH_TOKEN").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Secrets.DeleteRepoSecret(context.Background(), "octocat/hello-world", "GH_TOKEN")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestOrgSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/orgs/octo-org/actions/secrets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/secrets.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListOrgSecrets(context.Background(), "octo-org", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestOrgSecretUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *scm.SecretInput
+		existing int
+		want     secretInput
+		body     string
+	}{
+		{
+			name:     "unchanged",
+			input:    &scm.SecretInput{Name: "GH_TOKEN", Value: "ghp_secret"},
+			existing: 200,
+			want:     secretInput{Visibility: "selected"},
+		},
+		{
+			name:     "new",
+			input:    &scm.SecretInput{Name: "GH_TOKEN", Value: "ghp_secret"},
+			existing: 404,
+			want:     secretInput{Visibility: "all"},
+		},
+		{
+			name: "selected",
+			input: &scm.SecretInput{
+				Name:          "GH_TOKEN",
+				Value:         "ghp_secret",
+				Visibility:    scm.SecretVisibilitySelected,
+				SelectedRepos: []string{"1296269"},
+			},
+			want: secretInput{Visibility: "selected", SelectedRepositoryIDs: &[]int64{1296269}},
+		},
+		{
+			name: "selected none",
+			input: &scm.SecretInput{
+				Name:       "GH_TOKEN",
+				Value:      "ghp_secret",
+				Visibility: scm.SecretVisibilitySelected,
+			},
+			want: secretInput{Visibility: "selected", SelectedRepositoryIDs: &[]int64{}},
+			body: `"selected_repository_ids":[]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer gock.Off()
+
+			public, _, err := box.GenerateKey(rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.existing != 0 {
+				gock.New("https://api.github.com").
+					Get("/orgs/octo-org/actions/secrets/GH_TOKEN").
+					Reply(test.existing).
+					Type("application/json").
+					SetHeaders(mockHeaders).
+					BodyString(`{"name":"GH_TOKEN","visibility":"selected"}`)
+			}
+
+			gock.New("https://api.github.com").
+				Get("/orgs/octo-org/actions/secrets/public-key").
+				Reply(200).
+				Type("application/json").
+				SetHeaders(mockHeaders).
+				JSON(map[string]string{
+					"key_id": "012345678912345678",
+					"key":    base64.StdEncoding.EncodeToString(public[:]),
+				})
+
+			matcher := gock.NewMatcher()
+			matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+				if req.Method != "PUT" {
+					return false, nil
+				}
+				raw, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					return false, err
+				}
+				if !strings.Contains(string(raw), test.body) {
+					return false, nil
+				}
+				in := new(secretInput)
+				if err := json.Unmarshal(raw, in); err != nil {
+					return false, err
+				}
+				return in.Visibility == test.want.Visibility &&
+					cmp.Equal(in.SelectedRepositoryIDs, test.want.SelectedRepositoryIDs), nil
+			})
+
+			gock.New("https://api.github.com").
+				Put("/orgs/octo-org/actions/secrets/GH_TOKEN").
+				SetMatcher(matcher).
+				Reply(204).
+				SetHeaders(mockHeaders)
+
+			client := NewDefault()
+			_, err = client.Secrets.UpdateOrgSecret(context.Background(), "octo-org", test.input)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !gock.IsDone() {
+				t.Errorf("Pending mocks")
+			}
+		})
+	}
+}
+
+func TestEnvSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/environments/production/secrets").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/secrets.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListEnvSecrets(context.Background(), "octocat/hello-world", "production", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestEncryptSecret(t *testing.T) {
+	_, err := encryptSecret(base64.StdEncoding.EncodeToString([]byte("too short")), "value")
+	if err == nil {
+		t.Errorf("Expect error for invalid public key")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport/oauth2"
+)
+
+const (
+	// jwtLifetime is the lifetime of the App JWT. GitHub
+	// rejects tokens that expire more than ten minutes in
+	// the future.
+	jwtLifetime = 9 * time.Minute
+
+	// jwtSkew is subtracted from the issued at time to
+	// allow for clock drift between the client and server.
+	jwtSkew = time.Minute
+
+	// tokenExpiryDelta determines how much earlier a token
+	// should be considered expired than its actual expiration
+	// time, to avoid using a token that expires in-flight.
+	tokenExpiryDelta = 5 * time.Minute
+)
+
+// errInvalidKey is returned when the private key cannot be
+// decoded as an RSA private key.
+var errInvalidKey = errors.New("github: invalid RSA private key")
+
+// ParsePrivateKey parses a PEM encoded PKCS1 or PKCS8 RSA
+// private key, as downloaded from the GitHub App settings.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errInvalidKey
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errInvalidKey
+	}
+	return rsaKey, nil
+}
+
+// AppJWTSource is a TokenSource that returns RS256 signed
+// JSON Web Tokens used to authenticate as a GitHub App. The
+// token is cached and re-signed shortly before it expires.
+type AppJWTSource struct {
+	AppID      int64
+	PrivateKey *rsa.PrivateKey
+
+	mu    sync.Mutex
+	token *scm.Token
+}
+
+// NewAppJWTSource returns a TokenSource that returns JSON
+// Web Tokens for the GitHub App.
+func NewAppJWTSource(appID int64, key *rsa.PrivateKey) *AppJWTSource {
+	return &AppJWTSource{AppID: appID, PrivateKey: key}
+}
+
+// Token returns a signed App JWT.
+func (s *AppJWTSource) Token(context.Context) (*scm.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && time.Now().Add(time.Minute).Before(s.token.Expires) {
+		return s.token, nil
+	}
+	now := time.Now()
+	expires := now.Add(jwtLifetime)
+	signed, err := signJWT(s.PrivateKey, jwtClaims{
+		Issuer:    strconv.FormatInt(s.AppID, 10),
+		IssuedAt:  now.Add(-jwtSkew).Unix(),
+		ExpiresAt: expires.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.token = &scm.Token{
+		Token:   signed,
+		Expires: expires,
+	}
+	return s.token, nil
+}
+
+// AppTokenSource is a TokenSource that returns GitHub App
+// installation tokens. It authenticates as the App using a
+// signed JWT, exchanges the JWT for an installation token,
+// and caches the installation token until shortly before
+// it expires. The AppTokenSource is safe for concurrent use
+// by multiple goroutines, and can be used with the
+// oauth2.Transport to authenticate requests.
+type AppTokenSource struct {
+	// InstallationID is the App installation identifier.
+	// If zero, the installation is found using Repo.
+	InstallationID int64
+
+	// Repo is the repository name, in owner/name format,
+	// used to find the App installation if InstallationID
+	// is zero.
+	Repo string
+
+	// Options optionally restrict the repositories and
+	// permissions granted to the installation token.
+	Options *scm.InstallationTokenOptions
+
+	client *scm.Client
+
+	mu    sync.Mutex
+	token *scm.Token
+}
+
+// NewAppTokenSource returns a TokenSource that returns
+// installation tokens for the GitHub App. The uri is the
+// GitHub API address.
+func NewAppTokenSource(uri string, appID int64, key *rsa.PrivateKey) (*AppTokenSource, error) {
+	client, err := New(uri)
+	if err != nil {
+		return nil, err
+	}
+	client.Client = &http.Client{
+		Transport: &oauth2.Transport{
+			Source: NewAppJWTSource(appID, key),
+			Base:   client.Client.Transport,
+		},
+	}
+	return &AppTokenSource{client: client}, nil
+}
+
+// Token returns an installation token. If the cached token
+// is missing or about to expire, a new installation token
+// is created.
+func (s *AppTokenSource) Token(ctx context.Context) (*scm.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && time.Now().Add(tokenExpiryDelta).Before(s.token.Expires) {
+		return s.token, nil
+	}
+	if s.InstallationID == 0 {
+		if err := s.findInstallation(ctx); err != nil {
+			return nil, err
+		}
+	}
+	out, _, err := s.client.Apps.CreateInstallationToken(ctx, s.InstallationID, s.Options)
+	if err != nil {
+		return nil, err
+	}
+	s.token = &scm.Token{
+		Token:   out.Token,
+		Expires: out.ExpiresAt,
+	}
+	return s.token, nil
+}
+
+// findInstallation finds and caches the App installation
+// identifier for the repository.
+func (s *AppTokenSource) findInstallation(ctx context.Context) error {
+	if s.Repo == "" {
+		return errors.New("github: installation id or repository required")
+	}
+	owner, name := scm.Split(s.Repo)
+	out, _, err := s.client.Apps.FindRepositoryInstallation(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	s.InstallationID = out.ID
+	return nil
+}
+
+// jwtClaims is the set of registered claims included in
+// the App JWT.
+type jwtClaims struct {
+	Issuer    string `json:"iss"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// signJWT returns the RS256 signed JSON Web Token.
+func signJWT(key *rsa.PrivateKey, claims jwtClaims) (string, error) {
+	if key == nil {
+		return "", errInvalidKey
+	}
+	header, _ := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(payload)
+	sum := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
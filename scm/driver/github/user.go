@@ -59,6 +59,14 @@ type email struct {
 	Visibility string `json:"visibility"`
 }
 
+func convertUserList(from []*user) []*scm.User {
+	to := []*scm.User{}
+	for _, v := range from {
+		to = append(to, convertUser(v))
+	}
+	return to
+}
+
 func convertUser(from *user) *scm.User {
 	if from == nil {
 		return nil
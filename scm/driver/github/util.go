@@ -115,6 +115,29 @@ func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
 	return params.Encode()
 }
 
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Sha != "" {
+		params.Set("sha", opts.Sha)
+	}
+	if opts.Ref != "" {
+		params.Set("ref", opts.Ref)
+	}
+	if opts.Task != "" {
+		params.Set("task", opts.Task)
+	}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	return params.Encode()
+}
+
 func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -130,3 +153,17 @@ func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeCheckListOptions(opts scm.CheckListOptions) string {
+	params := url.Values{}
+	if opts.Name != "" {
+		params.Set("check_name", opts.Name)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
@@ -42,8 +42,12 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePullRequestHook(data)
 	case "deployment":
 		hook, err = s.parseDeploymentHook(data)
+	case "deployment_status":
+		hook, err = s.parseDeploymentStatusHook(data)
 	case "ping":
 		hook, err = s.parsePingHook(data)
+	case "pull_request_review":
+		hook, err = s.parsePullRequestReviewHook(data)
 	// case "pull_request_review_comment":
 	// case "issues":
 	case "issue_comment":
@@ -52,6 +56,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseReleaseHook(data)
 	case "workflow_run":
 		hook, err = s.parsePipelineHook(data)
+	case "check_run":
+		hook, err = s.parseCheckRunHook(data)
+	case "check_suite":
+		hook, err = s.parseCheckSuiteHook(data)
 	default:
 		return nil, scm.ErrUnknownEvent
 	}
@@ -128,6 +136,16 @@ func (s *webhookService) parseDeploymentHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parseDeploymentStatusHook(data []byte) (scm.Webhook, error) {
+	src := new(deploymentStatusHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertDeploymentStatusHook(src)
+	return dst, nil
+}
+
 func (s *webhookService) parseIssueCommentHook(data []byte) (scm.Webhook, error) {
 	src := new(issueCommentHook)
 	err := json.Unmarshal(data, src)
@@ -186,6 +204,26 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return dst, nil
 }
 
+func (s *webhookService) parsePullRequestReviewHook(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestReviewHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestReviewHook(src)
+	switch src.Action {
+	case "submitted":
+		dst.Action = scm.ActionSubmit
+	case "edited":
+		dst.Action = scm.ActionEdit
+	case "dismissed":
+		dst.Action = scm.ActionDismiss
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
 func (s *webhookService) parsePipelineHook(data []byte) (scm.Webhook, error) {
 	src := new(pipelineHook)
 	err := json.Unmarshal(data, src)
@@ -270,6 +308,28 @@ func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parseCheckRunHook(data []byte) (scm.Webhook, error) {
+	src := new(checkRunHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertCheckRunHook(src)
+	dst.Action = convertCheckAction(src.Action)
+	return dst, nil
+}
+
+func (s *webhookService) parseCheckSuiteHook(data []byte) (scm.Webhook, error) {
+	src := new(checkSuiteHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertCheckSuiteHook(src)
+	dst.Action = convertCheckAction(src.Action)
+	return dst, nil
+}
+
 func (s *webhookService) parsePingHook(data []byte) (scm.Webhook, error) {
 	src := new(pingHook)
 	err := json.Unmarshal(data, src)
@@ -372,6 +432,15 @@ type (
 		Sender      user       `json:"sender"`
 	}
 
+	// github pull_request_review webhook payload
+	pullRequestReviewHook struct {
+		Action      string     `json:"action"`
+		Review      prReview   `json:"review"`
+		PullRequest pr         `json:"pull_request"`
+		Repository  repository `json:"repository"`
+		Sender      user       `json:"sender"`
+	}
+
 	// github deployment webhook payload
 	deploymentHook struct {
 		Deployment struct {
@@ -390,10 +459,38 @@ type (
 		Sender     user       `json:"sender"`
 	}
 
+	// github deployment_status webhook payload
+	deploymentStatusHook struct {
+		Action           string       `json:"action"`
+		Deployment       deployment   `json:"deployment"`
+		DeploymentStatus deployStatus `json:"deployment_status"`
+		Repository       repository   `json:"repository"`
+		Sender           user         `json:"sender"`
+	}
+
 	pingHook struct {
 		Repository repository `json:"repository"`
 		Sender     user       `json:"sender"`
 	}
+
+	// github check_run webhook payload
+	checkRunHook struct {
+		Action          string   `json:"action"`
+		CheckRun        checkRun `json:"check_run"`
+		RequestedAction *struct {
+			Identifier string `json:"identifier"`
+		} `json:"requested_action"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github check_suite webhook payload
+	checkSuiteHook struct {
+		Action     string     `json:"action"`
+		CheckSuite checkSuite `json:"check_suite"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
 	// github issue_comment webhook payload
 	issueCommentHook struct {
 		Action       string     `json:"action"`
@@ -636,6 +733,15 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+func convertPullRequestReviewHook(src *pullRequestReviewHook) *scm.PullRequestReviewHook {
+	return &scm.PullRequestReviewHook{
+		Repo:        *convertRepository(&src.Repository),
+		PullRequest: *convertPullRequest(&src.PullRequest),
+		Review:      *convertPullRequestReview(&src.Review),
+		Sender:      *convertUser(&src.Sender),
+	}
+}
+
 func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	dst := &scm.DeployHook{
 		Number: src.Deployment.ID,
@@ -677,6 +783,15 @@ func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	}
 	return dst
 }
+func convertDeploymentStatusHook(src *deploymentStatusHook) *scm.DeployStatusHook {
+	return &scm.DeployStatusHook{
+		Deployment: *convertDeployment(&src.Deployment),
+		Status:     *convertDeployStatus(&src.DeploymentStatus),
+		Repo:       *convertRepository(&src.Repository),
+		Sender:     *convertUser(&src.Sender),
+	}
+}
+
 func convertPingHook(src *pingHook) *scm.PingHook {
 	return &scm.PingHook{
 		Repo: scm.Repository{
@@ -749,6 +864,43 @@ func convertReleaseHook(src *releaseHook) *scm.ReleaseHook {
 	return dst
 }
 
+func convertCheckRunHook(src *checkRunHook) *scm.CheckRunHook {
+	dst := &scm.CheckRunHook{
+		Repo:     *convertRepository(&src.Repository),
+		CheckRun: *convertCheckRun(&src.CheckRun),
+		Sender:   *convertUser(&src.Sender),
+	}
+	if src.RequestedAction != nil {
+		dst.RequestedAction = src.RequestedAction.Identifier
+	}
+	return dst
+}
+
+func convertCheckSuiteHook(src *checkSuiteHook) *scm.CheckSuiteHook {
+	return &scm.CheckSuiteHook{
+		Repo:       *convertRepository(&src.Repository),
+		CheckSuite: *convertCheckSuite(&src.CheckSuite),
+		Sender:     *convertUser(&src.Sender),
+	}
+}
+
+func convertCheckAction(src string) scm.Action {
+	switch src {
+	case "created":
+		return scm.ActionCreate
+	case "completed":
+		return scm.ActionComplete
+	case "requested":
+		return scm.ActionRequest
+	case "rerequested":
+		return scm.ActionRerequest
+	case "requested_action":
+		return scm.ActionRequestAction
+	default:
+		return scm.ActionUnknown
+	}
+}
+
 // regexp help determine if the named git object is a tag.
 // this is not meant to be 100% accurate.
 var tagRE = regexp.MustCompile("^v?(\\d+).(.+)")
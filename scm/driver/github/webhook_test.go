@@ -190,6 +190,12 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/deployment_commit.json.golden",
 			obj:    new(scm.DeployHook),
 		},
+		{
+			event:  "deployment_status",
+			before: "testdata/webhooks/deployment_status.json",
+			after:  "testdata/webhooks/deployment_status.json.golden",
+			obj:    new(scm.DeployStatusHook),
+		},
 		//
 		// release
 		//
@@ -241,6 +247,30 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pipeline_hook.json.golden",
 			obj:    new(scm.PipelineHook),
 		},
+		//
+		// pull request reviews
+		//
+		{
+			event:  "pull_request_review",
+			before: "testdata/webhooks/pr_review_submitted.json",
+			after:  "testdata/webhooks/pr_review_submitted.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
+		//
+		// checks
+		//
+		{
+			event:  "check_run",
+			before: "testdata/webhooks/check_run_requested_action.json",
+			after:  "testdata/webhooks/check_run_requested_action.json.golden",
+			obj:    new(scm.CheckRunHook),
+		},
+		{
+			event:  "check_suite",
+			before: "testdata/webhooks/check_suite_requested.json",
+			after:  "testdata/webhooks/check_suite_requested.json.golden",
+			obj:    new(scm.CheckSuiteHook),
+		},
 	}
 
 	for _, test := range tests {
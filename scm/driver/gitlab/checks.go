@@ -0,0 +1,116 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+// checksService implements the ChecksService using GitLab
+// commit statuses. GitLab does not support check suites or
+// annotations, and a commit status is updated by creating
+// a new status with the same name for the commit.
+type checksService struct {
+	client *wrapper
+}
+
+type commitStatus struct {
+	ID         int         `json:"id"`
+	Sha        string      `json:"sha"`
+	Ref        string      `json:"ref"`
+	Status     string      `json:"status"`
+	Name       string      `json:"name"`
+	Desc       null.String `json:"description"`
+	Target     null.String `json:"target_url"`
+	StartedAt  null.Time   `json:"started_at"`
+	FinishedAt null.Time   `json:"finished_at"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/statuses?%s", encode(repo), ref, encodeCheckListOptions(opts))
+	out := []*commitStatus{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitStatusList(out), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("state", convertFromState(input.State))
+	params.Set("name", input.Name)
+	if input.DetailsURL != "" {
+		params.Set("target_url", input.DetailsURL)
+	}
+	if output := input.Output; output != nil {
+		if output.Title != "" {
+			params.Set("description", output.Title)
+		} else if output.Summary != "" {
+			params.Set("description", output.Summary)
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/statuses/%s?%s", encode(repo), input.HeadSHA, params.Encode())
+	out := new(commitStatus)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertCommitStatus(out), res, err
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	return s.CreateCheckRun(ctx, repo, input)
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func convertCommitStatusList(from []*commitStatus) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from {
+		to = append(to, convertCommitStatus(v))
+	}
+	return to
+}
+
+func convertCommitStatus(from *commitStatus) *scm.CheckRun {
+	to := &scm.CheckRun{
+		ID:         strconv.Itoa(from.ID),
+		Name:       from.Name,
+		HeadSHA:    from.Sha,
+		DetailsURL: from.Target.String,
+		State:      convertState(from.Status),
+		Started:    from.StartedAt.ValueOrZero(),
+		Completed:  from.FinishedAt.ValueOrZero(),
+	}
+	if from.Desc.String != "" {
+		to.Output = &scm.CheckRunOutput{
+			Title: from.Desc.String,
+		}
+	}
+	return to
+}
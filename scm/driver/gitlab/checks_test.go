@@ -0,0 +1,109 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/statuses/18f3e63d05582537db6d183d9d557be09e1f90c8").
+		MatchParam("name", "default").
+		MatchParam("state", "pending").
+		MatchParam("description", "the dude abides").
+		MatchParam("target_url", "https://gitlab.example.com/thedude/gitlab-ce/builds/91").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/status.json")
+
+	in := &scm.CheckRunInput{
+		Name:       "default",
+		HeadSHA:    "18f3e63d05582537db6d183d9d557be09e1f90c8",
+		DetailsURL: "https://gitlab.example.com/thedude/gitlab-ce/builds/91",
+		State:      scm.StatePending,
+		Output: &scm.CheckRunOutput{
+			Title: "the dude abides",
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Checks.CreateCheckRun(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/check_run.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/18f3e63d05582537db6d183d9d557be09e1f90c8/statuses").
+		MatchParam("name", "default").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/statuses.json")
+
+	client := NewDefault()
+	opts := scm.CheckListOptions{Name: "default", Page: 1, Size: 30}
+	got, res, err := client.Checks.ListCheckRuns(context.Background(), "diaspora/diaspora", "18f3e63d05582537db6d183d9d557be09e1f90c8", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/check_runs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestChecksCheckSuites(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Checks.FindCheckSuite(context.Background(), "diaspora/diaspora", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+	_, _, err = client.Checks.ListAnnotations(context.Background(), "diaspora/diaspora", "1", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
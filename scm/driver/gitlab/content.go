@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 
@@ -40,6 +41,30 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/files/%s/raw?ref=%s", encode(repo), encodePath(path), urlEncodedRef)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	// the file metadata is returned in the response headers.
+	size, _ := strconv.ParseInt(res.Header.Get("X-Gitlab-Size"), 10, 64)
+	return &scm.RawContent{
+		Path:   path,
+		Size:   size,
+		Sha:    res.Header.Get("X-Gitlab-Last-Commit-Id"),
+		BlobID: res.Header.Get("X-Gitlab-Blob-Id"),
+		Body:   body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/archive.%s?sha=%s", encode(repo), format, urlEncodedRef)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/files/%s", encode(repo), encodePath(path))
 	in := &createUpdateContent{
@@ -84,6 +109,55 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// gitlab does not reject the commit actions if the
+	// branch was updated, so the branch head is compared to
+	// the parent commit before committing.
+	if input.Parent != "" {
+		ref, res, err := s.client.Git.FindBranch(ctx, repo, input.Branch)
+		if err != nil {
+			return nil, res, err
+		}
+		if ref.Sha != input.Parent {
+			return nil, res, scm.ErrConflict
+		}
+	}
+	in := &commitInput{
+		Branch:        input.Branch,
+		CommitMessage: input.Message,
+		AuthorName:    input.Author.Name,
+		AuthorEmail:   input.Author.Email,
+		Actions:       []*commitAction{},
+	}
+	for _, v := range input.Actions {
+		action := &commitAction{
+			Action:       v.Action.String(),
+			FilePath:     v.Path,
+			PreviousPath: v.PreviousPath,
+		}
+		switch v.Action {
+		case scm.FileActionCreate, scm.FileActionUpdate:
+			action.Content = base64.StdEncoding.EncodeToString(v.Data)
+			action.Encoding = "base64"
+		case scm.FileActionMove:
+			// the content of a moved file is preserved if
+			// the content is not provided.
+			if v.Data != nil {
+				action.Content = base64.StdEncoding.EncodeToString(v.Data)
+				action.Encoding = "base64"
+			}
+		case scm.FileActionDelete:
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+		in.Actions = append(in.Actions, action)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repo))
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, opts scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	endpoint := fmt.Sprintf("api/v4/projects/%s/repository/tree?path=%s&ref=%s&%s", encode(repo), url.QueryEscape(path), ref, encodeListOptions(opts))
 	out := []*object{}
@@ -235,3 +235,145 @@ func TestContentList(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/commits").
+		BodyString(`{"branch":"master","commit_message":"Sanitize for network graph","author_name":"randx","author_email":"dmitriy.zaporozhets@gmail.com","actions":[{"action":"update","file_path":"README.md","content":"IyBEaWFzcG9yYQ==","encoding":"base64"},{"action":"delete","file_path":"docs/index.md"},{"action":"move","file_path":"docs/new.md","previous_path":"docs/old.md"}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	input := &scm.CommitInput{
+		Branch:  "master",
+		Message: "Sanitize for network graph",
+		Parent:  "7b5c3cc8be40ee161ae89a06bba6229da1032a0c",
+		Author: scm.Signature{
+			Name:  "randx",
+			Email: "dmitriy.zaporozhets@gmail.com",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionUpdate, Path: "README.md", Data: []byte("# Diaspora")},
+			{Action: scm.FileActionDelete, Path: "docs/index.md"},
+			{Action: scm.FileActionMove, Path: "docs/new.md", PreviousPath: "docs/old.md"},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Contents.Commit(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestContentCommit_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	input := &scm.CommitInput{
+		Branch:  "master",
+		Message: "Sanitize for network graph",
+		Parent:  "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionDelete, Path: "docs/index.md"},
+		},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Contents.Commit(context.Background(), "diaspora/diaspora", input)
+	if err != scm.ErrConflict {
+		t.Errorf("Expect ErrConflict, got %v", err)
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/files/app/models/key.rb/raw").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("X-Gitlab-Size", "12").
+		SetHeader("X-Gitlab-Blob-Id", "79f7bbd25901e8334750839545a9bd021f0e4c83").
+		SetHeader("X-Gitlab-Last-Commit-Id", "570e7b2abdd848b95f2f578043fc23bd6f6fd24d").
+		BodyString("hello world\n")
+
+	client := NewDefault()
+	got, _, err := client.Contents.FindRaw(context.Background(), "diaspora/diaspora", "app/models/key.rb", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path:   "app/models/key.rb",
+		Size:   12,
+		Sha:    "570e7b2abdd848b95f2f578043fc23bd6f6fd24d",
+		BlobID: "79f7bbd25901e8334750839545a9bd021f0e4c83",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/archive.zip").
+		MatchParam("sha", "master").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client := NewDefault()
+	rc, _, err := client.Contents.Archive(context.Background(), "diaspora/diaspora", "master", scm.ArchiveFormatZip)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
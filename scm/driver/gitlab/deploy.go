@@ -0,0 +1,192 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// deploymentService implements the DeploymentService. A
+// gitlab deployment has a single status, which is updated
+// in place.
+type deploymentService struct {
+	client *wrapper
+}
+
+func (s *deploymentService) Find(ctx context.Context, repo string, id int64) (*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) List(ctx context.Context, repo string, opts scm.DeploymentListOptions) ([]*scm.Deployment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments?%s", encode(repo), encodeDeploymentListOptions(opts))
+	out := []*deployment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDeploymentList(out), res, err
+}
+
+func (s *deploymentService) Create(ctx context.Context, repo string, input *scm.DeploymentInput) (*scm.Deployment, *scm.Response, error) {
+	// gitlab requires the commit sha in addition to the
+	// reference, which is resolved before creating the
+	// deployment.
+	commit, res, err := s.client.Git.FindCommit(ctx, repo, input.Ref)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/deployments", encode(repo))
+	in := &deploymentInput{
+		Environment: input.Environment,
+		Sha:         commit.Sha,
+		Ref:         scm.TrimRef(input.Ref),
+		Tag:         scm.IsTag(input.Ref),
+		Status:      "running",
+	}
+	out := new(deployment)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertDeployment(out), res, err
+}
+
+func (s *deploymentService) ListStatus(ctx context.Context, repo string, id int64, opts scm.ListOptions) ([]*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	out := new(deployment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return []*scm.DeployStatus{convertDeployStatus(out)}, res, nil
+}
+
+func (s *deploymentService) CreateStatus(ctx context.Context, repo string, id int64, input *scm.DeployStatusInput) (*scm.DeployStatus, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deployments/%d", encode(repo), id)
+	in := &deployStatusInput{
+		Status: convertFromDeployState(input.State),
+	}
+	out := new(deployment)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertDeployStatus(out), res, err
+}
+
+func (s *deploymentService) ListEnvironments(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Environment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/environments?%s", encode(repo), encodeListOptions(opts))
+	out := []*deployEnvironment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertEnvironmentList(out), res, err
+}
+
+type deployment struct {
+	ID          int64             `json:"id"`
+	IID         int64             `json:"iid"`
+	Ref         string            `json:"ref"`
+	Sha         string            `json:"sha"`
+	Status      string            `json:"status"`
+	User        user              `json:"user"`
+	Environment deployEnvironment `json:"environment"`
+	Deployable  struct {
+		WebURL string `json:"web_url"`
+	} `json:"deployable"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type deploymentInput struct {
+	Environment string `json:"environment"`
+	Sha         string `json:"sha"`
+	Ref         string `json:"ref"`
+	Tag         bool   `json:"tag"`
+	Status      string `json:"status"`
+}
+
+type deployStatusInput struct {
+	Status string `json:"status"`
+}
+
+type deployEnvironment struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	ExternalURL string    `json:"external_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func convertDeploymentList(from []*deployment) []*scm.Deployment {
+	to := []*scm.Deployment{}
+	for _, v := range from {
+		to = append(to, convertDeployment(v))
+	}
+	return to
+}
+
+func convertDeployment(from *deployment) *scm.Deployment {
+	return &scm.Deployment{
+		ID:          from.ID,
+		Sha:         from.Sha,
+		Ref:         from.Ref,
+		Environment: from.Environment.Name,
+		Author:      *convertUser(&from.User),
+		Created:     from.CreatedAt,
+		Updated:     from.UpdatedAt,
+	}
+}
+
+func convertDeployStatus(from *deployment) *scm.DeployStatus {
+	return &scm.DeployStatus{
+		Number:         from.ID,
+		State:          convertDeployState(from.Status),
+		Target:         from.Deployable.WebURL,
+		Environment:    from.Environment.Name,
+		EnvironmentURL: from.Environment.ExternalURL,
+		Author:         *convertUser(&from.User),
+		Created:        from.CreatedAt,
+		Updated:        from.UpdatedAt,
+	}
+}
+
+func convertEnvironmentList(from []*deployEnvironment) []*scm.Environment {
+	to := []*scm.Environment{}
+	for _, v := range from {
+		to = append(to, convertEnvironment(v))
+	}
+	return to
+}
+
+func convertEnvironment(from *deployEnvironment) *scm.Environment {
+	return &scm.Environment{
+		ID:      from.ID,
+		Name:    from.Name,
+		Link:    from.ExternalURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+// convertDeployState converts the deployment status. A
+// deployment is created, or blocked waiting on approval,
+// before it is running.
+func convertDeployState(from string) scm.State {
+	switch from {
+	case "created", "blocked":
+		return scm.StatePending
+	default:
+		return convertState(from)
+	}
+}
+
+// convertFromDeployState converts the deployment status. A
+// deployment cannot be moved back to created, so pending is
+// reported as running.
+func convertFromDeployState(from scm.State) string {
+	switch from {
+	case scm.StatePending:
+		return "running"
+	default:
+		return convertFromState(from)
+	}
+}
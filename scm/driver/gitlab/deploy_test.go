@@ -0,0 +1,231 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeploymentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Find(context.Background(), "diaspora/diaspora", 42)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchParam("environment", "production").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/deploys.json")
+
+	client := NewDefault()
+	opts := scm.DeploymentListOptions{Environment: "production", Page: 1, Size: 30}
+	got, res, err := client.Deployments.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Deployment{}
+	raw, _ := ioutil.ReadFile("testdata/deploys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/deployments").
+		JSON(map[string]interface{}{
+			"environment": "production",
+			"sha":         "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+			"ref":         "master",
+			"tag":         false,
+			"status":      "running",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	input := &scm.DeploymentInput{
+		Ref:         "refs/heads/master",
+		Environment: "production",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.Create(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Deployment)
+	raw, _ := ioutil.ReadFile("testdata/deploy.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentListStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/deployments/42").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListStatus(context.Background(), "diaspora/diaspora", 42, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.DeployStatus{}
+	raw, _ := ioutil.ReadFile("testdata/deploy_statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentCreateStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/deployments/42").
+		JSON(map[string]string{"status": "success"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/deploy.json")
+
+	input := &scm.DeployStatusInput{
+		State: scm.StateSuccess,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Deployments.CreateStatus(context.Background(), "diaspora/diaspora", 42, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.DeployStatus)
+	raw, _ := ioutil.ReadFile("testdata/deploy_status.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestDeploymentListEnvironments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/environments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/environments.json")
+
+	client := NewDefault()
+	got, res, err := client.Deployments.ListEnvironments(context.Background(), "diaspora/diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Environment{}
+	raw, _ := ioutil.ReadFile("testdata/environments.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
@@ -27,6 +27,11 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches/%s", encode(repo), name)
 	out := new(branch)
@@ -46,6 +51,23 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// gitlab creates an annotated tag if a message is
+	// provided. The tagger is the authenticated user.
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags", encode(repo))
+	in := &createTag{
+		TagName: params.Name,
+		Ref:     params.Sha,
+		Message: params.Message,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), name)
 	out := new(branch)
@@ -102,11 +124,21 @@ type branch struct {
 	}
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type createBranch struct {
 	Branch string `json:"branch"`
 	Ref    string `json:"ref"`
 }
 
+type createTag struct {
+	TagName string `json:"tag_name"`
+	Ref     string `json:"ref"`
+	Message string `json:"message,omitempty"`
+}
+
 type commit struct {
 	ID             string    `json:"id"`
 	Title          string    `json:"title"`
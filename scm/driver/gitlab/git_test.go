@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -107,6 +108,145 @@ func TestGitCreateBranch(t *testing.T) {
 		t.Errorf("Unexpected Results")
 	}
 }
+
+func TestGitDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/branches/feature").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteBranch(context.Background(), "diaspora/diaspora", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/tags").
+		BodyString(`{"tag_name":"v1.0.0","ref":"2695effb5807a22ff3d138d593fd856244e155e7","message":"Release 1.0.0"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "2695effb5807a22ff3d138d593fd856244e155e7",
+		Message: "Release 1.0.0",
+	}
+
+	client := NewDefault()
+	res, err := client.Git.CreateTag(context.Background(), "diaspora/diaspora", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/repository/tags/v1.0.0").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Git.DeleteTag(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Git.UpdateRef(context.Background(), "diaspora/diaspora", &scm.ReferenceUpdateInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGitCreateBranch_Validation(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/branches").
+		Reply(400).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":{"branch":["is invalid"],"ref":["is missing"]}}`)
+
+	params := &scm.ReferenceInput{
+		Name: "yo..o",
+	}
+
+	client := NewDefault()
+	_, err := client.Git.CreateBranch(context.Background(), "diaspora/diaspora", params)
+	if !errors.Is(err, scm.ErrValidation) {
+		t.Errorf("Expect validation error, got %v", err)
+		return
+	}
+	if got, want := err.Error(), "branch is invalid, ref is missing"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+
+	apiErr := new(scm.APIError)
+	if !errors.As(err, &apiErr) {
+		t.Errorf("Expect API error")
+		return
+	}
+	if got, want := apiErr.RequestID, "0d511a76-2ade-4c34-af0d-d17e84adb255"; got != want {
+		t.Errorf("Want request id %q, got %q", want, got)
+	}
+	if got, want := len(apiErr.Errors), 2; got != want {
+		t.Errorf("Want %d field errors, got %d", want, got)
+	}
+}
+
+func TestGitCreateBranch_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/branches").
+		Reply(409).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Branch already exists"}`)
+
+	params := &scm.ReferenceInput{
+		Name: "yooo",
+		Sha:  "0efb1bed7c6a4871cb4ddb862ecc2111e11f31ee",
+	}
+
+	client := NewDefault()
+	_, err := client.Git.CreateBranch(context.Background(), "diaspora/diaspora", params)
+	if !errors.Is(err, scm.ErrConflict) {
+		t.Errorf("Expect conflict error, got %v", err)
+	}
+	if errors.Is(err, scm.ErrNotFound) {
+		t.Errorf("Expect conflict error does not match not found")
+	}
+}
+
 func TestGitFindTag(t *testing.T) {
 	defer gock.Off()
 
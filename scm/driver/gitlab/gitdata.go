@@ -0,0 +1,186 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// gitDataService implements the GitDataService. GitLab
+// does not support creating git objects, so commits are
+// created from the tree entries using the commit actions
+// endpoint.
+type gitDataService struct {
+	client *wrapper
+}
+
+func (s *gitDataService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/blobs/%s", encode(repo), sha)
+	out := new(blob)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBlob(out), res, nil
+}
+
+func (s *gitDataService) CreateBlob(ctx context.Context, repo string, input *scm.BlobInput) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	// the tree entries are paginated, and all pages are
+	// requested to return the complete tree.
+	to := &scm.Tree{
+		Sha:     sha,
+		Entries: []*scm.TreeEntry{},
+	}
+	params := url.Values{}
+	params.Set("ref", sha)
+	params.Set("per_page", "100")
+	if recursive {
+		params.Set("recursive", "true")
+	}
+	for page := 1; ; {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("api/v4/projects/%s/repository/tree?%s", encode(repo), params.Encode())
+		out := []*treeEntry{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			to.Entries = append(to.Entries, convertTreeEntry(v))
+		}
+		if res.Page.Next == 0 {
+			return to, res, nil
+		}
+		page = res.Page.Next
+	}
+}
+
+func (s *gitDataService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitDataService) CreateCommit(ctx context.Context, repo string, input *scm.GitCommitInput) (*scm.Commit, *scm.Response, error) {
+	if input.Tree != "" || input.Branch == "" || len(input.Parents) > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+
+	// gitlab requires the commit action to match the state
+	// of the file, which is found in the tree of the parent
+	// commit.
+	ref := input.Branch
+	if len(input.Parents) != 0 {
+		ref = input.Parents[0]
+	}
+	current, res, err := s.FindTree(ctx, repo, ref, true)
+	if err != nil {
+		return nil, res, err
+	}
+	exists := map[string]bool{}
+	for _, v := range current.Entries {
+		exists[v.Path] = true
+	}
+
+	in := &commitInput{
+		Branch:        input.Branch,
+		CommitMessage: input.Message,
+		AuthorName:    input.Author.Name,
+		AuthorEmail:   input.Author.Email,
+		Actions:       []*commitAction{},
+	}
+	for _, v := range input.Entries {
+		if v.Sha != "" && !v.Delete {
+			// entries that reference an existing blob
+			// cannot be written using commit actions.
+			return nil, nil, scm.ErrNotSupported
+		}
+		action := &commitAction{
+			FilePath: v.Path,
+		}
+		switch {
+		case v.Delete:
+			action.Action = "delete"
+		case exists[v.Path]:
+			action.Action = "update"
+		default:
+			action.Action = "create"
+		}
+		if !v.Delete {
+			action.Content = base64.StdEncoding.EncodeToString(v.Content)
+			action.Encoding = "base64"
+		}
+		in.Actions = append(in.Actions, action)
+	}
+
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repo))
+	out := new(commit)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
+func (s *gitDataService) UpdateRef(ctx context.Context, repo string, input *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return s.client.Git.UpdateRef(ctx, repo, input)
+}
+
+type blob struct {
+	Sha      string `json:"sha"`
+	Size     int64  `json:"size"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type treeEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type commitInput struct {
+	Branch        string          `json:"branch"`
+	CommitMessage string          `json:"commit_message"`
+	AuthorName    string          `json:"author_name,omitempty"`
+	AuthorEmail   string          `json:"author_email,omitempty"`
+	Actions       []*commitAction `json:"actions"`
+}
+
+type commitAction struct {
+	Action       string `json:"action"`
+	FilePath     string `json:"file_path"`
+	PreviousPath string `json:"previous_path,omitempty"`
+	Content      string `json:"content,omitempty"`
+	Encoding     string `json:"encoding,omitempty"`
+}
+
+func convertBlob(from *blob) *scm.Blob {
+	to := &scm.Blob{
+		Sha:     from.Sha,
+		Size:    from.Size,
+		Content: []byte(from.Content),
+	}
+	if from.Encoding == "base64" {
+		to.Content, _ = base64.StdEncoding.DecodeString(from.Content)
+	}
+	return to
+}
+
+func convertTreeEntry(from *treeEntry) *scm.TreeEntry {
+	return &scm.TreeEntry{
+		Path: from.Path,
+		Mode: from.Mode,
+		Type: from.Type,
+		Sha:  from.ID,
+	}
+}
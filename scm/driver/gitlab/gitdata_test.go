@@ -0,0 +1,147 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestGitDataFindBlob(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blob.json")
+
+	client := NewDefault()
+	got, res, err := client.GitData.FindBlob(context.Background(), "diaspora/diaspora", "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Blob)
+	raw, _ := ioutil.ReadFile("testdata/blob.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitDataFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		MatchParam("recursive", "true").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "6104942438c14ec7bd21c6cd5bd995272b3faff6").
+		MatchParam("recursive", "true").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree_page2.json")
+
+	client := NewDefault()
+	got, _, err := client.GitData.FindTree(context.Background(), "diaspora/diaspora", "6104942438c14ec7bd21c6cd5bd995272b3faff6", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestGitDataCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba").
+		MatchParam("recursive", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/commits").
+		BodyString(`{"branch":"master","commit_message":"Sanitize for network graph","author_name":"randx","author_email":"dmitriy.zaporozhets@gmail.com","actions":[{"action":"update","file_path":"README.md","content":"IyBEaWFzcG9yYQ==","encoding":"base64"},{"action":"create","file_path":"docs/hello.md","content":"aGVsbG8=","encoding":"base64"},{"action":"delete","file_path":"docs/index.md"}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	input := &scm.GitCommitInput{
+		Message: "Sanitize for network graph",
+		Parents: []string{"ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"},
+		Branch:  "master",
+		Author: scm.Signature{
+			Name:  "randx",
+			Email: "dmitriy.zaporozhets@gmail.com",
+		},
+		Entries: []*scm.TreeEntryInput{
+			{Path: "README.md", Content: []byte("# Diaspora")},
+			{Path: "docs/hello.md", Content: []byte("hello")},
+			{Path: "docs/index.md", Delete: true},
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.GitData.CreateCommit(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -9,8 +9,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/url"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -31,15 +33,23 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverGitlab
 	client.Linker = &linker{base.String()}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.Milestones = &milestoneService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -68,7 +78,17 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
-	if in != nil {
+	switch content := in.(type) {
+	case nil:
+	case *assetInput:
+		// the asset is streamed to the body of the request
+		// to avoid buffering large files in memory.
+		req.Body = content.Body
+		req.ContentLength = content.Size
+		req.Header = map[string][]string{
+			"Content-Type": {content.ContentType},
+		}
+	default:
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in)
 		req.Header = map[string][]string{
@@ -87,26 +107,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// parse the gitlab request id.
 	res.ID = res.Header.Get("X-Request-Id")
 
-	// parse the gitlab rate limit details.
-	res.Rate.Limit, _ = strconv.Atoi(
-		res.Header.Get("RateLimit-Limit"),
-	)
-	res.Rate.Remaining, _ = strconv.Atoi(
-		res.Header.Get("RateLimit-Remaining"),
-	)
-	res.Rate.Reset, _ = strconv.ParseInt(
-		res.Header.Get("RateLimit-Reset"), 10, 64,
-	)
-
 	// snapshot the request rate limit
 	c.Client.SetRate(res.Rate)
 
 	// if an error is encountered, unmarshal and return the
 	// error response.
 	if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -118,11 +125,135 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the gitlab request id.
+	res.ID = res.Header.Get("X-Request-Id")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
+// download streams the file at the given url. The request is
+// authenticated only if the url has the same origin as the
+// API, so that credentials are never sent to a third-party
+// host. Other urls are requested without credentials using
+// the default http client.
+func (c *wrapper) download(ctx context.Context, rawurl string) (io.ReadCloser, *scm.Response, error) {
+	uri, err := c.BaseURL.Parse(rawurl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uri.Scheme == c.BaseURL.Scheme && uri.Host == c.BaseURL.Host {
+		return c.stream(ctx, "GET", rawurl)
+	}
+	client := &scm.Client{BaseURL: uri}
+	res, err := client.Do(ctx, &scm.Request{Method: "GET", Path: uri.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
 // Error represents a GitLab error.
 type Error struct {
-	Message string `json:"message"`
+	Message string              `json:"message"`
+	Code    string              `json:"error"`
+	Fields  map[string][]string `json:"-"`
 }
 
 func (e *Error) Error() string {
 	return e.Message
 }
+
+// UnmarshalJSON decodes the GitLab error. The message is
+// either a string, or an object that maps field names to
+// validation errors.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var from struct {
+		Message     json.RawMessage `json:"message"`
+		Code        string          `json:"error"`
+		Description string          `json:"error_description"`
+	}
+	if err := json.Unmarshal(data, &from); err != nil {
+		return err
+	}
+	e.Code = from.Code
+	if len(from.Message) == 0 {
+		e.Message = from.Description
+		return nil
+	}
+	if err := json.Unmarshal(from.Message, &e.Message); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(from.Message, &e.Fields); err != nil {
+		return err
+	}
+	var messages []string
+	for _, field := range sortedKeys(e.Fields) {
+		for _, message := range e.Fields[field] {
+			messages = append(messages, field+" "+message)
+		}
+	}
+	e.Message = strings.Join(messages, ", ")
+	return nil
+}
+
+// newError returns an API error from the gitlab error
+// response.
+func newError(res *scm.Response) error {
+	raw, _ := ioutil.ReadAll(res.Body)
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	to := &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Code:      from.Code,
+		Message:   from.Message,
+		Header:    res.Header,
+		Raw:       raw,
+		Err:       from,
+	}
+	for _, field := range sortedKeys(from.Fields) {
+		for _, message := range from.Fields[field] {
+			to.Errors = append(to.Errors, scm.FieldError{
+				Field:   field,
+				Message: message,
+			})
+		}
+	}
+	return to
+}
+
+// sortedKeys returns the map keys in sorted order.
+func sortedKeys(m map[string][]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -50,12 +52,59 @@ func (s *issueService) Create(ctx context.Context, repo string, input *scm.Issue
 	in := url.Values{}
 	in.Set("title", input.Title)
 	in.Set("description", input.Body)
+	if input.Labels != nil {
+		in.Set("labels", strings.Join(input.Labels, ","))
+	}
+	if input.Milestone != 0 {
+		in.Set("milestone_id", strconv.Itoa(input.Milestone))
+	}
+	if len(input.Assignees) != 0 {
+		ids, res, err := findUserIDs(ctx, s.client, input.Assignees)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, id := range ids {
+			in.Add("assignee_ids[]", strconv.Itoa(id))
+		}
+	}
 	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), in.Encode())
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, nil, out)
 	return convertIssue(out), res, err
 }
 
+func (s *issueService) Update(ctx context.Context, repo string, number int, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
+	in := &issueInput{
+		Title:       input.Title,
+		Description: input.Body,
+		MilestoneID: input.Milestone,
+	}
+	if input.Labels != nil {
+		labels := strings.Join(input.Labels, ",")
+		in.Labels = &labels
+	}
+	if input.Assignees != nil {
+		// the assignees are unassigned when updating the
+		// issue with an empty list of ids.
+		ids, res, err := findUserIDs(ctx, s.client, input.Assignees)
+		if err != nil {
+			return nil, res, err
+		}
+		ids = append([]int{}, ids...)
+		in.AssigneeIDs = &ids
+	}
+	switch input.State {
+	case "closed":
+		in.StateEvent = "close"
+	case "open":
+		in.StateEvent = "reopen"
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertIssue(out), res, err
+}
+
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
 	in := url.Values{}
 	in.Set("body", input.Body)
@@ -65,6 +114,14 @@ func (s *issueService) CreateComment(ctx context.Context, repo string, number in
 	return convertIssueComment(out), res, err
 }
 
+func (s *issueService) UpdateComment(ctx context.Context, repo string, number, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d", encode(repo), number, id)
+	in := &issueCommentInput{Body: input.Body}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertIssueComment(out), res, err
+}
+
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d", encode(repo), number, id)
 	return s.client.do(ctx, "DELETE", path, nil, nil)
@@ -76,6 +133,12 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+func (s *issueService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?discussion_locked=true", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -102,8 +165,23 @@ type issue struct {
 		Username string      `json:"username"`
 		Avatar   null.String `json:"avatar_url"`
 	} `json:"author"`
-	Created time.Time `json:"created_at"`
-	Updated time.Time `json:"updated_at"`
+	Assignees []struct {
+		Name     string      `json:"name"`
+		Username string      `json:"username"`
+		Avatar   null.String `json:"avatar_url"`
+	} `json:"assignees"`
+	Milestone *milestone `json:"milestone"`
+	Created   time.Time  `json:"created_at"`
+	Updated   time.Time  `json:"updated_at"`
+}
+
+type issueInput struct {
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Labels      *string `json:"labels,omitempty"`
+	AssigneeIDs *[]int  `json:"assignee_ids,omitempty"`
+	MilestoneID int     `json:"milestone_id,omitempty"`
+	StateEvent  string  `json:"state_event,omitempty"`
 }
 
 type issueComment struct {
@@ -136,6 +214,14 @@ func convertIssueList(from []*issue) []*scm.Issue {
 // helper function to convert from the gogs issue structure to
 // the common issue structure.
 func convertIssue(from *issue) *scm.Issue {
+	var assignees []scm.User
+	for _, v := range from.Assignees {
+		assignees = append(assignees, scm.User{
+			Name:   v.Name,
+			Login:  v.Username,
+			Avatar: v.Avatar.String,
+		})
+	}
 	return &scm.Issue{
 		Number: from.Number,
 		Title:  from.Title,
@@ -149,8 +235,10 @@ func convertIssue(from *issue) *scm.Issue {
 			Login:  from.Author.Username,
 			Avatar: from.Author.Avatar.String,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
+		Assignees: assignees,
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.Created,
+		Updated:   from.Updated,
 	}
 }
 
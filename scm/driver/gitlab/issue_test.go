@@ -180,6 +180,57 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "lennie").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id":9,"username":"lennie","name":"Dr. Luella Kovacek"}]`)
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		JSON(map[string]interface{}{
+			"labels":       "bug,ui",
+			"assignee_ids": []int{9},
+			"milestone_id": 11,
+			"state_event":  "close",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Labels:    []string{"bug", "ui"},
+		Assignees: []string{"lennie"},
+		Milestone: 11,
+		State:     "closed",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Update(context.Background(), "diaspora/diaspora", 1, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -215,6 +266,41 @@ func TestIssueCreateComment(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueUpdateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1/notes/1").
+		JSON(map[string]string{"body": "lgtm"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_note.json")
+
+	input := &scm.CommentInput{
+		Body: "lgtm",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.UpdateComment(context.Background(), "diaspora/diaspora", 1, 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_note.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCommentDelete(t *testing.T) {
 	defer gock.Off()
 
@@ -256,6 +342,27 @@ func TestIssueClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/issues/1").
+		MatchParam("state_event", "reopen").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Issues.Reopen(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueLock(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,140 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys?%s", encode(repo), encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys", encode(repo))
+	in := &keyInput{
+		Title:   input.Title,
+		Key:     input.Key,
+		CanPush: !input.ReadOnly,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/deploy_keys/%s", encode(repo), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v4/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{Key: input.Key}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "api/v4/user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+type key struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	Key       string    `json:"key"`
+	CanPush   bool      `json:"can_push"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title   string `json:"title"`
+	Key     string `json:"key"`
+	CanPush bool   `json:"can_push,omitempty"`
+}
+
+type gpgKey struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type gpgKeyInput struct {
+	Key string `json:"key"`
+}
+
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:       strconv.Itoa(from.ID),
+		Title:    from.Title,
+		Key:      from.Key,
+		ReadOnly: !from.CanPush,
+		Created:  from.CreatedAt,
+	}
+}
+
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+// gitlab does not return the key id or the email addresses
+// associated with a gpg key.
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	return &scm.GPGKey{
+		ID:      strconv.Itoa(from.ID),
+		Key:     from.Key,
+		Created: from.CreatedAt,
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	out := new(label)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels?%s", encode(repo), encodeListOptions(opts))
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels", encode(repo))
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       convertLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	in := &labelUpdateInput{
+		NewName:     input.Name,
+		Color:       convertLabelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	in := &labelsInput{AddLabels: strings.Join(labels, ",")}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) RemoveIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	in := &labelsInput{RemoveLabels: strings.Join(labels, ",")}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) SetIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	joined := strings.Join(labels, ",")
+	in := &labelsInput{Labels: &joined}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	in := &labelsInput{AddLabels: strings.Join(labels, ",")}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	in := &labelsInput{RemoveLabels: strings.Join(labels, ",")}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	joined := strings.Join(labels, ",")
+	in := &labelsInput{Labels: &joined}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type label struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	TextColor   string `json:"text_color"`
+	Description string `json:"description"`
+}
+
+type labelInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+}
+
+type labelUpdateInput struct {
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// labelsInput updates the issue or merge request labels.
+// The labels are comma separated, and setting the labels
+// to an empty string removes all labels.
+type labelsInput struct {
+	Labels       *string `json:"labels,omitempty"`
+	AddLabels    string  `json:"add_labels,omitempty"`
+	RemoveLabels string  `json:"remove_labels,omitempty"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Color:       from.Color,
+		Description: from.Description,
+	}
+}
+
+// convertLabelColor returns the label color with the leading
+// hash required by gitlab.
+func convertLabelColor(from string) string {
+	if from == "" || strings.HasPrefix(from, "#") {
+		return from
+	}
+	return "#" + from
+}
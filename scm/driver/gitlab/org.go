@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -71,12 +72,147 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+// ListTeams returns the subgroups of the group. GitLab has
+// no teams, and subgroups are used to grant a set of users
+// access to projects.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/subgroups?%s", encode(name), encodeListOptions(opts))
+	out := []*group{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, team string) (*scm.Team, *scm.Response, error) {
+	out, res, err := s.findGroup(ctx, scm.Join(name, team))
+	if err != nil {
+		return nil, res, err
+	}
+	return convertTeam(out), res, nil
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	parent, res, err := s.findGroup(ctx, name)
+	if err != nil {
+		return nil, res, err
+	}
+	// gitlab requires the subgroup path, which is derived
+	// from the team name.
+	in := &groupInput{
+		Name:        input.Name,
+		Path:        strings.ToLower(strings.Join(strings.Fields(input.Name), "-")),
+		Description: input.Description,
+		ParentID:    parent.ID,
+	}
+	out := new(group)
+	res, err = s.client.do(ctx, "POST", "api/v4/groups", in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, team string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(scm.Join(name, team)))
+	in := &groupInput{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	out := new(group)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, team string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(scm.Join(name, team)))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/members?%s", encode(scm.Join(name, team)), encodeListOptions(opts))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertUserList(out), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	ids, res, err := findUserIDs(ctx, s.client, []string{username})
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members", encode(scm.Join(name, team)))
+	in := &memberInput{
+		UserID:      ids[0],
+		AccessLevel: convertFromPermission(scm.PermissionWrite),
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	ids, res, err := findUserIDs(ctx, s.client, []string{username})
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members/%d", encode(scm.Join(name, team)), ids[0])
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// AddTeamRepo shares the project with the subgroup.
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, team, repo string, perm scm.Permission) (*scm.Response, error) {
+	out, res, err := s.findGroup(ctx, scm.Join(name, team))
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/share", encode(repo))
+	in := &shareInput{
+		GroupID:     out.ID,
+		GroupAccess: convertFromPermission(perm),
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, team, repo string) (*scm.Response, error) {
+	out, res, err := s.findGroup(ctx, scm.Join(name, team))
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/share/%d", encode(repo), out.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) findGroup(ctx context.Context, name string) (*group, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s", encode(name))
+	out := new(group)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
 type organization struct {
 	Name   string      `json:"name"`
 	Path   string      `json:"path"`
 	Avatar null.String `json:"avatar_url"`
 }
 
+type group struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Description string `json:"description"`
+}
+
+type groupInput struct {
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Description string `json:"description,omitempty"`
+	ParentID    int    `json:"parent_id,omitempty"`
+}
+
+type memberInput struct {
+	UserID      int `json:"user_id"`
+	AccessLevel int `json:"access_level"`
+}
+
+type shareInput struct {
+	GroupID     int `json:"group_id"`
+	GroupAccess int `json:"group_access"`
+}
+
 func convertOrganizationList(from []*organization) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from {
@@ -92,6 +228,51 @@ func convertOrganization(from *organization) *scm.Organization {
 	}
 }
 
+func convertTeamList(from []*group) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *group) *scm.Team {
+	return &scm.Team{
+		ID:          strconv.Itoa(from.ID),
+		Name:        from.Name,
+		Slug:        from.Path,
+		Description: from.Description,
+	}
+}
+
+// helper function to convert the gitlab access level to
+// the common permission level.
+func convertPermission(from int) scm.Permission {
+	switch {
+	case from >= 40:
+		return scm.PermissionAdmin
+	case from >= 30:
+		return scm.PermissionWrite
+	case from >= 20:
+		return scm.PermissionRead
+	default:
+		return scm.PermissionUndefined
+	}
+}
+
+// helper function to convert the common permission level
+// to the gitlab access level.
+func convertFromPermission(from scm.Permission) int {
+	switch from {
+	case scm.PermissionAdmin:
+		return 40
+	case scm.PermissionWrite:
+		return 30
+	default:
+		return 20
+	}
+}
+
 func convertMembership(from *membership) *scm.Membership {
 	to := new(scm.Membership)
 
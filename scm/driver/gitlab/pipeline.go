@@ -0,0 +1,178 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// GitLab CI/CD pipelines. A project has a single pipeline,
+// and the pipeline name is ignored.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipeline", encode(repo))
+	in := &pipelineInput{
+		Ref:       scm.TrimRef(input.Ref),
+		Variables: []*pipelineVariable{},
+	}
+	// the variables are sorted by key to create a stable
+	// request body.
+	keys := []string{}
+	for k := range input.Inputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Variables = append(in.Variables, &pipelineVariable{
+			Key:   k,
+			Value: input.Inputs[k],
+		})
+	}
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertPipeline(out), res, nil
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines?%s", encode(repo), encodePipelineListOptions(opts))
+	out := []*pipeline{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertPipelineList(out), res, err
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%s", encode(repo), id)
+	out := new(pipeline)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertPipeline(out)
+	run.Jobs = []*scm.PipelineJob{}
+	// the jobs are paginated, and every page is requested.
+	for page := 1; page != 0; page = res.Page.Next {
+		path = fmt.Sprintf("api/v4/projects/%s/pipelines/%s/jobs?page=%d&per_page=100", encode(repo), id, page)
+		jobs := []*pipelineJob{}
+		res, err = s.client.do(ctx, "GET", path, nil, &jobs)
+		if err != nil {
+			return nil, res, err
+		}
+		run.Jobs = append(run.Jobs, convertPipelineJobList(jobs)...)
+	}
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%s/cancel", encode(repo), id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/pipelines/%s/retry", encode(repo), id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/jobs/%s/trace", encode(repo), job)
+	return s.client.stream(ctx, "GET", path)
+}
+
+type pipelineInput struct {
+	Ref       string              `json:"ref"`
+	Variables []*pipelineVariable `json:"variables,omitempty"`
+}
+
+type pipelineVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type pipeline struct {
+	ID        int       `json:"id"`
+	IID       int       `json:"iid"`
+	Ref       string    `json:"ref"`
+	Sha       string    `json:"sha"`
+	Status    string    `json:"status"`
+	Source    string    `json:"source"`
+	WebURL    string    `json:"web_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type pipelineJob struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	Stage      string    `json:"stage"`
+	Status     string    `json:"status"`
+	WebURL     string    `json:"web_url"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+func encodePipelineListOptions(opts scm.PipelineListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("ref", scm.TrimRef(opts.Ref))
+	}
+	return params.Encode()
+}
+
+func convertPipelineList(from []*pipeline) []*scm.PipelineRun {
+	to := []*scm.PipelineRun{}
+	for _, v := range from {
+		to = append(to, convertPipeline(v))
+	}
+	return to
+}
+
+func convertPipeline(from *pipeline) *scm.PipelineRun {
+	return &scm.PipelineRun{
+		ID:      strconv.Itoa(from.ID),
+		Number:  from.IID,
+		Ref:     from.Ref,
+		Sha:     from.Sha,
+		Event:   from.Source,
+		Status:  scm.ConvertExecutionStatus(from.Status),
+		Link:    from.WebURL,
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+}
+
+func convertPipelineJobList(from []*pipelineJob) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, v := range from {
+		to = append(to, &scm.PipelineJob{
+			ID:       strconv.Itoa(v.ID),
+			Name:     v.Name,
+			Status:   scm.ConvertExecutionStatus(v.Status),
+			Link:     v.WebURL,
+			Started:  v.StartedAt,
+			Finished: v.FinishedAt,
+		})
+	}
+	return to
+}
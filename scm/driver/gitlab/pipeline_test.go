@@ -0,0 +1,258 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipeline").
+		BodyString(`{"ref":"main","variables":[{"key":"DEPLOY","value":"true"},{"key":"ENVIRONMENT","value":"staging"}]}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.Trigger(context.Background(), "diaspora/diaspora", &scm.PipelineInput{Ref: "refs/heads/main", Inputs: map[string]string{"ENVIRONMENT": "staging", "DEPLOY": "true"}})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/pipeline_trigger.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines").
+		MatchParam("ref", "main").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipelines.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.ListRuns(context.Background(), "diaspora/diaspora", scm.PipelineListOptions{Ref: "main", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/pipelines.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineTrigger_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipeline").
+		Reply(400).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":{"base":["Reference not found"]}}`)
+
+	client := NewDefault()
+	got, _, err := client.Pipelines.Trigger(context.Background(), "diaspora/diaspora", &scm.PipelineInput{Ref: "refs/heads/missing"})
+	if err == nil {
+		t.Errorf("Expect error when the pipeline is not created")
+	}
+	if got != nil {
+		t.Errorf("Expect nil pipeline run on error, got %v", got)
+	}
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46/jobs").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline_jobs.json")
+
+	client := NewDefault()
+	got, res, err := client.Pipelines.FindRun(context.Background(), "diaspora/diaspora", "46")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindRun_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46/jobs").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://gitlab.com/api/v4/projects/diaspora%2Fdiaspora/pipelines/46/jobs?page=2&per_page=100>; rel="next"`).
+		JSON([]map[string]interface{}{{"id": 1, "name": "lint", "status": "success"}})
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/pipelines/46/jobs").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pipeline_jobs.json")
+
+	client := NewDefault()
+	got, _, err := client.Pipelines.FindRun(context.Background(), "diaspora/diaspora", "46")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/pipeline.json.golden")
+	_ = json.Unmarshal(raw, want)
+	if got, want := len(got.Jobs), len(want.Jobs)+1; got != want {
+		t.Errorf("Want %d jobs, got %d", want, got)
+	}
+	if got, want := got.Jobs[0].Name, "lint"; got != want {
+		t.Errorf("Want first job %q, got %q", want, got)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipelines/46/cancel").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Pipelines.CancelRun(context.Background(), "diaspora/diaspora", "46")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineRetryRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/pipelines/46/retry").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Pipelines.RetryRun(context.Background(), "diaspora/diaspora", "46")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/jobs/7/trace").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		BodyString("Running with gitlab-runner 16.0.0\n")
+
+	client := NewDefault()
+	rc, res, err := client.Pipelines.FindLogs(context.Background(), "diaspora/diaspora", "46", "7")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "Running with gitlab-runner 16.0.0\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -101,12 +102,222 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	if opts.Method != scm.MergeMethodDefault {
+		res, err := s.checkMergeMethod(ctx, repo, opts.Method)
+		if err != nil {
+			return res, err
+		}
+	}
+	in := &mergeInput{
+		SHA:                opts.SHA,
+		RemoveSourceBranch: opts.DeleteSourceBranch,
+	}
+	message := opts.CommitTitle
+	if opts.CommitMessage != "" {
+		if message != "" {
+			message += "\n\n"
+		}
+		message += opts.CommitMessage
+	}
+	if opts.Method == scm.MergeMethodSquash {
+		in.Squash = true
+		in.SquashCommitMessage = message
+	} else {
+		in.MergeCommitMessage = message
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/merge", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// checkMergeMethod returns a MergeMethodError if the merge
+// method is not allowed for the project. GitLab configures
+// the merge method for the project and does not accept the
+// merge method when merging.
+func (s *pullService) checkMergeMethod(ctx context.Context, repo string, method scm.MergeMethod) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	out := new(mergeSettings)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	var allowed bool
+	switch method {
+	case scm.MergeMethodMerge:
+		allowed = out.MergeMethod == "merge"
+	case scm.MergeMethodRebase:
+		allowed = out.MergeMethod == "rebase_merge"
+	case scm.MergeMethodFastForward:
+		allowed = out.MergeMethod == "ff"
+	case scm.MergeMethodSquash:
+		allowed = out.SquashOption != "never"
+	}
+	if !allowed {
+		return res, &scm.MergeMethodError{Method: method}
+	}
+	return res, nil
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?state_event=closed", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
 	return res, err
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?state_event=reopen", encode(repo), number)
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	return res, err
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+	in := &prUpdateInput{
+		Title:        input.Title,
+		Description:  input.Body,
+		TargetBranch: input.Target,
+	}
+	// the draft state is derived from the merge request
+	// title, which is required to change the draft state.
+	if input.Draft != nil {
+		if in.Title == "" {
+			current, res, err := s.Find(ctx, repo, number)
+			if err != nil {
+				return nil, res, err
+			}
+			in.Title = current.Title
+		}
+		in.Title = convertDraftTitle(in.Title, *input.Draft)
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	// gitlab does not support requesting a review from a
+	// group.
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	// the reviewers are replaced when updating the merge
+	// request, so the requested reviewers are added to the
+	// current reviewers.
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	ids, res, err := findUserIDs(ctx, s.client, input.Users)
+	if err != nil {
+		return res, err
+	}
+	in := &prReviewersInput{ReviewerIDs: []int{}}
+	seen := map[int]bool{}
+	for _, v := range current.Reviewers {
+		seen[v.ID] = true
+		in.ReviewerIDs = append(in.ReviewerIDs, v.ID)
+	}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			in.ReviewerIDs = append(in.ReviewerIDs, id)
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	current, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	remove := map[string]bool{}
+	for _, v := range input.Users {
+		remove[v] = true
+	}
+	in := &prReviewersInput{ReviewerIDs: []int{}}
+	for _, v := range current.Reviewers {
+		if !remove[v.Username] {
+			in.ReviewerIDs = append(in.ReviewerIDs, v.ID)
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return pr.Reviewers, res, nil
+}
+
+func (s *pullService) SetAssignees(ctx context.Context, repo string, number int, assignees []string) (*scm.Response, error) {
+	ids, res, err := findUserIDs(ctx, s.client, assignees)
+	if err != nil {
+		return res, err
+	}
+	in := &prAssigneesInput{AssigneeIDs: append([]int{}, ids...)}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *pullService) find(ctx context.Context, repo string, number int) (*pr, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+type prUpdateInput struct {
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+	TargetBranch string `json:"target_branch,omitempty"`
+}
+
+type prReviewersInput struct {
+	ReviewerIDs []int `json:"reviewer_ids"`
+}
+
+type prAssigneesInput struct {
+	AssigneeIDs []int `json:"assignee_ids"`
+}
+
+type mergeInput struct {
+	MergeCommitMessage  string `json:"merge_commit_message,omitempty"`
+	SquashCommitMessage string `json:"squash_commit_message,omitempty"`
+	Squash              bool   `json:"squash,omitempty"`
+	RemoveSourceBranch  bool   `json:"should_remove_source_branch,omitempty"`
+	SHA                 string `json:"sha,omitempty"`
+}
+
+type mergeSettings struct {
+	MergeMethod  string `json:"merge_method"`
+	SquashOption string `json:"squash_option"`
+}
+
+// draftPrefix matches the title prefixes that mark a merge
+// request as a draft.
+var draftPrefix = regexp.MustCompile(`(?i)^\s*(\[draft\]|\(draft\)|draft:|\[wip\]|wip:)\s*`)
+
 type pr struct {
 	Number         int    `json:"iid"`
 	Sha            string `json:"sha"`
@@ -127,6 +338,8 @@ type pr struct {
 	Updated      time.Time `json:"updated_at"`
 	Closed       time.Time
 	Labels       []string `json:"labels"`
+	Assignees    []*user  `json:"assignees"`
+	Reviewers    []*user  `json:"reviewers"`
 	DiffRefs     struct {
 		BaseSha  string `json:"base_sha"`
 		HeadSha  string `json:"head_sha"`
@@ -143,6 +356,7 @@ type change struct {
 	Added   bool   `json:"new_file"`
 	Renamed bool   `json:"renamed_file"`
 	Deleted bool   `json:"deleted_file"`
+	Diff    string `json:"diff"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -160,6 +374,18 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Name: label,
 		})
 	}
+	// the merge request does not include the review state
+	// of the reviewers.
+	var reviewers []scm.Reviewer
+	for _, v := range from.Reviewers {
+		reviewers = append(reviewers, scm.Reviewer{
+			User: *convertUser(v),
+		})
+	}
+	var assignees []scm.User
+	for _, v := range from.Assignees {
+		assignees = append(assignees, *convertUser(v))
+	}
 	return &scm.PullRequest{
 		Number: from.Number,
 		Title:  from.Title,
@@ -177,9 +403,11 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Login:  from.Author.Username,
 			Avatar: from.Author.Avatar,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
-		Labels:  labels,
+		Created:   from.Created,
+		Updated:   from.Updated,
+		Labels:    labels,
+		Reviewers: reviewers,
+		Assignees: assignees,
 		Base: scm.Reference{
 			Name: from.TargetBranch,
 			Sha:  from.DiffRefs.BaseSha,
@@ -211,3 +439,13 @@ func convertChange(from *change) *scm.Change {
 	}
 	return to
 }
+
+// convertDraftTitle adds or removes the draft prefix from
+// the merge request title.
+func convertDraftTitle(title string, draft bool) string {
+	title = draftPrefix.ReplaceAllString(title, "")
+	if draft {
+		return "Draft: " + title
+	}
+	return title
+}
@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -45,6 +46,33 @@ func TestPullFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.ListReviewers(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/merge.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want.Reviewers); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestPullList(t *testing.T) {
 	defer gock.Off()
 
@@ -129,6 +157,67 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id":178504,"merge_method":"merge","squash_option":"default_off"}`)
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/merge").
+		JSON(map[string]interface{}{
+			"squash_commit_message":       "Add new topic\n\nSquashed",
+			"squash":                      true,
+			"should_remove_source_branch": true,
+			"sha":                         "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Add new topic",
+		CommitMessage:      "Squashed",
+		SHA:                "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		DeleteSourceBranch: true,
+	}
+
+	client := NewDefault()
+	res, err := client.PullRequests.MergeWithOptions(context.Background(), "diaspora/diaspora", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullMergeWithOptions_NotAllowed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id":178504,"merge_method":"merge","squash_option":"default_off"}`)
+
+	opts := scm.PullRequestMergeOptions{Method: scm.MergeMethodFastForward}
+
+	client := NewDefault()
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "diaspora/diaspora", 1347, opts)
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -150,6 +239,111 @@ func TestPullClose(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		MatchParam("state_event", "reopen").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.Reopen(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string]string{"target_branch": "master"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	input := scm.PullRequestUpdateInput{
+		Target: "master",
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Update(context.Background(), "diaspora/diaspora", 1347, &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/merge.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullConvertToDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string]string{"title": "Draft: JS fix"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.ConvertToDraft(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect draft title updated")
+	}
+}
+
+func TestConvertDraftTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		draft bool
+		want  string
+	}{
+		{"JS fix", true, "Draft: JS fix"},
+		{"Draft: JS fix", true, "Draft: JS fix"},
+		{"Draft: JS fix", false, "JS fix"},
+		{"[Draft] JS fix", false, "JS fix"},
+		{"(draft) JS fix", false, "JS fix"},
+		{"WIP: JS fix", false, "JS fix"},
+		{"JS fix", false, "JS fix"},
+	}
+	for _, test := range tests {
+		if got := convertDraftTitle(test.title, test.draft); got != test.want {
+			t.Errorf("Want title %q, got %q", test.want, got)
+		}
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -334,3 +528,114 @@ func TestPullListCommits(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "root").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id":1,"username":"root","name":"Administrator"}]`)
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string][]int{"reviewer_ids": {13356, 1}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"root"},
+	}
+
+	client := NewDefault()
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers updated")
+	}
+}
+
+func TestPullRequestReviewers_Teams(t *testing.T) {
+	input := &scm.ReviewerInput{
+		Teams: []string{"core"},
+	}
+	_, err := NewDefault().PullRequests.RequestReviewers(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string][]int{"reviewer_ids": {}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"dblessing"},
+	}
+
+	client := NewDefault()
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewers updated")
+	}
+}
+
+func TestPullSetAssignees(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("username", "root").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`[{"id":1,"username":"root","name":"Administrator"}]`)
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string][]int{"assignee_ids": {1}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.SetAssignees(context.Background(), "diaspora/diaspora", 1347, []string{"root"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect assignees updated")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// gitlab access levels.
+const (
+	accessNone       = 0
+	accessDeveloper  = 30
+	accessMaintainer = 40
+)
+
+// protectionService implements the BranchProtectionService
+// using GitLab protected branches. Branches are protected
+// from deletion and status checks are configured for the
+// project, so these rules are ignored. An update edits the
+// protected branch in a single request, replacing the
+// existing access levels, or protects the branch if it is
+// not already protected.
+type protectionService struct {
+	client *wrapper
+}
+
+type protectedBranch struct {
+	ID                        int            `json:"id"`
+	Name                      string         `json:"name"`
+	PushAccessLevels          []*accessLevel `json:"push_access_levels"`
+	MergeAccessLevels         []*accessLevel `json:"merge_access_levels"`
+	AllowForcePush            bool           `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool           `json:"code_owner_approval_required"`
+}
+
+type protectedBranchInput struct {
+	Name                      string         `json:"name"`
+	PushAccessLevel           int            `json:"push_access_level"`
+	MergeAccessLevel          int            `json:"merge_access_level"`
+	AllowedToPush             []*accessLevel `json:"allowed_to_push,omitempty"`
+	AllowedToMerge            []*accessLevel `json:"allowed_to_merge,omitempty"`
+	AllowForcePush            bool           `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool           `json:"code_owner_approval_required"`
+}
+
+type protectedBranchPatch struct {
+	AllowedToPush             []*accessLevel `json:"allowed_to_push"`
+	AllowedToMerge            []*accessLevel `json:"allowed_to_merge"`
+	AllowForcePush            bool           `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool           `json:"code_owner_approval_required"`
+}
+
+type accessLevel struct {
+	ID          int  `json:"id,omitempty"`
+	AccessLevel *int `json:"access_level,omitempty"`
+	UserID      int  `json:"user_id,omitempty"`
+	GroupID     int  `json:"group_id,omitempty"`
+	Destroy     bool `json:"_destroy,omitempty"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), encodePath(branch))
+	out := new(protectedBranch)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertProtectedBranch(out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), encodePath(branch))
+	current := new(protectedBranch)
+	res, err := s.client.do(ctx, "GET", path, nil, current)
+	if errors.Is(err, scm.ErrNotFound) {
+		return s.create(ctx, repo, branch, input)
+	} else if err != nil {
+		return nil, res, err
+	}
+	in := &protectedBranchPatch{
+		AllowedToPush:  replaceAccessLevels(current.PushAccessLevels, input.Push),
+		AllowedToMerge: replaceAccessLevels(current.MergeAccessLevels, input.Merge),
+		AllowForcePush: input.AllowForcePush,
+	}
+	if v := input.RequiredReviews; v != nil {
+		in.CodeOwnerApprovalRequired = v.RequireCodeOwners
+	}
+	out := new(protectedBranch)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertProtectedBranch(out), res, err
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches/%s", encode(repo), encodePath(branch))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function protects a branch that is not already
+// protected.
+func (s *protectionService) create(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/protected_branches", encode(repo))
+	in := &protectedBranchInput{
+		Name:             branch,
+		PushAccessLevel:  accessDeveloper,
+		MergeAccessLevel: accessDeveloper,
+		AllowForcePush:   input.AllowForcePush,
+	}
+	if v := input.RequiredReviews; v != nil {
+		in.CodeOwnerApprovalRequired = v.RequireCodeOwners
+	}
+	if v := input.Push; v != nil {
+		in.PushAccessLevel = accessMaintainer
+		in.AllowedToPush = convertFromRestrictions(v)
+	}
+	if v := input.Merge; v != nil {
+		in.MergeAccessLevel = accessMaintainer
+		in.AllowedToMerge = convertFromRestrictions(v)
+	}
+	out := new(protectedBranch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertProtectedBranch(out), res, err
+}
+
+func convertProtectedBranch(from *protectedBranch) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:         from.Name,
+		Push:           convertAccessLevels(from.PushAccessLevels),
+		Merge:          convertAccessLevels(from.MergeAccessLevels),
+		AllowForcePush: from.AllowForcePush,
+	}
+	if from.CodeOwnerApprovalRequired {
+		to.RequiredReviews = &scm.RequiredReviews{
+			RequireCodeOwners: true,
+		}
+	}
+	return to
+}
+
+// convertAccessLevels converts the access levels to branch
+// restrictions. The branch is unrestricted if developers
+// are granted access.
+func convertAccessLevels(from []*accessLevel) *scm.BranchRestrictions {
+	to := new(scm.BranchRestrictions)
+	for _, v := range from {
+		switch {
+		case v.UserID != 0:
+			to.Users = append(to.Users, strconv.Itoa(v.UserID))
+		case v.GroupID != 0:
+			to.Teams = append(to.Teams, strconv.Itoa(v.GroupID))
+		case v.AccessLevel != nil && *v.AccessLevel != accessNone && *v.AccessLevel <= accessDeveloper:
+			return nil
+		}
+	}
+	return to
+}
+
+// convertFromRestrictions converts the branch restrictions
+// to access levels. GitLab identifies users and groups by
+// numeric id, and other values are ignored.
+func convertFromRestrictions(from *scm.BranchRestrictions) []*accessLevel {
+	var to []*accessLevel
+	for _, v := range from.Users {
+		if id, err := strconv.Atoi(v); err == nil {
+			to = append(to, &accessLevel{UserID: id})
+		}
+	}
+	for _, v := range from.Teams {
+		if id, err := strconv.Atoi(v); err == nil {
+			to = append(to, &accessLevel{GroupID: id})
+		}
+	}
+	return to
+}
+
+// replaceAccessLevels returns the access levels that remove
+// the existing access levels and grant the access levels
+// for the branch restrictions. Developers are granted access
+// if the branch is unrestricted, otherwise maintainers and
+// the restricted users and groups are granted access.
+func replaceAccessLevels(existing []*accessLevel, from *scm.BranchRestrictions) []*accessLevel {
+	var to []*accessLevel
+	for _, v := range existing {
+		to = append(to, &accessLevel{ID: v.ID, Destroy: true})
+	}
+	level := accessDeveloper
+	if from != nil {
+		level = accessMaintainer
+	}
+	to = append(to, &accessLevel{AccessLevel: &level})
+	if from != nil {
+		to = append(to, convertFromRestrictions(from)...)
+	}
+	return to
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Find(context.Background(), "diaspora/diaspora", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protected_branch.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestProtectionUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	gock.New("https://gitlab.com").
+		Patch("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		JSON(map[string]interface{}{
+			"allowed_to_push": []map[string]interface{}{
+				{"id": 1, "_destroy": true},
+				{"id": 2, "_destroy": true},
+				{"access_level": 40},
+				{"user_id": 1},
+			},
+			"allowed_to_merge": []map[string]interface{}{
+				{"id": 1, "_destroy": true},
+				{"access_level": 30},
+			},
+			"allow_force_push":             false,
+			"code_owner_approval_required": true,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{RequireCodeOwners: true},
+		Push:            &scm.BranchRestrictions{Users: []string{"1", "octocat"}},
+	}
+
+	client := NewDefault()
+	got, res, err := client.BranchProtections.Update(context.Background(), "diaspora/diaspora", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/protected_branch.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestProtectionUpdate_NotProtected(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"404 Not found"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/protected_branches").
+		JSON(map[string]interface{}{
+			"name":                         "master",
+			"push_access_level":            40,
+			"merge_access_level":           30,
+			"allowed_to_push":              []map[string]int{{"user_id": 1}},
+			"allow_force_push":             false,
+			"code_owner_approval_required": true,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/protected_branch.json")
+
+	input := &scm.BranchProtectionInput{
+		RequiredReviews: &scm.RequiredReviews{RequireCodeOwners: true},
+		Push:            &scm.BranchRestrictions{Users: []string{"1", "octocat"}},
+	}
+
+	client := NewDefault()
+	_, _, err := client.BranchProtections.Update(context.Background(), "diaspora/diaspora", "master", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/protected_branches/master").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.BranchProtections.Delete(context.Background(), "diaspora/diaspora", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
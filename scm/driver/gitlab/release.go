@@ -3,6 +3,11 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -20,6 +25,28 @@ type release struct {
 	} `json:"commit"`
 }
 
+type releaseLink struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	DirectAssetURL string `json:"direct_asset_url"`
+	LinkType       string `json:"link_type"`
+}
+
+type releaseLinkInput struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	LinkType string `json:"link_type"`
+}
+
+// assetInput is the streamed body of a generic package
+// upload, which is not encoded as json.
+type assetInput struct {
+	ContentType string
+	Size        int64
+	Body        io.Reader
+}
+
 type releaseInput struct {
 	Title       string `json:"name"`
 	Description string `json:"description"`
@@ -87,6 +114,66 @@ func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag strin
 	return convertRelease(out), res, err
 }
 
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s/assets/links?%s", encode(repo), tag, encodeListOptions(opts))
+	out := []*releaseLink{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseLinkList(out), res, err
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	// gitlab releases cannot store files, so the asset is
+	// uploaded to the generic package registry using the
+	// project name and release tag as the package name and
+	// version, and is linked to the release.
+	packagePath := fmt.Sprintf("api/v4/projects/%s/packages/generic/%s/%s/%s", encode(repo), url.PathEscape(path.Base(repo)), url.PathEscape(tag), url.PathEscape(input.Name))
+	in := &assetInput{
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Body:        input.Body,
+	}
+	if in.ContentType == "" {
+		in.ContentType = "application/octet-stream"
+	}
+	res, err := s.client.do(ctx, "PUT", packagePath, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+
+	linkPath := fmt.Sprintf("api/v4/projects/%s/releases/%s/assets/links", encode(repo), tag)
+	link := &releaseLinkInput{
+		Name:     input.Name,
+		URL:      strings.TrimSuffix(s.client.BaseURL.String(), "/") + "/" + packagePath,
+		LinkType: "package",
+	}
+	out := new(releaseLink)
+	res, err = s.client.do(ctx, "POST", linkPath, link, out)
+	if err != nil {
+		return nil, res, err
+	}
+	asset := convertReleaseLink(out)
+	asset.ContentType = in.ContentType
+	asset.Size = input.Size
+	return asset, res, nil
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s/assets/links/%s", encode(repo), tag, id)
+	out := new(releaseLink)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.download(ctx, out.URL)
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	// the link is removed from the release, and the linked
+	// package file is not deleted.
+	path := fmt.Sprintf("api/v4/projects/%s/releases/%s/assets/links/%s", encode(repo), tag, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func convertReleaseList(from []*release) []*scm.Release {
 	var to []*scm.Release
 	for _, m := range from {
@@ -107,3 +194,23 @@ func convertRelease(from *release) *scm.Release {
 		Prerelease:  false, // not supported by gitlab
 	}
 }
+
+func convertReleaseLinkList(from []*releaseLink) []*scm.ReleaseAsset {
+	to := []*scm.ReleaseAsset{}
+	for _, v := range from {
+		to = append(to, convertReleaseLink(v))
+	}
+	return to
+}
+
+func convertReleaseLink(from *releaseLink) *scm.ReleaseAsset {
+	to := &scm.ReleaseAsset{
+		ID:   strconv.Itoa(from.ID),
+		Name: from.Name,
+		Link: from.DirectAssetURL,
+	}
+	if to.Link == "" {
+		to.Link = from.URL
+	}
+	return to
+}
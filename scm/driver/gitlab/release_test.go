@@ -3,10 +3,14 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
@@ -189,3 +193,200 @@ func TestReleaseDeleteByTag(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReleaseListAssets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/releases/v1.0.1/assets/links").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_links.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.ListAssets(context.Background(), "diaspora/diaspora", "v1.0.1", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReleaseAsset{}
+	raw, _ := ioutil.ReadFile("testdata/release_links.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseUploadAsset(t *testing.T) {
+	defer gock.Off()
+
+	// the asset must be streamed to the package registry
+	// with the content length set.
+	matcher := gock.NewMatcher()
+	matcher.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		if req.Method != "PUT" {
+			return true, nil
+		}
+		if req.ContentLength != 11 {
+			return false, nil
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		return string(body) == "hello world", err
+	})
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/packages/generic/diaspora/v1.0.1/diaspora.tar.gz").
+		MatchHeader("Content-Type", "application/gzip").
+		SetMatcher(matcher).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"201 Created"}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/releases/v1.0.1/assets/links").
+		BodyString(`{"name":"diaspora.tar.gz","url":"https://gitlab.com/api/v4/projects/diaspora%2Fdiaspora/packages/generic/diaspora/v1.0.1/diaspora.tar.gz","link_type":"package"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_link.json")
+
+	input := &scm.ReleaseAssetInput{
+		Name:        "diaspora.tar.gz",
+		ContentType: "application/gzip",
+		Size:        11,
+		Body:        struct{ io.Reader }{strings.NewReader("hello world")},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Releases.UploadAsset(context.Background(), "diaspora/diaspora", "v1.0.1", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReleaseAsset)
+	raw, _ := ioutil.ReadFile("testdata/release_link.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseDownloadAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/releases/v1.0.1/assets/links/2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release_link.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/packages/generic/diaspora/v1.0.1/diaspora.tar.gz").
+		Reply(200).
+		Type("application/octet-stream").
+		SetHeaders(mockHeaders).
+		BodyString("hello world")
+
+	client := NewDefault()
+	rc, res, err := client.Releases.DownloadAsset(context.Background(), "diaspora/diaspora", "v1.0.1", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseDownloadAsset_External(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/releases/v1.0.1/assets/links/3").
+		MatchHeader("Private-Token", "secret").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]interface{}{
+			"id":        3,
+			"name":      "diaspora.tar.gz",
+			"url":       "https://example.com/files/diaspora.tar.gz",
+			"link_type": "other",
+		})
+
+	// the private token must not be sent to the third-party
+	// host that stores the asset.
+	noToken := gock.NewBasicMatcher()
+	noToken.Add(func(req *http.Request, _ *gock.Request) (bool, error) {
+		return req.Header.Get("Private-Token") == "", nil
+	})
+	gock.New("https://example.com").
+		Get("/files/diaspora.tar.gz").
+		SetMatcher(noToken).
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("hello world")
+
+	client := NewDefault()
+	client.Client = &http.Client{
+		Transport: &transport.PrivateToken{Token: "secret"},
+	}
+	rc, _, err := client.Releases.DownloadAsset(context.Background(), "diaspora/diaspora", "v1.0.1", "3")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "hello world"; string(got) != want {
+		t.Errorf("Want asset %q, got %q", want, got)
+	}
+}
+
+func TestReleaseDeleteAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/releases/v1.0.1/assets/links/2").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Releases.DeleteAsset(context.Background(), "diaspora/diaspora", "v1.0.1", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -62,6 +62,44 @@ type hook struct {
 	CreatedAt             time.Time `json:"created_at"`
 }
 
+type namespaceID struct {
+	ID int `json:"id"`
+}
+
+type repositoryInput struct {
+	Name              string `json:"name"`
+	NamespaceID       int    `json:"namespace_id,omitempty"`
+	Description       string `json:"description,omitempty"`
+	Visibility        string `json:"visibility,omitempty"`
+	DefaultBranch     string `json:"default_branch,omitempty"`
+	InitReadme        bool   `json:"initialize_with_readme,omitempty"`
+	TemplateProjectID int    `json:"template_project_id,omitempty"`
+	UseCustomTemplate bool   `json:"use_custom_template,omitempty"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string `json:"name,omitempty"`
+	Path          string `json:"path,omitempty"`
+	Description   string `json:"description,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+	Visibility    string `json:"visibility,omitempty"`
+}
+
+type forkInput struct {
+	NamespacePath string `json:"namespace_path,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Path          string `json:"path,omitempty"`
+}
+
+type transferInput struct {
+	Namespace string `json:"namespace"`
+}
+
+type member struct {
+	user
+	AccessLevel int `json:"access_level"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -193,6 +231,128 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	in := &repositoryInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Visibility:    convertFromVisibility(input.Visibility),
+		DefaultBranch: input.Branch,
+		InitReadme:    input.AutoInit,
+	}
+	// gitlab requires the namespace and template project
+	// to be identified by id.
+	if input.Namespace != "" {
+		path := fmt.Sprintf("api/v4/namespaces/%s", encode(input.Namespace))
+		out := new(namespaceID)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		in.NamespaceID = out.ID
+	}
+	if input.Template != "" {
+		path := fmt.Sprintf("api/v4/projects/%s", encode(input.Template))
+		out := new(repository)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		in.TemplateProjectID = out.ID
+		in.UseCustomTemplate = true
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", "api/v4/projects", in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/fork", encode(repo))
+	in := &forkInput{
+		NamespacePath: input.Namespace,
+		Name:          input.Name,
+		Path:          input.Name,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	out := new(repository)
+
+	// gitlab archives projects using separate endpoints,
+	// which are requested before the project is renamed.
+	if input.Archived != nil {
+		action := "unarchive"
+		if *input.Archived {
+			action = "archive"
+		}
+		path := fmt.Sprintf("api/v4/projects/%s/%s", encode(repo), action)
+		res, err := s.client.do(ctx, "POST", path, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		if input.Name == "" && input.Description == "" && input.Branch == "" && input.Visibility == scm.VisibilityUndefined {
+			return convertRepository(out), res, nil
+		}
+	}
+
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	in := &repositoryUpdateInput{
+		Name:          input.Name,
+		Path:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		Visibility:    convertFromVisibility(input.Visibility),
+	}
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/transfer", encode(repo))
+	in := &transferInput{Namespace: namespace}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// ListCollaborators returns the project members, including
+// members inherited from the parent groups.
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/members/all?%s", encode(repo), encodeListOptions(opts))
+	out := []*member{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCollaboratorList(out), res, err
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	ids, res, err := findUserIDs(ctx, s.client, []string{username})
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/members", encode(repo))
+	in := &memberInput{
+		UserID:      ids[0],
+		AccessLevel: convertFromPermission(perm),
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	ids, res, err := findUserIDs(ctx, s.client, []string{username})
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/members/%d", encode(repo), ids[0])
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gitlab repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -234,6 +394,18 @@ func convertRepository(from *repository) *scm.Repository {
 	return to
 }
 
+// helper function to convert from the common visibility
+// to the gitlab visibility, or an empty string if the
+// visibility is undefined.
+func convertFromVisibility(from scm.Visibility) string {
+	switch from {
+	case scm.VisibilityPublic, scm.VisibilityInternal, scm.VisibilityPrivate:
+		return from.String()
+	default:
+		return ""
+	}
+}
+
 func convertHookList(from []*hook) []*scm.Hook {
 	to := []*scm.Hook{}
 	for _, v := range from {
@@ -353,3 +525,18 @@ func canAdmin(proj *repository) bool {
 		return false
 	}
 }
+
+func convertCollaboratorList(from []*member) []*scm.Collaborator {
+	to := []*scm.Collaborator{}
+	for _, v := range from {
+		to = append(to, convertCollaborator(v))
+	}
+	return to
+}
+
+func convertCollaborator(from *member) *scm.Collaborator {
+	return &scm.Collaborator{
+		User:       *convertUser(&from.user),
+		Permission: convertPermission(from.AccessLevel),
+	}
+}
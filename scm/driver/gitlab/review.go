@@ -6,6 +6,13 @@ package gitlab
 
 import (
 	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -23,9 +30,393 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	mr, res, err := s.findDiffRefs(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	out, res, err := s.createDiscussion(ctx, repo, number, mr, input)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertDiscussionNote(out.Notes[0]), res, nil
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertApprovals(out), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	// gitlab does not support requesting changes.
+	switch input.State {
+	case scm.ReviewStateApproved, scm.ReviewStateCommented:
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	var res *scm.Response
+	var err error
+	// inline comments are created as merge request
+	// discussions positioned on the latest diff.
+	if len(input.Comments) != 0 {
+		var mr *pr
+		mr, res, err = s.findDiffRefs(ctx, repo, number)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range input.Comments {
+			_, res, err = s.createDiscussion(ctx, repo, number, mr, v)
+			if err != nil {
+				return nil, res, err
+			}
+		}
+	}
+	if input.Body != "" {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes", encode(repo), number)
+		in := &issueCommentInput{Body: input.Body}
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if input.State == scm.ReviewStateApproved {
+		// https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approve", encode(repo), number)
+		in := &approveInput{SHA: input.Sha}
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	return &scm.PullRequestReview{
+		Body:  input.Body,
+		State: input.State,
+		Sha:   input.Sha,
+	}, res, nil
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	// gitlab only permits users to revoke their own approval.
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/discussions.html#list-project-merge-request-discussion-items
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+	out := []*discussion{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertDiscussionList(out), res, err
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/discussions.html#add-note-to-existing-merge-request-thread
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s/notes", encode(repo), number, thread)
+	in := &issueCommentInput{Body: input.Body}
+	out := new(discussionNote)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertDiscussionNote(out), res, err
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolveDiscussion(ctx, repo, number, thread, true)
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolveDiscussion(ctx, repo, number, thread, false)
+}
+
+// findDiffRefs returns the merge request with the diff refs
+// required to position a discussion on the latest diff.
+func (s *reviewService) findDiffRefs(ctx context.Context, repo string, number int) (*pr, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+// createDiscussion creates a merge request discussion on
+// the diff. A discussion on a range of lines requires the
+// line codes of the first and last line, which are computed
+// from the merge request diff.
+func (s *reviewService) createDiscussion(ctx context.Context, repo string, number int, mr *pr, input *scm.ReviewInput) (*discussion, *scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/discussions.html#create-new-merge-request-thread
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions", encode(repo), number)
+	in := &discussionInput{
+		Body: input.Body,
+		Position: &discussionPosition{
+			PositionType: "text",
+			BaseSha:      mr.DiffRefs.BaseSha,
+			StartSha:     mr.DiffRefs.StartSha,
+			HeadSha:      mr.DiffRefs.HeadSha,
+			OldPath:      input.Path,
+			NewPath:      input.Path,
+		},
+	}
+	if input.Side == scm.ReviewSideLeft {
+		in.Position.OldLine = input.Line
+	} else {
+		in.Position.NewLine = input.Line
+	}
+	if input.StartLine != 0 && input.StartLine != input.Line {
+		diff, res, err := s.findDiff(ctx, repo, number, input.Path)
+		if err != nil {
+			return nil, res, err
+		}
+		start := findDiffLine(diff, input.Path, input.StartLine, input.Side)
+		end := findDiffLine(diff, input.Path, input.Line, input.Side)
+		if start == nil || end == nil {
+			return nil, res, errors.New("gitlab: review comment line range is not in the diff")
+		}
+		in.Position.OldLine = end.OldLine
+		in.Position.NewLine = end.NewLine
+		in.Position.LineRange = &discussionLineRange{
+			Start: start,
+			End:   end,
+		}
+	}
+	out := new(discussion)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return out, res, err
+}
+
+// findDiff returns the merge request diff of the file.
+func (s *reviewService) findDiff(ctx context.Context, repo string, number int, file string) (string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes", encode(repo), number)
+	out := new(changes)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return "", res, err
+	}
+	for _, v := range out.Changes {
+		if v.NewPath == file || v.OldPath == file {
+			return v.Diff, res, nil
+		}
+	}
+	return "", res, nil
+}
+
+func (s *reviewService) resolveDiscussion(ctx context.Context, repo string, number int, thread string, resolved bool) (*scm.Response, error) {
+	// https://docs.gitlab.com/ee/api/discussions.html#resolve-a-merge-request-thread
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s?resolved=%t", encode(repo), number, thread, resolved)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+type approvals struct {
+	ApprovedBy []struct {
+		User struct {
+			Username  string `json:"username"`
+			Name      string `json:"name"`
+			AvatarURL string `json:"avatar_url"`
+		} `json:"user"`
+	} `json:"approved_by"`
+}
+
+type approveInput struct {
+	SHA string `json:"sha,omitempty"`
+}
+
+type discussionInput struct {
+	Body     string              `json:"body"`
+	Position *discussionPosition `json:"position,omitempty"`
+}
+
+type discussionPosition struct {
+	PositionType string `json:"position_type"`
+	BaseSha      string `json:"base_sha"`
+	StartSha     string `json:"start_sha"`
+	HeadSha      string `json:"head_sha"`
+	OldPath      string `json:"old_path"`
+	NewPath      string `json:"new_path"`
+	OldLine      int    `json:"old_line,omitempty"`
+	NewLine      int    `json:"new_line,omitempty"`
+
+	LineRange *discussionLineRange `json:"line_range,omitempty"`
+}
+
+type discussionLineRange struct {
+	Start *discussionLine `json:"start"`
+	End   *discussionLine `json:"end"`
+}
+
+type discussionLine struct {
+	LineCode string `json:"line_code"`
+	Type     string `json:"type"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
+}
+
+type discussion struct {
+	ID             string            `json:"id"`
+	IndividualNote bool              `json:"individual_note"`
+	Notes          []*discussionNote `json:"notes"`
+}
+
+type discussionNote struct {
+	ID     int    `json:"id"`
+	Body   string `json:"body"`
+	Author struct {
+		Username  string `json:"username"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"author"`
+	Resolvable bool      `json:"resolvable"`
+	Resolved   bool      `json:"resolved"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Position   *struct {
+		HeadSha   string `json:"head_sha"`
+		OldPath   string `json:"old_path"`
+		NewPath   string `json:"new_path"`
+		OldLine   int    `json:"old_line"`
+		NewLine   int    `json:"new_line"`
+		LineRange *struct {
+			Start struct {
+				OldLine int `json:"old_line"`
+				NewLine int `json:"new_line"`
+			} `json:"start"`
+		} `json:"line_range"`
+	} `json:"position"`
+}
+
+func convertApprovals(from *approvals) []*scm.PullRequestReview {
+	to := []*scm.PullRequestReview{}
+	for _, v := range from.ApprovedBy {
+		to = append(to, &scm.PullRequestReview{
+			State: scm.ReviewStateApproved,
+			Author: scm.User{
+				Login:  v.User.Username,
+				Name:   v.User.Name,
+				Avatar: v.User.AvatarURL,
+			},
+		})
+	}
+	return to
+}
+
+// convertDiscussionList converts the merge request
+// discussions to review threads. Individual notes are not
+// threaded and are skipped.
+func convertDiscussionList(from []*discussion) []*scm.ReviewThread {
+	to := []*scm.ReviewThread{}
+	for _, v := range from {
+		if v.IndividualNote || len(v.Notes) == 0 {
+			continue
+		}
+		to = append(to, convertDiscussion(v))
+	}
+	return to
+}
+
+func convertDiscussion(from *discussion) *scm.ReviewThread {
+	to := &scm.ReviewThread{
+		ID:       from.ID,
+		Comments: []*scm.Review{},
+	}
+	resolvable := false
+	resolved := true
+	for _, v := range from.Notes {
+		if v.Resolvable {
+			resolvable = true
+			resolved = resolved && v.Resolved
+		}
+		to.Comments = append(to.Comments, convertDiscussionNote(v))
+	}
+	to.Resolved = resolvable && resolved
+	if first := to.Comments[0]; first.Path != "" {
+		to.Path = first.Path
+		to.Line = first.Line
+		to.StartLine = first.StartLine
+		to.Side = first.Side
+	}
+	return to
+}
+
+func convertDiscussionNote(from *discussionNote) *scm.Review {
+	to := &scm.Review{
+		ID:   from.ID,
+		Body: from.Body,
+		Author: scm.User{
+			Login:  from.Author.Username,
+			Name:   from.Author.Name,
+			Avatar: from.Author.AvatarURL,
+		},
+		Created: from.CreatedAt,
+		Updated: from.UpdatedAt,
+	}
+	if pos := from.Position; pos != nil {
+		to.Sha = pos.HeadSha
+		if pos.NewLine != 0 {
+			to.Path = pos.NewPath
+			to.Line = pos.NewLine
+			to.Side = scm.ReviewSideRight
+		} else {
+			to.Path = pos.OldPath
+			to.Line = pos.OldLine
+			to.Side = scm.ReviewSideLeft
+		}
+		if pos.LineRange != nil {
+			start := pos.LineRange.Start.NewLine
+			if to.Side == scm.ReviewSideLeft {
+				start = pos.LineRange.Start.OldLine
+			}
+			if start != to.Line {
+				to.StartLine = start
+			}
+		}
+	}
+	return to
+}
+
+// diffHunkRE matches the header of a unified diff hunk.
+var diffHunkRE = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// findDiffLine returns the line of the unified diff at the
+// line number on the given side of the diff, or nil if the
+// line is not in the diff. The line code is the sha1 of the
+// file path followed by the old and new line numbers, where
+// added lines use the number of the next old line, and
+// removed lines use the number of the next new line.
+func findDiffLine(diff, file string, line int, side scm.ReviewSide) *discussionLine {
+	prefix := fmt.Sprintf("%x", sha1.Sum([]byte(file)))
+	code := func(oldLine, newLine int) string {
+		return prefix + "_" + strconv.Itoa(oldLine) + "_" + strconv.Itoa(newLine)
+	}
+	var oldLine, newLine int
+	var hunk bool
+	for _, text := range strings.Split(diff, "\n") {
+		if match := diffHunkRE.FindStringSubmatch(text); match != nil {
+			oldLine, _ = strconv.Atoi(match[1])
+			newLine, _ = strconv.Atoi(match[2])
+			hunk = true
+			continue
+		}
+		if !hunk || text == "" {
+			continue
+		}
+		switch text[0] {
+		case '+':
+			if side != scm.ReviewSideLeft && newLine == line {
+				return &discussionLine{LineCode: code(oldLine, newLine), Type: "new", NewLine: newLine}
+			}
+			newLine++
+		case '-':
+			if side == scm.ReviewSideLeft && oldLine == line {
+				return &discussionLine{LineCode: code(oldLine, newLine), Type: "old", OldLine: oldLine}
+			}
+			oldLine++
+		case ' ':
+			if (side == scm.ReviewSideLeft && oldLine == line) || (side != scm.ReviewSideLeft && newLine == line) {
+				return &discussionLine{LineCode: code(oldLine, newLine), Type: "old", OldLine: oldLine, NewLine: newLine}
+			}
+			oldLine++
+			newLine++
+		}
+	}
+	return nil
+}
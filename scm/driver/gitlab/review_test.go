@@ -6,9 +6,14 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -28,17 +33,335 @@ func TestReviewList(t *testing.T) {
 }
 
 func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/changes").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"changes":[{"old_path":"README.md","new_path":"README.md","diff":"@@ -2,6 +2,7 @@\n line2\n line3\n-old4\n+new4\n+new5\n line5\n line6\n"}]}`)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		JSON(map[string]interface{}{
+			"body": "Nit: typo",
+			"position": map[string]interface{}{
+				"position_type": "text",
+				"base_sha":      "45d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+				"start_sha":     "",
+				"head_sha":      "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+				"old_path":      "README.md",
+				"new_path":      "README.md",
+				"old_line":      5,
+				"new_line":      6,
+				"line_range": map[string]interface{}{
+					"start": map[string]interface{}{
+						"line_code": "8ec9a00bfd09b3190ac6b22251dbb1aa95a0579d_5_4",
+						"type":      "new",
+						"new_line":  4,
+					},
+					"end": map[string]interface{}{
+						"line_code": "8ec9a00bfd09b3190ac6b22251dbb1aa95a0579d_5_6",
+						"type":      "old",
+						"old_line":  5,
+						"new_line":  6,
+					},
+				},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Nit: typo",
+		Path:      "README.md",
+		Line:      6,
+		StartLine: 4,
+		Side:      scm.ReviewSideRight,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_discussion.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate_NotInDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/changes").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"changes":[{"old_path":"README.md","new_path":"README.md","diff":"@@ -2,2 +2,2 @@\n line2\n line3\n"}]}`)
+
+	input := &scm.ReviewInput{
+		Body:      "Nit: typo",
+		Path:      "README.md",
+		Line:      6,
+		StartLine: 4,
+	}
+
+	client := NewDefault()
+	_, _, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1347, input)
+	if err == nil {
+		t.Errorf("Expect error when the line range is not in the diff")
+	}
+}
+
+func TestReviewDelete(t *testing.T) {
 	service := new(reviewService)
-	_, _, err := service.Create(context.Background(), "diaspora/diaspora", 1, nil)
+	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
 
-func TestReviewDelete(t *testing.T) {
+func TestReviewListReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/5/approvals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListReviews(context.Background(), "diaspora/diaspora", 5, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/approvals.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		JSON(map[string]interface{}{
+			"body": "Nit: typo",
+			"position": map[string]interface{}{
+				"position_type": "text",
+				"base_sha":      "45d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+				"start_sha":     "",
+				"head_sha":      "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+				"old_path":      "README.md",
+				"new_path":      "README.md",
+				"new_line":      6,
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/notes").
+		JSON(map[string]string{"body": "LGTM"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/approve").
+		JSON(map[string]string{"sha": "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/approvals.json")
+
+	input := &scm.PullRequestReviewInput{
+		Body:  "LGTM",
+		State: scm.ReviewStateApproved,
+		Sha:   "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		Comments: []*scm.ReviewInput{
+			{Path: "README.md", Line: 6, Body: "Nit: typo"},
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Submit(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comments created and merge request approved")
+	}
+	if got.State != scm.ReviewStateApproved {
+		t.Errorf("Want review state approved, got %s", got.State)
+	}
+}
+
+func TestReviewSubmit_ChangesRequested(t *testing.T) {
 	service := new(reviewService)
-	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
+	input := &scm.PullRequestReviewInput{State: scm.ReviewStateChangesRequested}
+	_, _, err := service.Submit(context.Background(), "diaspora/diaspora", 1, input)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewDismiss(t *testing.T) {
+	service := new(reviewService)
+	_, _, err := service.Dismiss(context.Background(), "diaspora/diaspora", 1, 1, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ListThreads(context.Background(), "diaspora/diaspora", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/merge_discussions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7/notes").
+		JSON(map[string]string{"body": "Fixed, thanks"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion_note.json")
+
+	input := &scm.CommentInput{
+		Body: "Fixed, thanks",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Reviews.Reply(context.Background(), "diaspora/diaspora", 1347, "6a9c1750b37d513a43987b574953fceb50b03ce7", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/merge_discussion_note.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7").
+		MatchParam("resolved", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	client := NewDefault()
+	_, err := client.Reviews.Resolve(context.Background(), "diaspora/diaspora", 1347, "6a9c1750b37d513a43987b574953fceb50b03ce7")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect discussion resolved")
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7").
+		MatchParam("resolved", "false").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_discussion.json")
+
+	client := NewDefault()
+	_, err := client.Reviews.Unresolve(context.Background(), "diaspora/diaspora", 1347, "6a9c1750b37d513a43987b574953fceb50b03ce7")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect discussion unresolved")
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// secretService implements the SecretService using CI/CD
+// variables. Environment secrets are project variables that
+// are scoped to the named environment.
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.ListEnvSecrets(ctx, repo, "*", opts)
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.CreateEnvSecret(ctx, repo, "*", input)
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	return s.UpdateEnvSecret(ctx, repo, "*", input)
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	return s.DeleteEnvSecret(ctx, repo, "*", name)
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables?%s", encode(org), encodeListOptions(opts))
+	out := []*ciVariable{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCIVariableList(out), res, err
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables", encode(org))
+	in := &ciVariableInput{
+		Key:    input.Name,
+		Value:  input.Value,
+		Masked: input.Masked,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables/%s", encode(org), input.Name)
+	in := &ciVariableInput{
+		Value:  input.Value,
+		Masked: input.Masked,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/variables/%s", encode(org), name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, _ scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	// the variables endpoint cannot filter by environment
+	// scope, so each page of variables is requested and the
+	// variables are filtered on the client. The filtered
+	// list is not paginated.
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	filtered := []*ciVariable{}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/variables?%s", encode(repo), encodeListOptions(opts))
+		out := []*ciVariable{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			if v.EnvironmentScope == env {
+				filtered = append(filtered, v)
+			}
+		}
+		if res.Page.Next == 0 {
+			res.Page = scm.Page{}
+			return convertCIVariableList(filtered), res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables", encode(repo))
+	in := &ciVariableInput{
+		Key:              input.Name,
+		Value:            input.Value,
+		Masked:           input.Masked,
+		EnvironmentScope: env,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables/%s?%s", encode(repo), input.Name, encodeEnvironmentScope(env))
+	in := &ciVariableInput{
+		Value:  input.Value,
+		Masked: input.Masked,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/variables/%s?%s", encode(repo), name, encodeEnvironmentScope(env))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type ciVariable struct {
+	Key              string `json:"key"`
+	VariableType     string `json:"variable_type"`
+	Protected        bool   `json:"protected"`
+	Masked           bool   `json:"masked"`
+	EnvironmentScope string `json:"environment_scope"`
+}
+
+type ciVariableInput struct {
+	Key              string `json:"key,omitempty"`
+	Value            string `json:"value"`
+	Masked           bool   `json:"masked"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+}
+
+// helper function returns the query parameters used to
+// select a variable with the given environment scope, since
+// the variable key is only unique within the scope.
+func encodeEnvironmentScope(env string) string {
+	params := url.Values{}
+	params.Set("filter[environment_scope]", env)
+	return params.Encode()
+}
+
+func convertCIVariableList(from []*ciVariable) []*scm.Secret {
+	to := []*scm.Secret{}
+	for _, v := range from {
+		to = append(to, convertCIVariable(v))
+	}
+	return to
+}
+
+func convertCIVariable(from *ciVariable) *scm.Secret {
+	return &scm.Secret{
+		Name:   from.Key,
+		Masked: from.Masked,
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/variables").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/variables.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListRepoSecrets(context.Background(), "diaspora/diaspora", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/variables.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/variables").
+		BodyString(`{"key":"TEST_VARIABLE_1","value":"TEST_1","masked":true,"environment_scope":"*"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Secrets.CreateRepoSecret(context.Background(), "diaspora/diaspora", &scm.SecretInput{Name: "TEST_VARIABLE_1", Value: "TEST_1", Masked: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSecretDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/variables/TEST_VARIABLE_1").
+		MatchParam("filter[environment_scope]", `\*`).
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Secrets.DeleteRepoSecret(context.Background(), "diaspora/diaspora", "TEST_VARIABLE_1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestOrgSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/groups/gitlab-org/variables").
+		BodyString(`{"key":"TEST_VARIABLE_1","value":"TEST_1","masked":false}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Secrets.CreateOrgSecret(context.Background(), "gitlab-org", &scm.SecretInput{Name: "TEST_VARIABLE_1", Value: "TEST_1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestEnvSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/variables").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/variables.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListEnvSecrets(context.Background(), "diaspora/diaspora", "production", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/env_variables.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestEnvSecretList_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/variables").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeader("Link", `<https://gitlab.com/api/v4/projects/diaspora%2Fdiaspora/variables?page=2&per_page=100>; rel="next"`).
+		BodyString(`[{"key":"STAGING_ONLY","value":"x","environment_scope":"staging"}]`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/variables").
+		MatchParam("page", "2").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/variables.json")
+
+	client := NewDefault()
+	got, res, err := client.Secrets.ListEnvSecrets(context.Background(), "diaspora/diaspora", "production", scm.ListOptions{Page: 1, Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/env_variables.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if res.Page.Next != 0 {
+		t.Errorf("Expect filtered list not paginated")
+	}
+}
+
+func TestEnvSecretUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/variables/TEST_VARIABLE_2").
+		MatchParam("filter[environment_scope]", "production").
+		BodyString(`{"value":"TEST_2","masked":false}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Secrets.UpdateEnvSecret(context.Background(), "diaspora/diaspora", "production", &scm.SecretInput{Name: "TEST_VARIABLE_2", Value: "TEST_2"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/drone/go-scm/scm"
@@ -48,6 +49,28 @@ func (s *userService) ListEmail(ctx context.Context, opts scm.ListOptions) ([]*s
 	return convertEmailList(out), res, err
 }
 
+// findUserIDs returns the user ids for the usernames. The
+// issue and merge request assignees and reviewers are
+// updated by id.
+func findUserIDs(ctx context.Context, client *wrapper, usernames []string) ([]int, *scm.Response, error) {
+	var ids []int
+	var res *scm.Response
+	for _, username := range usernames {
+		path := fmt.Sprintf("api/v4/users?username=%s", url.QueryEscape(username))
+		out := []*user{}
+		var err error
+		res, err = client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		if len(out) == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, out[0].ID)
+	}
+	return ids, res, nil
+}
+
 type user struct {
 	ID       int         `json:"id"`
 	Username string      `json:"username"`
@@ -72,6 +95,14 @@ func convertUser(from *user) *scm.User {
 	}
 }
 
+func convertUserList(from []*user) []*scm.User {
+	to := []*scm.User{}
+	for _, v := range from {
+		to = append(to, convertUser(v))
+	}
+	return to
+}
+
 // helper function to convert from the gitlab email list to
 // the common email structure.
 func convertEmailList(from []*email) []*scm.Email {
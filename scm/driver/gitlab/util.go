@@ -150,3 +150,31 @@ func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
 	}
 	return params.Encode()
 }
+
+func encodeCheckListOptions(opts scm.CheckListOptions) string {
+	params := url.Values{}
+	if opts.Name != "" {
+		params.Set("name", opts.Name)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func encodeDeploymentListOptions(opts scm.DeploymentListOptions) string {
+	params := url.Values{}
+	if opts.Environment != "" {
+		params.Set("environment", opts.Environment)
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"strconv"
 	"time"
 
@@ -43,6 +44,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = parseSystemHook(data)
 	case "Pipeline Hook":
 		return parsePipelineHook(data)
+	case "Deployment Hook":
+		hook, err = parseDeploymentHook(data)
 	default:
 		return nil, scm.ErrUnknownEvent
 	}
@@ -110,6 +113,15 @@ func parsePipelineHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func parseDeploymentHook(data []byte) (scm.Webhook, error) {
+	src := new(deploymentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertDeploymentHook(src), nil
+}
+
 func parsePushHook(data []byte) (scm.Webhook, error) {
 	src := new(pushHook)
 	err := json.Unmarshal(data, src)
@@ -145,6 +157,8 @@ func parsePullRequestHook(data []byte) (scm.Webhook, error) {
 	switch src.ObjectAttributes.Action {
 	case "open", "close", "reopen", "merge", "update":
 		// no-op
+	case "approved", "approval", "unapproved", "unapproval":
+		return convertPullRequestReviewHook(src), nil
 	default:
 		return nil, scm.ErrUnknownEvent
 	}
@@ -423,6 +437,33 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	}
 }
 
+// convertPullRequestReviewHook converts a merge request
+// approval event. The approved and unapproved actions are
+// sent when the approval rules are satisfied or no longer
+// satisfied, and the approval and unapproval actions are
+// sent for each individual approval.
+func convertPullRequestReviewHook(src *pullRequestHook) *scm.PullRequestReviewHook {
+	from := convertPullRequestHook(src)
+	dst := &scm.PullRequestReviewHook{
+		Action:      scm.ActionSubmit,
+		Repo:        from.Repo,
+		PullRequest: from.PullRequest,
+		Sender:      from.Sender,
+		Review: scm.PullRequestReview{
+			State:     scm.ReviewStateApproved,
+			Sha:       src.ObjectAttributes.LastCommit.ID,
+			Author:    from.Sender,
+			Submitted: parseTimeString(src.ObjectAttributes.UpdatedAt),
+		},
+	}
+	switch src.ObjectAttributes.Action {
+	case "unapproved", "unapproval":
+		dst.Action = scm.ActionDismiss
+		dst.Review.State = scm.ReviewStateDismissed
+	}
+	return dst
+}
+
 func parseTimeString(timeString string) time.Time {
 	layout := "2006-01-02 15:04:05 UTC"
 	// Returns zero value of time in case of an error 0001-01-01 00:00:00 +0000 UTC
@@ -481,6 +522,59 @@ func convertPipelineHook(src *pipelineHook) *scm.PipelineHook {
 	}
 }
 
+func convertDeploymentHook(src *deploymentHook) *scm.DeployStatusHook {
+	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	// the deployment hook includes the abbreviated sha,
+	// however, the full sha is included in the commit url.
+	sha := src.ShortSha
+	if src.CommitURL != "" {
+		sha = path.Base(src.CommitURL)
+	}
+	sender := scm.User{
+		Login:  src.User.Username,
+		Name:   src.User.Name,
+		Email:  src.User.Email,
+		Avatar: src.User.AvatarURL,
+	}
+	changed := parseDeploymentTime(src.StatusChangedAt)
+	return &scm.DeployStatusHook{
+		Deployment: scm.Deployment{
+			ID:          src.DeploymentID,
+			Sha:         sha,
+			Ref:         src.Ref,
+			Environment: src.Environment,
+			Author:      sender,
+		},
+		Status: scm.DeployStatus{
+			Number:         src.DeploymentID,
+			State:          convertDeployState(src.Status),
+			Target:         src.DeployableURL,
+			Environment:    src.Environment,
+			EnvironmentURL: src.EnvironmentExternalURL,
+			Author:         sender,
+			Updated:        changed,
+		},
+		Repo: scm.Repository{
+			ID:        strconv.Itoa(src.Project.ID),
+			Namespace: namespace,
+			Name:      name,
+			Clone:     src.Project.GitHTTPURL,
+			CloneSSH:  src.Project.GitSSHURL,
+			Link:      src.Project.WebURL,
+			Branch:    src.Project.DefaultBranch,
+			Private:   false,
+		},
+		Sender: sender,
+	}
+}
+
+// parseDeploymentTime parses the deployment hook timestamp,
+// which includes the numeric timezone offset.
+func parseDeploymentTime(s string) time.Time {
+	t, _ := time.Parse("2006-01-02 15:04:05 -0700", s)
+	return t
+}
+
 type (
 	// Generic struct to detect event type
 	event struct {
@@ -1092,4 +1186,39 @@ type (
 		Action         string `json:"action"`
 		DeploymentTier string `json:"deployment_tier"`
 	}
+
+	deploymentHook struct {
+		ObjectKind             string `json:"object_kind"`
+		Status                 string `json:"status"`
+		StatusChangedAt        string `json:"status_changed_at"`
+		DeploymentID           int64  `json:"deployment_id"`
+		DeployableID           int64  `json:"deployable_id"`
+		DeployableURL          string `json:"deployable_url"`
+		Environment            string `json:"environment"`
+		EnvironmentExternalURL string `json:"environment_external_url"`
+		Ref                    string `json:"ref"`
+		ShortSha               string `json:"short_sha"`
+		CommitURL              string `json:"commit_url"`
+		CommitTitle            string `json:"commit_title"`
+		User                   struct {
+			ID        int    `json:"id"`
+			Name      string `json:"name"`
+			Username  string `json:"username"`
+			AvatarURL string `json:"avatar_url"`
+			Email     string `json:"email"`
+		} `json:"user"`
+		Project struct {
+			ID                int         `json:"id"`
+			Name              string      `json:"name"`
+			Description       string      `json:"description"`
+			WebURL            string      `json:"web_url"`
+			AvatarURL         null.String `json:"avatar_url"`
+			GitSSHURL         string      `json:"git_ssh_url"`
+			GitHTTPURL        string      `json:"git_http_url"`
+			Namespace         string      `json:"namespace"`
+			VisibilityLevel   int         `json:"visibility_level"`
+			PathWithNamespace string      `json:"path_with_namespace"`
+			DefaultBranch     string      `json:"default_branch"`
+		} `json:"project"`
+	}
 )
@@ -176,6 +176,12 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pull_request_merge.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		{
+			event:  "Merge Request Hook",
+			before: "testdata/webhooks/pull_request_approved.json",
+			after:  "testdata/webhooks/pull_request_approved.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
 		// Note hook for Gitlab Merge Request comment
 		{
 			event:  "Note Hook",
@@ -195,6 +201,12 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pipeline_hook.json.golden",
 			obj:    new(scm.PipelineHook),
 		},
+		{
+			event:  "Deployment Hook",
+			before: "testdata/webhooks/deployment.json",
+			after:  "testdata/webhooks/deployment.json.golden",
+			obj:    new(scm.DeployStatusHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -26,6 +28,25 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v1/repos/%s/raw/%s/%s", repo, scm.TrimRef(ref), path)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	return &scm.RawContent{
+		Path: path,
+		Size: size,
+		Body: body,
+	}, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	endpoint := fmt.Sprintf("api/v1/repos/%s/archive/%s.%s", repo, scm.TrimRef(ref), format)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -38,6 +59,10 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return nil, scm.ErrNotSupported
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -6,9 +6,11 @@ package gogs
 
 import (
 	"context"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
 )
 
@@ -71,3 +73,60 @@ func TestContentList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/raw/master/README.md").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		BodyString("hello world\n")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Contents.FindRaw(context.Background(), "gogits/gogs", "README.md", "refs/heads/master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path: "README.md",
+		Size: 12,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/archive/master.zip").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client, _ := New("https://try.gogs.io")
+	rc, _, err := client.Contents.Archive(context.Background(), "gogits/gogs", "refs/heads/master", scm.ArchiveFormatZip)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
@@ -148,6 +148,14 @@ func TestBranchList(t *testing.T) {
 	}
 }
 
+func TestBranchDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.DeleteBranch(context.Background(), "gogits/gogs", "feature")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 //
 // tag sub-tests
 //
@@ -167,3 +175,19 @@ func TestTagList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestTagCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.CreateTag(context.Background(), "gogits/gogs", &scm.TagInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestTagDelete(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Git.DeleteTag(context.Background(), "gogits/gogs", "v1.0.0")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
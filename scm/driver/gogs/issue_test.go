@@ -99,14 +99,99 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
-func TestIssueClose(t *testing.T) {
+func TestIssueUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]interface{}{
+			"title":     "Bug found",
+			"assignee":  "",
+			"milestone": 1,
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Assignees: []string{},
+		Milestone: 1,
+	}
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
+	got, _, err := client.Issues.Update(context.Background(), "gogits/gogs", 1, &input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueUpdate_MultipleAssignees(t *testing.T) {
+	input := scm.IssueInput{
+		Assignees: []string{"janedoe", "johndoe"},
+	}
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.Update(context.Background(), "gogits/gogs", 1, &input)
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
 
+func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Close(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestIssueReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		JSON(map[string]string{"state": "open"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Issues.Reopen(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueLock(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, err := client.Issues.Lock(context.Background(), "gogits/go-gogs-client", 1)
@@ -189,6 +274,36 @@ func TestIssueCommentCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCommentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/comments/1").
+		JSON(map[string]string{"body": "what?"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/comment.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Issues.UpdateComment(context.Background(), "gogits/gogs", 1, 1, &scm.CommentInput{Body: "what?"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestIssueCommentDelete(t *testing.T) {
 	defer gock.Off()
 
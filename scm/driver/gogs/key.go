@@ -0,0 +1,120 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// keyService implements the KeyService. Gogs deploy keys
+// are always read-only, and gpg keys are not supported.
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	out := new(key)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	if !input.ReadOnly {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/keys", repo)
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/keys/%s", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, _ scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", "api/v1/user/keys", nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+//
+// native data structures
+//
+
+type (
+	// gogs public key object.
+	key struct {
+		ID       int       `json:"id"`
+		Title    string    `json:"title"`
+		Key      string    `json:"key"`
+		ReadOnly bool      `json:"read_only"`
+		Created  time.Time `json:"created_at"`
+	}
+
+	// gogs public key input object.
+	keyInput struct {
+		Title string `json:"title"`
+		Key   string `json:"key"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:       strconv.Itoa(src.ID),
+		Title:    src.Title,
+		Key:      src.Key,
+		ReadOnly: src.ReadOnly,
+		Created:  src.Created,
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/keys/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Keys.FindDeployKey(context.Background(), "gogits/gogs", "1")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Keys.ListDeployKeys(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/keys").
+		BodyString(`{"title":"drone","key":"ssh-rsa AAA..."}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title:    "drone",
+		Key:      "ssh-rsa AAA...",
+		ReadOnly: true,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Keys.CreateDeployKey(context.Background(), "gogits/gogs", input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate_ReadWrite(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Keys.CreateDeployKey(context.Background(), "gogits/gogs", &scm.KeyInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/keys/1").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Keys.DeleteDeployKey(context.Background(), "gogits/gogs", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/user/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Keys.ListKeys(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/user/keys").
+		BodyString(`{"title":"drone","key":"ssh-rsa AAA..."}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	input := &scm.KeyInput{
+		Title: "drone",
+		Key:   "ssh-rsa AAA...",
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Keys.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyList(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Keys.ListGPGKeys(context.Background(), scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGPGKeyCreate(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Keys.CreateGPGKey(context.Background(), &scm.GPGKeyInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
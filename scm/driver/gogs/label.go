@@ -0,0 +1,212 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// labelService implements the LabelService. Gogs
+// identifies labels by id, so labels are matched by name
+// against the repository labels. Gogs does not support
+// label descriptions.
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+func (s *labelService) List(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: convertLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	in := &labelUpdateInput{
+		Name:  input.Name,
+		Color: convertLabelColor(input.Color),
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	current, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, current.ID)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: ids}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *labelService) RemoveIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	for _, id := range ids {
+		path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels/%d", repo, number, id)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *labelService) SetIssueLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/labels", repo, number)
+	in := &labelsInput{Labels: ids}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+// pull requests are issues, and pull request labels are
+// managed using the issue endpoints.
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.AddIssueLabels(ctx, repo, number, labels)
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.RemoveIssueLabels(ctx, repo, number, labels)
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	return s.SetIssueLabels(ctx, repo, number, labels)
+}
+
+func (s *labelService) list(ctx context.Context, repo string) ([]*label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return out, res, err
+}
+
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	labels, res, err := s.list(ctx, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range labels {
+		if v.Name == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// findIDs returns the label ids for the label names. An
+// error is returned if a label does not exist.
+func (s *labelService) findIDs(ctx context.Context, repo string, names []string) ([]int64, *scm.Response, error) {
+	ids := []int64{}
+	if len(names) == 0 {
+		return ids, nil, nil
+	}
+	labels, res, err := s.list(ctx, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, name := range names {
+		id := int64(0)
+		for _, v := range labels {
+			if v.Name == name {
+				id = v.ID
+			}
+		}
+		if id == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, id)
+	}
+	return ids, res, nil
+}
+
+type label struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	URL   string `json:"url"`
+}
+
+type labelInput struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type labelUpdateInput struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+}
+
+type labelsInput struct {
+	Labels []int64 `json:"labels"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:  from.Name,
+		Color: from.Color,
+	}
+}
+
+// convertLabelColor returns the label color with the leading
+// hash required by gogs.
+func convertLabelColor(from string) string {
+	if from == "" || strings.HasPrefix(from, "#") {
+		return from
+	}
+	return "#" + from
+}
@@ -0,0 +1,245 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.Find(context.Background(), "gogits/gogs", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Labels.Find(context.Background(), "gogits/gogs", "wontfix")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.List(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/labels").
+		JSON(map[string]string{
+			"name":  "bug",
+			"color": "#ee0701",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:  "bug",
+		Color: "ee0701",
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.Create(context.Background(), "gogits/gogs", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/labels/1").
+		JSON(map[string]string{"color": "#ee0701"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/label.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Labels.Update(context.Background(), "gogits/gogs", "bug", &scm.LabelInput{Color: "#ee0701"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/labels/2").
+		Reply(204)
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Labels.Delete(context.Background(), "gogits/gogs", "enhancement")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect label deleted")
+	}
+}
+
+func TestLabelAddIssueLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string][]int{"labels": {2, 1}}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Labels.AddIssueLabels(context.Background(), "gogits/gogs", 1, []string{"enhancement", "bug"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels added")
+	}
+}
+
+func TestLabelRemovePullRequestLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/issues/1/labels/1").
+		Reply(204)
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Labels.RemovePullRequestLabels(context.Background(), "gogits/gogs", 1, []string{"bug"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels removed")
+	}
+}
+
+func TestLabelSetIssueLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/issues/1/labels").
+		JSON(map[string][]int{"labels": {}}).
+		Reply(200).
+		Type("application/json").
+		BodyString("[]")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Labels.SetIssueLabels(context.Background(), "gogits/gogs", 1, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect labels replaced")
+	}
+}
@@ -36,6 +36,46 @@ func (s *organizationService) List(ctx context.Context, _ scm.ListOptions) ([]*s
 	return convertOrgList(out), res, err
 }
 
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, team string) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, team string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, team string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, team, repo string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, team, repo string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
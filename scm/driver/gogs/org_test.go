@@ -73,3 +73,11 @@ func TestOrgList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrgListTeams(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Organizations.ListTeams(context.Background(), "gogits", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -54,6 +54,42 @@ func (s *pullService) Merge(context.Context, string, int) (*scm.Response, error)
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) MergeWithOptions(context.Context, string, int, scm.PullRequestMergeOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) Reopen(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) MarkReady(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ConvertToDraft(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) Update(context.Context, string, int, *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RequestReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListReviewers(context.Context, string, int) ([]scm.Reviewer, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetAssignees(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -2,6 +2,8 @@ package gogs
 
 import (
 	"context"
+	"io"
+
 	"github.com/drone/go-scm/scm"
 )
 
@@ -40,3 +42,19 @@ func (s *releaseService) Update(ctx context.Context, repo string, id int, input
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
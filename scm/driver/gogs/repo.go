@@ -119,6 +119,60 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Create creates a new repository. Gogs does not support
+// template repositories, and the default branch of an
+// initialized repository is configured by the server.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := "api/v1/user/repos"
+	if input.Namespace != "" {
+		path = fmt.Sprintf("api/v1/org/%s/repos", input.Namespace)
+	}
+	in := &repositoryInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Private:     input.Visibility == scm.VisibilityPrivate || input.Visibility == scm.VisibilityInternal,
+		AutoInit:    input.AutoInit,
+	}
+	if input.AutoInit {
+		in.Readme = "Default"
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(context.Context, string, *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Update(context.Context, string, *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s", repo)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) Transfer(context.Context, string, string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -141,6 +195,15 @@ type (
 		Permissions   perm      `json:"permissions"`
 	}
 
+	// gogs repository creation request.
+	repositoryInput struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+		Private     bool   `json:"private"`
+		AutoInit    bool   `json:"auto_init"`
+		Readme      string `json:"readme,omitempty"`
+	}
+
 	// gogs permissions details.
 	perm struct {
 		Admin bool `json:"admin"`
@@ -282,6 +282,70 @@ func TestHookEvents(t *testing.T) {
 	}
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/org/gogits/repos").
+		BodyString(`{"name":"gogs","private":false,"auto_init":true,"readme":"Default"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:  "gogits",
+		Name:       "gogs",
+		Visibility: scm.VisibilityPublic,
+		AutoInit:   true,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.Fork(context.Background(), "gogits/gogs", &scm.ForkInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gogs.io")
+	_, err := client.Repositories.Delete(context.Background(), "gogits/gogs")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoListCollaborators(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Repositories.ListCollaborators(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 //
 // status sub-tests
 //
@@ -42,3 +42,19 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListReviews(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Reviews.ListReviews(context.Background(), "gogits/gogs", 1, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Reviews.Submit(context.Background(), "gogits/gogs", 1, &scm.PullRequestReviewInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -36,6 +38,49 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the raw endpoint does not return the file metadata, and
+	// the sha of the last commit that changed the file is
+	// requested first.
+	opts := scm.CommitListOptions{Ref: ref, Path: path, Size: 1}
+	commitsEndpoint := fmt.Sprintf("api/v1/repos/%s/commits?%s&%s", repoId, encodeCommitListOptions(opts), queryParams)
+	commitsOut := new(commits)
+	res, err := s.client.do(ctx, "GET", commitsEndpoint, nil, commitsOut)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint := fmt.Sprintf("api/v1/repos/%s/raw/%s?git_ref=%s&%s", repoId, path, ref, queryParams)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	to := &scm.RawContent{
+		Path: path,
+		Size: size,
+		Body: body,
+	}
+	if len(commitsOut.Commits) != 0 {
+		to.Sha = commitsOut.Commits[0].Sha
+	}
+	return to, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("api/v1/repos/%s/archive/%s.%s?%s", repoId, ref, format, queryParams)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
 	repoId, queryParams, err := getRepoAndQueryParams(slug)
@@ -123,6 +168,77 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return res, err
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// harness does not reject the commit if the branch was
+	// updated, so the branch head is compared to the parent
+	// commit before committing.
+	if input.Parent != "" {
+		ref, res, err := s.client.Git.FindBranch(ctx, repo, input.Branch)
+		if err != nil {
+			return nil, res, err
+		}
+		if ref.Sha != input.Parent {
+			return nil, res, scm.ErrConflict
+		}
+	}
+	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	endpoint := fmt.Sprintf("api/v1/repos/%s/commits?%s", repoId, queryParams)
+	in := editFile{
+		Branch:      input.Branch,
+		Message:     input.Message,
+		Title:       input.Message,
+		Actions:     []action{},
+		BypassRules: true,
+		Author: identity{
+			Name:  input.Author.Name,
+			Email: input.Author.Email,
+		},
+	}
+	for _, v := range input.Actions {
+		a := action{
+			Path:     v.Path,
+			Payload:  string(v.Data),
+			Encoding: "string",
+		}
+		switch v.Action {
+		case scm.FileActionCreate:
+			a.Action = "CREATE"
+		case scm.FileActionUpdate:
+			a.Action = "UPDATE"
+		case scm.FileActionDelete:
+			a.Action = "DELETE"
+			a.Payload = ""
+		case scm.FileActionMove:
+			// the payload of a moved file is the new path,
+			// followed by a null byte and the new content if
+			// the content is replaced.
+			a.Action = "MOVE"
+			a.Path = v.PreviousPath
+			a.Payload = v.Path
+			if v.Data != nil {
+				a.Payload += "\x00" + string(v.Data)
+			}
+		default:
+			return nil, nil, scm.ErrNotSupported
+		}
+		in.Actions = append(in.Actions, a)
+	}
+	out := new(commitFiles)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Commit{
+		Sha:     out.CommitID,
+		Message: input.Message,
+		Author:  input.Author,
+	}, res, nil
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	slug := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
 	repoId, queryParams, err := getRepoAndQueryParams(slug)
@@ -152,6 +268,10 @@ type (
 		BypassRules bool `json:"bypass_rules"`
 	}
 
+	commitFiles struct {
+		CommitID string `json:"commit_id"`
+	}
+
 	action struct {
 		Action   string `json:"action"`
 		Encoding string `json:"encoding"`
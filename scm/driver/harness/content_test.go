@@ -231,3 +231,138 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/branches/main").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/commits").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		BodyString(`{"actions":[{"action":"UPDATE","encoding":"string","path":"README.md","payload":"hello world","sha":""},{"action":"DELETE","encoding":"string","path":"README.2","payload":"","sha":""},{"action":"MOVE","encoding":"string","path":"docs/old.md","payload":"docs/new.md","sha":""}],"author":{"name":"Thomas Honey","email":"thomas.honey@harness.io"},"branch":"main","message":"update docs","new_branch":"","title":"update docs","bypass_rules":true}`).
+		Reply(200).
+		Type("application/json").
+		BodyString("{\"commit_id\":\"20ecde1f8c277da0e91750bef9f3b88f228d86db\"}")
+
+	input := &scm.CommitInput{
+		Branch:  "main",
+		Message: "update docs",
+		Parent:  "1d640265d8bdd818175fa736f0fcbad2c9b716c9",
+		Author: scm.Signature{
+			Name:  "Thomas Honey",
+			Email: "thomas.honey@harness.io",
+		},
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionUpdate, Path: "README.md", Data: []byte("hello world")},
+			{Action: scm.FileActionDelete, Path: "README.2"},
+			{Action: scm.FileActionMove, Path: "docs/new.md", PreviousPath: "docs/old.md"},
+		},
+	}
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Contents.Commit(context.Background(), harnessRepo, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Commit{
+		Sha:     "20ecde1f8c277da0e91750bef9f3b88f228d86db",
+		Message: "update docs",
+		Author: scm.Signature{
+			Name:  "Thomas Honey",
+			Email: "thomas.honey@harness.io",
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/commits").
+		MatchParam("git_ref", "main").
+		MatchParam("path", "README.md").
+		MatchParam("limit", "1").
+		Reply(200).
+		Type("application/json").
+		JSON(map[string]interface{}{
+			"commits": []map[string]string{{"sha": "2bc1c7e4a8c6f1e0c1b6f2a44c6d9e1a3e6b7d02"}},
+		})
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/raw/README.md").
+		MatchParam("git_ref", "main").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		BodyString("hello world\n")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Contents.FindRaw(context.Background(), "thomas", "README.md", "main")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path: "README.md",
+		Size: 12,
+		Sha:  "2bc1c7e4a8c6f1e0c1b6f2a44c6d9e1a3e6b7d02",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/archive/main.tar.gz").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	rc, _, err := client.Contents.Archive(context.Background(), "thomas", "main", scm.ArchiveFormatTarGz)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
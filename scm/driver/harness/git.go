@@ -32,6 +32,16 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 	return s.client.do(ctx, "POST", path, in, nil)
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s?bypass_rules=true&%s", repoID, name, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
 	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
@@ -56,6 +66,34 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommitInfo(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// harness creates an annotated tag if a message is
+	// provided. The tagger is the authenticated user.
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags?%s", repoID, queryParams)
+	in := &tagInput{
+		Name:        params.Name,
+		Target:      params.Sha,
+		Message:     params.Message,
+		BypassRules: true,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s?bypass_rules=true&%s", repoID, name, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -126,6 +164,10 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 // native data structures
 type (
 	commits struct {
@@ -156,6 +198,12 @@ type (
 		Target      string `json:"target"`
 		BypassRules bool   `json:"bypass_rules"`
 	}
+	tagInput struct {
+		Name        string `json:"name"`
+		Target      string `json:"target"`
+		Message     string `json:"message"`
+		BypassRules bool   `json:"bypass_rules"`
+	}
 	branch struct {
 		Commit struct {
 			Author struct {
@@ -229,6 +229,83 @@ func TestCreateBranch(t *testing.T) {
 
 }
 
+func TestDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/branches/test").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("bypass_rules", "true").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	result, err := client.Git.DeleteBranch(context.Background(), harnessRepo, "test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if result.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/tags").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		BodyString(`{"name":"v1.0.0","target":"e8ef0374ca0cee8048e94b28eaf0d9e2e2515a14","message":"release v1.0.0","bypass_rules":true}`).
+		Reply(201).
+		Type("application/json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	input := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "e8ef0374ca0cee8048e94b28eaf0d9e2e2515a14",
+		Message: "release v1.0.0",
+	}
+	result, err := client.Git.CreateTag(context.Background(), harnessRepo, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if result.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/tags/v1.0.0").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	result, err := client.Git.DeleteTag(context.Background(), harnessRepo, "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if result.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
 func TestCompareChanges(t *testing.T) {
 	source := "542ddabd47d7bfa79359b7b4e2af7f975354e35f"
 	target := "c7d0d4b21d5cfdf47475ff1f6281ef1a91883d"
@@ -0,0 +1,298 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// labelService implements the LabelService. Labels are
+// identified by key, and are assigned to pull requests by
+// id. Harness does not support issues.
+type labelService struct {
+	client *wrapper
+}
+
+func (s *labelService) Find(ctx context.Context, repo, name string) (*scm.Label, *scm.Response, error) {
+	out, res, err := s.find(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertLabel(out), res, nil
+}
+
+func (s *labelService) List(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	out, res, err := s.list(ctx, repo, opts)
+	return convertLabelList(out), res, err
+}
+
+func (s *labelService) Create(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels?%s", repoId, queryParams)
+	in := &labelInput{
+		Key:         input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Update(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%s?%s", repoId, url.PathEscape(name), queryParams)
+	in := &labelInput{
+		Key:         input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *labelService) Delete(ctx context.Context, repo, name string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%s?%s", repoId, url.PathEscape(name), queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) AddIssueLabels(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) RemoveIssueLabels(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) SetIssueLabels(context.Context, string, int, []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *labelService) AddPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	for _, id := range ids {
+		res, err = s.assign(ctx, repo, number, id)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *labelService) RemovePullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	for _, id := range ids {
+		res, err = s.unassign(ctx, repo, number, id)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *labelService) SetPullRequestLabels(ctx context.Context, repo string, number int, labels []string) (*scm.Response, error) {
+	ids, res, err := s.findIDs(ctx, repo, labels)
+	if err != nil {
+		return res, err
+	}
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/labels?%s", repoId, number, queryParams)
+	out := new(prLabels)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	// labels are assigned one at a time, so the assigned
+	// labels are compared to the requested labels to
+	// replace the pull request labels.
+	assigned := map[int64]bool{}
+	for _, v := range out.LabelData {
+		assigned[v.ID] = true
+		if !containsID(ids, v.ID) {
+			res, err = s.unassign(ctx, repo, number, v.ID)
+			if err != nil {
+				return res, err
+			}
+		}
+	}
+	for _, id := range ids {
+		if !assigned[id] {
+			res, err = s.assign(ctx, repo, number, id)
+			if err != nil {
+				return res, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *labelService) assign(ctx context.Context, repo string, number int, id int64) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/labels?%s", repoId, number, queryParams)
+	in := &labelAssignInput{LabelID: id}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *labelService) unassign(ctx context.Context, repo string, number int, id int64) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/labels/%d?%s", repoId, number, id, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *labelService) list(ctx context.Context, repo string, opts scm.ListOptions) ([]*label, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels?%s&%s", repoId, encodeListOptions(opts), queryParams)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return out, res, err
+}
+
+// listAll returns all repository labels, requesting pages
+// until a partial page is returned.
+func (s *labelService) listAll(ctx context.Context, repo string) ([]*label, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	all := []*label{}
+	for {
+		out, res, err := s.list(ctx, repo, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, out...)
+		if len(out) < opts.Size {
+			return all, res, nil
+		}
+		opts.Page++
+	}
+}
+
+func (s *labelService) find(ctx context.Context, repo, name string) (*label, *scm.Response, error) {
+	labels, res, err := s.listAll(ctx, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range labels {
+		if v.Key == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// findIDs returns the label ids for the label keys. An
+// error is returned if a label does not exist.
+func (s *labelService) findIDs(ctx context.Context, repo string, names []string) ([]int64, *scm.Response, error) {
+	ids := []int64{}
+	if len(names) == 0 {
+		return ids, nil, nil
+	}
+	labels, res, err := s.listAll(ctx, repo)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, name := range names {
+		id := int64(0)
+		for _, v := range labels {
+			if v.Key == name {
+				id = v.ID
+			}
+		}
+		if id == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, id)
+	}
+	return ids, res, nil
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+type label struct {
+	ID          int64  `json:"id"`
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Color       string `json:"color"`
+	Scope       int    `json:"scope"`
+	ValueCount  int    `json:"value_count"`
+	Created     int64  `json:"created"`
+	Updated     int64  `json:"updated"`
+}
+
+type labelInput struct {
+	Key         string `json:"key,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type labelAssignInput struct {
+	LabelID int64 `json:"label_id"`
+}
+
+type prLabels struct {
+	LabelData []*label `json:"label_data"`
+}
+
+func convertLabelList(from []*label) []*scm.Label {
+	to := []*scm.Label{}
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertLabel(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Key,
+		Color:       from.Color,
+		Description: from.Description,
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestLabelFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/labels").
+		MatchParam("page", "1").
+		MatchParam("limit", "100").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Labels.Find(context.Background(), harnessRepo, "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/labels").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Labels.List(context.Background(), harnessRepo, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/labels").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		JSON(map[string]string{
+			"key":         "bug",
+			"color":       "red",
+			"description": "Something isn't working",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/label.json")
+
+	input := &scm.LabelInput{
+		Name:        "bug",
+		Color:       "red",
+		Description: "Something isn't working",
+	}
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Labels.Create(context.Background(), harnessRepo, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/labels/bug").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Labels.Delete(context.Background(), harnessRepo, "bug")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect label deleted")
+	}
+}
+
+func TestLabelSetPullRequestLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/pullreq/1/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_labels.json")
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas/pullreq/1/labels/12").
+		Reply(204)
+
+	gock.New(gockOrigin).
+		Put("/gateway/code/api/v1/repos/thomas/pullreq/1/labels").
+		JSON(map[string]int{"label_id": 11}).
+		Reply(200).
+		Type("application/json").
+		BodyString("{}")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Labels.SetPullRequestLabels(context.Background(), harnessRepo, 1, []string{"bug"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request labels replaced")
+	}
+}
+
+func TestLabelAddIssueLabels(t *testing.T) {
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Labels.AddIssueLabels(context.Background(), harnessRepo, 1, []string{"bug"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
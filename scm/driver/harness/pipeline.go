@@ -0,0 +1,203 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// pipelineService implements the PipelineService using
+// pipeline executions. Executions are numbered per
+// pipeline, so the run identifier is the pipeline
+// identifier and execution number separated by a slash,
+// and the job identifier is the stage and step number
+// separated by a slash.
+type pipelineService struct {
+	client *wrapper
+}
+
+func (s *pipelineService) Trigger(ctx context.Context, repo string, input *scm.PipelineInput) (*scm.PipelineRun, *scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	params := url.Values{}
+	params.Set("branch", scm.TrimRef(input.Ref))
+	path := fmt.Sprintf("api/v1/repos/%s/pipelines/%s/executions?%s&%s", repoID, input.Pipeline, params.Encode(), queryParams)
+	out := new(execution)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertExecution(input.Pipeline, out), res, err
+}
+
+func (s *pipelineService) ListRuns(ctx context.Context, repo string, opts scm.PipelineListOptions) ([]*scm.PipelineRun, *scm.Response, error) {
+	if opts.Pipeline == "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pipelines/%s/executions?%s&%s", repoID, opts.Pipeline, encodeListOptions(scm.ListOptions{Page: opts.Page, Size: opts.Size}), queryParams)
+	out := []*execution{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the executions cannot be filtered by branch, and are
+	// filtered after they are returned.
+	to := []*scm.PipelineRun{}
+	for _, v := range out {
+		if opts.Ref != "" && v.Source != scm.TrimRef(opts.Ref) {
+			continue
+		}
+		to = append(to, convertExecution(opts.Pipeline, v))
+	}
+	return to, res, nil
+}
+
+func (s *pipelineService) FindRun(ctx context.Context, repo, id string) (*scm.PipelineRun, *scm.Response, error) {
+	pipeline, number := splitExecutionID(id)
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pipelines/%s/executions/%s?%s", repoID, pipeline, number, queryParams)
+	out := new(execution)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	run := convertExecution(pipeline, out)
+	run.Jobs = convertExecutionSteps(out.Stages)
+	return run, res, nil
+}
+
+func (s *pipelineService) CancelRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	pipeline, number := splitExecutionID(id)
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pipelines/%s/executions/%s/cancel?%s", repoID, pipeline, number, queryParams)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pipelineService) RetryRun(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pipelineService) FindLogs(ctx context.Context, repo, run, job string) (io.ReadCloser, *scm.Response, error) {
+	pipeline, number := splitExecutionID(run)
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoID, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the step logs are returned as a list of json encoded
+	// log lines, which are joined to return the plain text
+	// logs.
+	path := fmt.Sprintf("api/v1/repos/%s/pipelines/%s/executions/%s/logs/%s?%s", repoID, pipeline, number, job, queryParams)
+	out := []*logLine{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	buf := new(bytes.Buffer)
+	for _, v := range out {
+		buf.WriteString(v.Out)
+	}
+	return ioutil.NopCloser(buf), res, nil
+}
+
+type execution struct {
+	Number  int      `json:"number"`
+	Status  string   `json:"status"`
+	Event   string   `json:"event"`
+	Trigger string   `json:"trigger"`
+	Source  string   `json:"source"`
+	After   string   `json:"after"`
+	Created int64    `json:"created"`
+	Updated int64    `json:"updated"`
+	Stages  []*stage `json:"stages"`
+}
+
+type stage struct {
+	Number int     `json:"number"`
+	Name   string  `json:"name"`
+	Status string  `json:"status"`
+	Steps  []*step `json:"steps"`
+}
+
+type step struct {
+	Number  int    `json:"number"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Started int64  `json:"started"`
+	Stopped int64  `json:"stopped"`
+}
+
+type logLine struct {
+	Pos  int    `json:"pos"`
+	Out  string `json:"out"`
+	Time int64  `json:"time"`
+}
+
+// splitExecutionID returns the pipeline identifier and
+// execution number of the run identifier.
+func splitExecutionID(id string) (string, string) {
+	i := strings.LastIndex(id, "/")
+	if i == -1 {
+		return "", id
+	}
+	return id[:i], id[i+1:]
+}
+
+func convertExecution(pipeline string, from *execution) *scm.PipelineRun {
+	return &scm.PipelineRun{
+		ID:       fmt.Sprintf("%s/%d", pipeline, from.Number),
+		Number:   from.Number,
+		Pipeline: pipeline,
+		Ref:      from.Source,
+		Sha:      from.After,
+		Event:    from.Event,
+		Status:   scm.ConvertExecutionStatus(from.Status),
+		Created:  time.UnixMilli(from.Created),
+		Updated:  time.UnixMilli(from.Updated),
+	}
+}
+
+func convertExecutionSteps(from []*stage) []*scm.PipelineJob {
+	to := []*scm.PipelineJob{}
+	for _, stage := range from {
+		for _, step := range stage.Steps {
+			job := &scm.PipelineJob{
+				ID:     fmt.Sprintf("%d/%d", stage.Number, step.Number),
+				Name:   step.Name,
+				Status: scm.ConvertExecutionStatus(step.Status),
+			}
+			if step.Started != 0 {
+				job.Started = time.Unix(step.Started, 0)
+			}
+			if step.Stopped != 0 {
+				job.Finished = time.Unix(step.Stopped, 0)
+			}
+			to = append(to, job)
+		}
+	}
+	return to
+}
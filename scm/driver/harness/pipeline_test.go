@@ -0,0 +1,167 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestPipelineTrigger(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/pipelines/pipeline1/executions").
+		MatchParam("branch", "main").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("application/json").
+		File("testdata/execution.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Pipelines.Trigger(context.Background(), harnessRepo, &scm.PipelineInput{Pipeline: "pipeline1", Ref: "refs/heads/main"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/execution_trigger.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/pipelines/pipeline1/executions").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("application/json").
+		File("testdata/executions.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Pipelines.ListRuns(context.Background(), harnessRepo, scm.PipelineListOptions{Pipeline: "pipeline1", Ref: "main", Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PipelineRun{}
+	raw, _ := ioutil.ReadFile("testdata/executions.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineListRuns_PipelineRequired(t *testing.T) {
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, _, err := client.Pipelines.ListRuns(context.Background(), harnessRepo, scm.PipelineListOptions{Ref: "main"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPipelineFindRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/pipelines/pipeline1/executions/4").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("application/json").
+		File("testdata/execution.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Pipelines.FindRun(context.Background(), harnessRepo, "pipeline1/4")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.PipelineRun)
+	raw, _ := ioutil.ReadFile("testdata/execution.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPipelineCancelRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/pipelines/pipeline1/executions/4/cancel").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Pipelines.CancelRun(context.Background(), harnessRepo, "pipeline1/4")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPipelineRetryRun(t *testing.T) {
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Pipelines.RetryRun(context.Background(), harnessRepo, "pipeline1/4")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPipelineFindLogs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/code/api/v1/repos/thomas/pipelines/pipeline1/executions/4/logs/1/2").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		Reply(200).
+		Type("application/json").
+		File("testdata/execution_logs.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	rc, _, err := client.Pipelines.FindLogs(context.Background(), harnessRepo, "pipeline1/4", "1/2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if want := "+ go test ./...\nFAIL\n"; string(got) != want {
+		t.Errorf("Want logs %q, got %q", want, got)
+	}
+}
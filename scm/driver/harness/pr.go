@@ -6,8 +6,10 @@ package harness
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/drone/go-scm/scm/driver/internal/null"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -114,6 +116,67 @@ func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Res
 }
 
 func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	return s.MergeWithOptions(ctx, repo, index, scm.PullRequestMergeOptions{})
+}
+
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, index int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pullreq/%d/merge?%s", repoId, index, queryParams)
+	in := &prMergeInput{
+		Method:             "merge",
+		SourceSHA:          opts.SHA,
+		Title:              opts.CommitTitle,
+		Message:            opts.CommitMessage,
+		DeleteSourceBranch: opts.DeleteSourceBranch,
+	}
+	switch opts.Method {
+	case scm.MergeMethodSquash:
+		in.Method = "squash"
+	case scm.MergeMethodRebase:
+		in.Method = "rebase"
+	case scm.MergeMethodFastForward:
+		in.Method = "fast-forward"
+	}
+	res, err := s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	return res, nil
+}
+
+func (s *pullService) Reopen(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) MarkReady(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ConvertToDraft(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) Update(context.Context, string, int, *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RequestReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RemoveReviewers(context.Context, string, int, *scm.ReviewerInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListReviewers(context.Context, string, int) ([]scm.Reviewer, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetAssignees(context.Context, string, int, []string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
@@ -164,6 +227,14 @@ type (
 		Sha  string     `json:"sha"`
 	}
 
+	prMergeInput struct {
+		Method             string `json:"method"`
+		SourceSHA          string `json:"source_sha,omitempty"`
+		Title              string `json:"title,omitempty"`
+		Message            string `json:"message,omitempty"`
+		DeleteSourceBranch bool   `json:"delete_source_branch,omitempty"`
+	}
+
 	prInput struct {
 		Description   string `json:"description"`
 		IsDraft       bool   `json:"is_draft"`
@@ -339,3 +410,16 @@ func convertComment(comment *prCommentResponse) *scm.Comment {
 		Updated: time.UnixMilli(comment.Updated),
 	}
 }
+
+// convertMergeError returns a MergeMethodError if the merge
+// failed because the merge method is not allowed by the
+// repository rules.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		(apiErr.Status == http.StatusBadRequest || apiErr.Status == http.StatusUnprocessableEntity) &&
+		strings.Contains(strings.ToLower(apiErr.Message), "merge method") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
+}
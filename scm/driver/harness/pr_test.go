@@ -191,3 +191,39 @@ func TestPRComment(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPRMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos/thomas/pullreq/1/merge").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		JSON(map[string]interface{}{
+			"method":               "squash",
+			"source_sha":           "f2d2b1e1d52e6b0a8ad6ee6ecb4cc8eee1d6e6b3",
+			"title":                "Add feature (#1)",
+			"delete_source_branch": true,
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"sha":"2b7cba2b5a7cd9e3f8f8ffd3a3bf7cac22c89c4c"}`)
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Add feature (#1)",
+		SHA:                "f2d2b1e1d52e6b0a8ad6ee6ecb4cc8eee1d6e6b3",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), harnessRepo, 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request merged")
+	}
+}
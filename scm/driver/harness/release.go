@@ -2,6 +2,7 @@ package harness
 
 import (
 	"context"
+	"io"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -43,6 +44,22 @@ func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag strin
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *releaseService) ListAssets(ctx context.Context, repo string, tag string, opts scm.ListOptions) ([]*scm.ReleaseAsset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) UploadAsset(ctx context.Context, repo string, tag string, input *scm.ReleaseAssetInput) (*scm.ReleaseAsset, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DownloadAsset(ctx context.Context, repo string, tag string, id string) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *releaseService) DeleteAsset(ctx context.Context, repo string, tag string, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 type ReleaseInput struct {
 	TagName      string `json:"tag_name"`
 	Target       string `json:"target_commitish"`
@@ -133,6 +133,63 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Create creates a new repository in the project of the
+// client.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	queryParams := fmt.Sprintf("%s=%s&%s=%s&%s=%s&%s=%s",
+		projectIdentifier, s.client.project, orgIdentifier, s.client.organization, accountIdentifier, s.client.account,
+		routingId, s.client.account)
+
+	path := fmt.Sprintf("api/v1/repos?%s", queryParams)
+	in := &repositoryInput{
+		Identifier:    input.Name,
+		Description:   input.Description,
+		IsPublic:      input.Visibility == scm.VisibilityPublic,
+		DefaultBranch: input.Branch,
+		Readme:        input.AutoInit,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+func (s *repositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	harnessURI := buildHarnessURI(s.client.account, s.client.organization, s.client.project, repo)
+	repoId, queryParams, err := getRepoAndQueryParams(harnessURI)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s?%s", repoId, queryParams)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -158,6 +215,14 @@ type (
 		NumMergedPulls int    `json:"num_merged_pulls"`
 		GitURL         string `json:"git_url"`
 	}
+	// harness repository creation request.
+	repositoryInput struct {
+		Identifier    string `json:"identifier"`
+		Description   string `json:"description,omitempty"`
+		IsPublic      bool   `json:"is_public"`
+		DefaultBranch string `json:"default_branch,omitempty"`
+		Readme        bool   `json:"readme"`
+	}
 	hook struct {
 		Created               int      `json:"created"`
 		CreatedBy             int      `json:"created_by"`
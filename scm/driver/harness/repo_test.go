@@ -252,3 +252,63 @@ func TestRepositoryHookCreateDelete(t *testing.T) {
 		return
 	}
 }
+
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/code/api/v1/repos").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		BodyString(`{"identifier":"demo","is_public":false,"default_branch":"main","readme":true}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	input := &scm.RepositoryInput{
+		Name:       "demo",
+		Visibility: scm.VisibilityPrivate,
+		Branch:     "main",
+		AutoInit:   true,
+	}
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/code/api/v1/repos/thomas").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("routingId", "px7xd_BFRCi-pfWPYXVjvw").
+		Reply(204)
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	result, err := client.Repositories.Delete(context.Background(), harnessRepo)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if result.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// secretService implements the SecretService using the
+// Harness platform secrets api, which is served alongside
+// the code api. Repository secrets are stored in the project
+// of the client, and environment secrets are not supported.
+type secretService struct {
+	client *wrapper
+}
+
+func (s *secretService) ListRepoSecrets(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	params := s.params(s.client.organization, s.client.project)
+	return s.list(ctx, params, opts)
+}
+
+func (s *secretService) CreateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	params := s.params(s.client.organization, s.client.project)
+	path := fmt.Sprintf("../ng/api/v2/secrets?%s", params.Encode())
+	in := convertSecretInput(input, s.client.organization, s.client.project)
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *secretService) UpdateRepoSecret(ctx context.Context, repo string, input *scm.SecretInput) (*scm.Response, error) {
+	params := s.params(s.client.organization, s.client.project)
+	path := fmt.Sprintf("../ng/api/v2/secrets/%s?%s", input.Name, params.Encode())
+	in := convertSecretInput(input, s.client.organization, s.client.project)
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) DeleteRepoSecret(ctx context.Context, repo, name string) (*scm.Response, error) {
+	params := s.params(s.client.organization, s.client.project)
+	path := fmt.Sprintf("../ng/api/v2/secrets/%s?%s", name, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListOrgSecrets(ctx context.Context, org string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return s.list(ctx, s.params(org, ""), opts)
+}
+
+func (s *secretService) CreateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("../ng/api/v2/secrets?%s", s.params(org, "").Encode())
+	in := convertSecretInput(input, org, "")
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *secretService) UpdateOrgSecret(ctx context.Context, org string, input *scm.SecretInput) (*scm.Response, error) {
+	path := fmt.Sprintf("../ng/api/v2/secrets/%s?%s", input.Name, s.params(org, "").Encode())
+	in := convertSecretInput(input, org, "")
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+func (s *secretService) DeleteOrgSecret(ctx context.Context, org, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("../ng/api/v2/secrets/%s?%s", name, s.params(org, "").Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *secretService) ListEnvSecrets(ctx context.Context, repo, env string, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *secretService) CreateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) UpdateEnvSecret(ctx context.Context, repo, env string, input *scm.SecretInput) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) DeleteEnvSecret(ctx context.Context, repo, env, name string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *secretService) list(ctx context.Context, params url.Values, opts scm.ListOptions) ([]*scm.Secret, *scm.Response, error) {
+	// the secrets api uses a zero-based page index.
+	if opts.Page > 0 {
+		params.Set("pageIndex", strconv.Itoa(opts.Page-1))
+	}
+	if opts.Size != 0 {
+		params.Set("pageSize", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("../ng/api/v2/secrets?%s", params.Encode())
+	out := new(secretList)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSecretList(out), res, err
+}
+
+// helper function returns the query parameters that identify
+// the account, organization and project scope of a secret.
+func (s *secretService) params(org, project string) url.Values {
+	params := url.Values{}
+	params.Set(accountIdentifier, s.client.account)
+	params.Set(routingId, s.client.account)
+	if org != "" {
+		params.Set(orgIdentifier, org)
+	}
+	if project != "" {
+		params.Set(projectIdentifier, project)
+	}
+	return params
+}
+
+//
+// native data structures
+//
+
+type (
+	secretList struct {
+		Status string `json:"status"`
+		Data   struct {
+			TotalPages int `json:"totalPages"`
+			PageIndex  int `json:"pageIndex"`
+			Content    []struct {
+				Secret    secret `json:"secret"`
+				CreatedAt int64  `json:"createdAt"`
+				UpdatedAt int64  `json:"updatedAt"`
+			} `json:"content"`
+		} `json:"data"`
+	}
+
+	secret struct {
+		Type              string     `json:"type"`
+		Name              string     `json:"name"`
+		Identifier        string     `json:"identifier"`
+		OrgIdentifier     string     `json:"orgIdentifier,omitempty"`
+		ProjectIdentifier string     `json:"projectIdentifier,omitempty"`
+		Spec              secretSpec `json:"spec"`
+	}
+
+	secretSpec struct {
+		SecretManagerIdentifier string `json:"secretManagerIdentifier"`
+		ValueType               string `json:"valueType"`
+		Value                   string `json:"value,omitempty"`
+	}
+
+	secretInput struct {
+		Secret secret `json:"secret"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertSecretInput(src *scm.SecretInput, org, project string) *secretInput {
+	return &secretInput{
+		Secret: secret{
+			Type:              "SecretText",
+			Name:              src.Name,
+			Identifier:        src.Name,
+			OrgIdentifier:     org,
+			ProjectIdentifier: project,
+			Spec: secretSpec{
+				SecretManagerIdentifier: "harnessSecretManager",
+				ValueType:               "Inline",
+				Value:                   src.Value,
+			},
+		},
+	}
+}
+
+func convertSecretList(src *secretList) []*scm.Secret {
+	dst := []*scm.Secret{}
+	for _, v := range src.Data.Content {
+		dst = append(dst, &scm.Secret{
+			Name:    v.Secret.Identifier,
+			Masked:  true,
+			Created: time.Unix(v.CreatedAt/1000, 0),
+			Updated: time.Unix(v.UpdatedAt/1000, 0),
+		})
+	}
+	return dst
+}
@@ -0,0 +1,97 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSecretList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Get("/gateway/ng/api/v2/secrets").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		MatchParam("pageIndex", "0").
+		MatchParam("pageSize", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/secrets.json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	got, _, err := client.Secrets.ListRepoSecrets(context.Background(), harnessRepo, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Secret{}
+	raw, _ := ioutil.ReadFile("testdata/secrets.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSecretCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Post("/gateway/ng/api/v2/secrets").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		MatchParam("projectIdentifier", "codeciintegration").
+		BodyString(`{"secret":{"type":"SecretText","name":"docker_password","identifier":"docker_password","orgIdentifier":"default","projectIdentifier":"codeciintegration","spec":{"secretManagerIdentifier":"harnessSecretManager","valueType":"Inline","value":"s3cr3t"}}}`).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.SecretInput{
+		Name:  "docker_password",
+		Value: "s3cr3t",
+	}
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Secrets.CreateRepoSecret(context.Background(), harnessRepo, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrgSecretDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(gockOrigin).
+		Delete("/gateway/ng/api/v2/secrets/docker_password").
+		MatchParam("accountIdentifier", "px7xd_BFRCi-pfWPYXVjvw").
+		MatchParam("orgIdentifier", "default").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, err := client.Secrets.DeleteOrgSecret(context.Background(), "default", "docker_password")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEnvSecretList(t *testing.T) {
+	client, _ := New(gockOrigin, harnessOrg, harnessAccount, harnessProject)
+	_, _, err := client.Secrets.ListEnvSecrets(context.Background(), harnessRepo, "production", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// checksService implements the ChecksService using the
+// Bitbucket Server code insights reports and annotations. A
+// report is identified by the commit and the report key,
+// which is the check run external id, or the check run name
+// if the external id is empty. Bitbucket Server cannot filter
+// reports by name, so the name filter is applied to each page
+// of results. Bitbucket Server does not support check suites.
+type checksService struct {
+	client *wrapper
+}
+
+type insightReports struct {
+	pagination
+	Values []*insightReport `json:"values"`
+}
+
+type insightReport struct {
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	Details     string `json:"details"`
+	Reporter    string `json:"reporter"`
+	Link        string `json:"link"`
+	Result      string `json:"result"`
+	CreatedDate int64  `json:"createdDate"`
+}
+
+type insightReportInput struct {
+	Title    string `json:"title"`
+	Details  string `json:"details,omitempty"`
+	Reporter string `json:"reporter,omitempty"`
+	Link     string `json:"link,omitempty"`
+	Result   string `json:"result,omitempty"`
+}
+
+type insightAnnotations struct {
+	Annotations []*insightAnnotation `json:"annotations"`
+}
+
+type insightAnnotation struct {
+	ExternalID string `json:"externalId"`
+	Path       string `json:"path,omitempty"`
+	Line       int    `json:"line,omitempty"`
+	Message    string `json:"message"`
+	Severity   string `json:"severity"`
+	Type       string `json:"type"`
+}
+
+func (s *checksService) FindCheckRun(ctx context.Context, repo, id string) (*scm.CheckRun, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckRuns(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckRun, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/insights/1.0/projects/%s/repos/%s/commits/%s/reports?%s", namespace, name, ref, encodeListOptions(scm.ListOptions{Page: opts.Page, Size: opts.Size}))
+	out := new(insightReports)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertInsightReportList(out, ref, opts.Name), res, err
+}
+
+func (s *checksService) ListAnnotations(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.CheckRunAnnotation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckRun(ctx context.Context, repo string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	id := input.ExternalID
+	if id == "" {
+		id = input.Name
+	}
+	return s.UpdateCheckRun(ctx, repo, id, input)
+}
+
+func (s *checksService) UpdateCheckRun(ctx context.Context, repo, id string, input *scm.CheckRunInput) (*scm.CheckRun, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/insights/1.0/projects/%s/repos/%s/commits/%s/reports/%s", namespace, name, input.HeadSHA, url.PathEscape(id))
+	in := &insightReportInput{
+		Title:  input.Name,
+		Link:   input.DetailsURL,
+		Result: convertFromInsightResult(input.State),
+	}
+	if output := input.Output; output != nil {
+		in.Details = output.Summary
+		if in.Details == "" {
+			in.Details = output.Title
+		}
+	}
+	out := new(insightReport)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil || input.Output == nil || len(input.Output.Annotations) == 0 {
+		return convertInsightReport(out, input.HeadSHA), res, err
+	}
+
+	annotations := &insightAnnotations{
+		Annotations: convertFromInsightAnnotationList(id, input.Output.Annotations),
+	}
+	res, err = s.client.do(ctx, "POST", path+"/annotations", annotations, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertInsightReport(out, input.HeadSHA), res, nil
+}
+
+func (s *checksService) FindCheckSuite(ctx context.Context, repo, id string) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) ListCheckSuites(ctx context.Context, repo, ref string, opts scm.CheckListOptions) ([]*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) CreateCheckSuite(ctx context.Context, repo string, input *scm.CheckSuiteInput) (*scm.CheckSuite, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *checksService) RerequestCheckSuite(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func convertInsightReportList(from *insightReports, sha, name string) []*scm.CheckRun {
+	to := []*scm.CheckRun{}
+	for _, v := range from.Values {
+		if name != "" && v.Title != name {
+			continue
+		}
+		to = append(to, convertInsightReport(v, sha))
+	}
+	return to
+}
+
+func convertInsightReport(from *insightReport, sha string) *scm.CheckRun {
+	to := &scm.CheckRun{
+		ID:         from.Key,
+		Name:       from.Title,
+		HeadSHA:    sha,
+		ExternalID: from.Key,
+		DetailsURL: from.Link,
+		State:      convertInsightResult(from.Result),
+		Output: &scm.CheckRunOutput{
+			Summary: from.Details,
+		},
+	}
+	if from.CreatedDate != 0 {
+		to.Started = time.Unix(from.CreatedDate/1000, 0)
+	}
+	return to
+}
+
+func convertFromInsightAnnotationList(id string, from []*scm.CheckRunAnnotation) []*insightAnnotation {
+	var to []*insightAnnotation
+	for i, v := range from {
+		message := v.Message
+		if message == "" {
+			message = v.Title
+		}
+		annotation := &insightAnnotation{
+			ExternalID: fmt.Sprintf("%s-%d", id, i+1),
+			Path:       v.Path,
+			Line:       v.StartLine,
+			Message:    message,
+			Severity:   "LOW",
+			Type:       "CODE_SMELL",
+		}
+		switch v.Level {
+		case scm.AnnotationLevelFailure:
+			annotation.Severity = "HIGH"
+			annotation.Type = "BUG"
+		case scm.AnnotationLevelWarning:
+			annotation.Severity = "MEDIUM"
+		}
+		to = append(to, annotation)
+	}
+	return to
+}
+
+func convertInsightResult(from string) scm.State {
+	switch from {
+	case "PASS":
+		return scm.StateSuccess
+	case "FAIL":
+		return scm.StateFailure
+	default:
+		return scm.StatePending
+	}
+}
+
+func convertFromInsightResult(from scm.State) string {
+	switch from {
+	case scm.StateSuccess:
+		return "PASS"
+	case scm.StatePending, scm.StateRunning, scm.StateUnknown:
+		return ""
+	default:
+		return "FAIL"
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestChecksCreateCheckRun(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/insights/1.0/projects/PRJ/repos/my-repo/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports/drone-lint").
+		BodyString(`{"title":"drone","details":"2 lint warnings","link":"https://ci.example.com/1000/output","result":"FAIL"}`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/report.json")
+
+	annotations, _ := ioutil.ReadFile("testdata/report_annotations.json")
+	gock.New("http://example.com:7990").
+		Post("/rest/insights/1.0/projects/PRJ/repos/my-repo/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports/drone-lint/annotations").
+		BodyString(string(annotations)).
+		Reply(204)
+
+	in := &scm.CheckRunInput{
+		Name:       "drone",
+		HeadSHA:    "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+		ExternalID: "drone-lint",
+		DetailsURL: "https://ci.example.com/1000/output",
+		State:      scm.StateFailure,
+		Output: &scm.CheckRunOutput{
+			Title:   "Lint",
+			Summary: "2 lint warnings",
+			Annotations: []*scm.CheckRunAnnotation{
+				{
+					Path:      "main.go",
+					StartLine: 12,
+					Level:     scm.AnnotationLevelWarning,
+					Message:   "exported function should have comment",
+				},
+				{
+					Path:      "main.go",
+					StartLine: 20,
+					Level:     scm.AnnotationLevelFailure,
+					Title:     "ineffectual assignment",
+					Message:   "ineffectual assignment to err",
+				},
+			},
+		},
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Checks.CreateCheckRun(context.Background(), "PRJ/my-repo", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.CheckRun)
+	raw, _ := ioutil.ReadFile("testdata/report.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestChecksListCheckRuns(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/insights/1.0/projects/PRJ/repos/my-repo/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9/reports").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reports.json")
+
+	client, _ := New("http://example.com:7990")
+	opts := scm.CheckListOptions{Page: 1, Size: 25}
+	got, _, err := client.Checks.ListCheckRuns(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CheckRun{}
+	raw, _ := ioutil.ReadFile("testdata/reports.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestChecksCheckSuites(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Checks.ListCheckSuites(context.Background(), "PRJ/my-repo", "master", scm.CheckListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
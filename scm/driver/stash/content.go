@@ -8,7 +8,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -29,6 +31,42 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+func (s *contentService) FindRaw(ctx context.Context, repo, path, ref string) (*scm.RawContent, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	namespace, name := scm.Split(repo)
+	// the raw endpoint does not return the file metadata, and
+	// the sha of the last commit that changed the file is
+	// requested first.
+	commitsEndpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits?until=%s&path=%s&limit=1", namespace, name, urlEncodedRef, url.QueryEscape(path))
+	commitsOut := new(commits)
+	res, err := s.client.do(ctx, "GET", commitsEndpoint, nil, commitsOut)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/raw/%s?at=%s", namespace, name, path, urlEncodedRef)
+	body, res, err := s.client.stream(ctx, "GET", endpoint)
+	if err != nil {
+		return nil, res, err
+	}
+	size, _ := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	to := &scm.RawContent{
+		Path: path,
+		Size: size,
+		Body: body,
+	}
+	if len(commitsOut.Values) != 0 {
+		to.Sha = commitsOut.Values[0].ID
+	}
+	return to, res, nil
+}
+
+func (s *contentService) Archive(ctx context.Context, repo, ref string, format scm.ArchiveFormat) (io.ReadCloser, *scm.Response, error) {
+	urlEncodedRef := url.QueryEscape(ref)
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/archive?at=%s&format=%s", namespace, name, urlEncodedRef, format)
+	return s.client.stream(ctx, "GET", endpoint)
+}
+
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	namespace, repoName := scm.Split(repo)
 	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, repoName, path)
@@ -56,6 +94,43 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 	return nil, scm.ErrNotSupported
 }
 
+func (s *contentService) Commit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	// bitbucket server commits a single file using the
+	// browse endpoint, and does not support committing
+	// multiple files, or deleting and moving files.
+	if len(input.Actions) != 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	action := input.Actions[0]
+	in := &contentCreateUpdate{
+		Message: input.Message,
+		Branch:  input.Branch,
+		Content: action.Data,
+	}
+	switch action.Action {
+	case scm.FileActionCreate:
+	case scm.FileActionUpdate:
+		// the update is rejected if the file was changed
+		// since the source commit, which defaults to the
+		// branch head.
+		in.Sha = input.Parent
+		if in.Sha == "" {
+			ref, res, err := s.client.Git.FindBranch(ctx, repo, input.Branch)
+			if err != nil {
+				return nil, res, err
+			}
+			in.Sha = ref.Sha
+		}
+	default:
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, repoName := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, repoName, action.Path)
+	out := new(commit)
+	res, err := s.client.do(ctx, "PUT", endpoint, in, out)
+	return convertCommit(out), res, err
+}
+
 func (s *contentService) List(ctx context.Context, repo, path, ref string, opts scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/files/%s?at=%s&%s", namespace, name, path, ref, encodeListOptions(opts))
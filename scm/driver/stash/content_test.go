@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
@@ -176,3 +177,137 @@ func TestContentList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestContentCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://localhost:7990").
+		Put("/rest/api/1.0/projects/octocat/repos/hello-world/browse/README").
+		SetMatcher(gock.NewMatcher()).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			form := req.MultipartForm
+			return cmp.Equal(form.Value["content"], []string{"my updated file contents"}) &&
+				cmp.Equal(form.Value["message"], []string{"WIP on feature 1"}) &&
+				cmp.Equal(form.Value["branch"], []string{"master"}) &&
+				cmp.Equal(form.Value["sourceCommitId"], []string{"abcdef0123abcdef4567abcdef8987abcdef6543"}), nil
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	input := &scm.CommitInput{
+		Branch:  "master",
+		Message: "WIP on feature 1",
+		Parent:  "abcdef0123abcdef4567abcdef8987abcdef6543",
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionUpdate, Path: "README", Data: []byte("my updated file contents")},
+		},
+	}
+
+	client := NewDefault()
+	got, _, err := client.Contents.Commit(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/content_update.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentCommit_Multiple(t *testing.T) {
+	input := &scm.CommitInput{
+		Branch: "master",
+		Actions: []*scm.CommitAction{
+			{Action: scm.FileActionCreate, Path: "README"},
+			{Action: scm.FileActionDelete, Path: "LICENSE"},
+		},
+	}
+	client := NewDefault()
+	_, _, err := client.Contents.Commit(context.Background(), "octocat/hello-world", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestContentFindRaw(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits").
+		MatchParam("until", "5c64a07cd6c0f21b753bf261ef059c7e7633c50a").
+		MatchParam("path", "README").
+		MatchParam("limit", "1").
+		Reply(200).
+		Type("application/json").
+		JSON(map[string]interface{}{
+			"values":     []map[string]string{{"id": "131cb13f4aed12e725177bc4b7c28db67839bf9f"}},
+			"isLastPage": false,
+		})
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/raw/README").
+		MatchParam("at", "5c64a07cd6c0f21b753bf261ef059c7e7633c50a").
+		Reply(200).
+		Type("text/plain").
+		SetHeader("Content-Length", "12").
+		BodyString("hello world\n")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Contents.FindRaw(context.Background(), "PRJ/my-repo", "README", "5c64a07cd6c0f21b753bf261ef059c7e7633c50a")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer got.Body.Close()
+
+	data, _ := ioutil.ReadAll(got.Body)
+	if want := "hello world\n"; string(data) != want {
+		t.Errorf("Want content %q, got %q", want, data)
+	}
+
+	got.Body = nil
+	want := &scm.RawContent{
+		Path: "README",
+		Size: 12,
+		Sha:  "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestContentArchive(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/archive").
+		MatchParam("at", "5c64a07cd6c0f21b753bf261ef059c7e7633c50a").
+		MatchParam("format", "zip").
+		Reply(200).
+		Type("application/octet-stream").
+		BodyString("archive")
+
+	client, _ := New("http://example.com:7990")
+	rc, _, err := client.Contents.Archive(context.Background(), "PRJ/my-repo", "5c64a07cd6c0f21b753bf261ef059c7e7633c50a", scm.ArchiveFormatZip)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	data, _ := ioutil.ReadAll(rc)
+	if want := "archive"; string(data) != want {
+		t.Errorf("Want archive %q, got %q", want, data)
+	}
+}
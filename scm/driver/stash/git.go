@@ -30,6 +30,15 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 
 }
 
+func (s *gitService) DeleteBranch(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-utils/1.0/projects/%s/repos/%s/branches", namespace, repoName)
+	in := &deleteBranchInput{
+		Name: scm.ExpandRef(name, "refs/heads"),
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
 func (s *gitService) FindBranch(ctx context.Context, repo, branch string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?filterText=%s", namespace, name, branch)
@@ -54,6 +63,25 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, params *scm.TagInput) (*scm.Response, error) {
+	// bitbucket server creates an annotated tag if a message
+	// is provided. The tagger is the authenticated user.
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags", namespace, repoName)
+	in := &createTag{
+		Name:       params.Name,
+		StartPoint: params.Sha,
+		Message:    params.Message,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *gitService) DeleteTag(ctx context.Context, repo, name string) (*scm.Response, error) {
+	namespace, repoName := scm.Split(repo)
+	path := fmt.Sprintf("rest/git/1.0/projects/%s/repos/%s/tags/%s", namespace, repoName, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?filterText=%s", namespace, name, tag)
@@ -177,11 +205,21 @@ type diffstat struct {
 	} `json:"properties"`
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo string, params *scm.ReferenceUpdateInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type createBranch struct {
 	Name       string `json:"name"`
 	StartPoint string `json:"startPoint"`
 }
 
+type createTag struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
 type commit struct {
 	ID        string `json:"id"`
 	DisplayID string `json:"displayId"`
@@ -281,3 +281,68 @@ func TestCreateBranch(t *testing.T) {
 		t.Errorf("The error response of branch creation is not 201")
 	}
 }
+
+func TestDeleteBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		BodyString(`{"name":"refs/heads/Hello","dryRun":false}`).
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Git.DeleteBranch(context.Background(), "PRJ/my-repo", "Hello")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/tags").
+		BodyString(`{"name":"v1.0.0","startPoint":"312797ba52425353dec56871a255e2a36fc96344","message":"release v1.0.0"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	params := &scm.TagInput{
+		Name:    "v1.0.0",
+		Sha:     "312797ba52425353dec56871a255e2a36fc96344",
+		Message: "release v1.0.0",
+	}
+	res, err := client.Git.CreateTag(context.Background(), "PRJ/my-repo", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Git.DeleteTag(context.Background(), "PRJ/my-repo", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 204 {
+		t.Errorf("Unexpected Results")
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// keyService implements the KeyService. Deploy keys are
+// managed as repository access keys, which requires the
+// ssh access keys plugin bundled with Bitbucket Server.
+type keyService struct {
+	client *wrapper
+}
+
+func (s *keyService) FindDeployKey(ctx context.Context, repo, id string) (*scm.Key, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	out := new(accessKey)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertAccessKey(out), res, err
+}
+
+func (s *keyService) ListDeployKeys(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh?%s", namespace, name, encodeListOptions(opts))
+	out := new(accessKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertAccessKeyList(out), res, err
+}
+
+func (s *keyService) CreateDeployKey(ctx context.Context, repo string, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh", namespace, name)
+	in := &accessKeyInput{
+		Permission: "REPO_WRITE",
+	}
+	in.Key.Text = input.Key
+	in.Key.Label = input.Title
+	if input.ReadOnly {
+		in.Permission = "REPO_READ"
+	}
+	out := new(accessKey)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAccessKey(out), res, err
+}
+
+func (s *keyService) DeleteDeployKey(ctx context.Context, repo, id string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/keys/1.0/projects/%s/repos/%s/ssh/%s", namespace, name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *keyService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("rest/ssh/1.0/keys?%s", encodeListOptions(opts))
+	out := new(keys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertKeyList(out), res, err
+}
+
+func (s *keyService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Text:  input.Key,
+		Label: input.Title,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "rest/ssh/1.0/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *keyService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("rest/gpg/1.0/keys?%s", encodeListOptions(opts))
+	out := new(gpgKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *keyService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{Text: input.Key}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "rest/gpg/1.0/keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+type key struct {
+	ID    int    `json:"id"`
+	Text  string `json:"text"`
+	Label string `json:"label"`
+}
+
+type keyInput struct {
+	Text  string `json:"text"`
+	Label string `json:"label,omitempty"`
+}
+
+type accessKeys struct {
+	pagination
+	Values []*accessKey `json:"values"`
+}
+
+type accessKey struct {
+	Key        key    `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type accessKeyInput struct {
+	Key        keyInput `json:"key"`
+	Permission string   `json:"permission"`
+}
+
+type gpgKeys struct {
+	pagination
+	Values []*gpgKey `json:"values"`
+}
+
+type gpgKey struct {
+	ID           string `json:"id"`
+	Fingerprint  string `json:"fingerprint"`
+	EmailAddress string `json:"emailAddress"`
+	Text         string `json:"text"`
+	ExpiryDate   int64  `json:"expiryDate"`
+}
+
+type gpgKeyInput struct {
+	Text string `json:"text"`
+}
+
+func convertKeyList(from *keys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:    strconv.Itoa(from.ID),
+		Title: from.Label,
+		Key:   from.Text,
+	}
+}
+
+func convertAccessKeyList(from *accessKeys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertAccessKey(v))
+	}
+	return to
+}
+
+func convertAccessKey(from *accessKey) *scm.Key {
+	to := convertKey(&from.Key)
+	to.ReadOnly = from.Permission == "REPO_READ"
+	return to
+}
+
+func convertGPGKeyList(from *gpgKeys) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from.Values {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	to := &scm.GPGKey{
+		ID:     from.ID,
+		KeyID:  from.Fingerprint,
+		Key:    from.Text,
+		Emails: []string{},
+	}
+	if from.EmailAddress != "" {
+		to.Emails = append(to.Emails, from.EmailAddress)
+	}
+	if from.ExpiryDate != 0 {
+		to.Expires = time.Unix(from.ExpiryDate/1000, 0)
+	}
+	return to
+}
@@ -0,0 +1,218 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestDeployKeyFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/access_key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.FindDeployKey(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/access_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/access_keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.ListDeployKeys(context.Background(), "PRJ/my-repo", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/access_keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh").
+		BodyString(`{"key":{"text":"ssh-rsa AAA...","label":"drone"},"permission":"REPO_READ"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/access_key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.CreateDeployKey(context.Background(), "PRJ/my-repo", &scm.KeyInput{Title: "drone", Key: "ssh-rsa AAA...", ReadOnly: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/access_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestDeployKeyDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/keys/1.0/projects/PRJ/repos/my-repo/ssh/1").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Keys.DeleteDeployKey(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/ssh/1.0/keys").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.ListKeys(context.Background(), scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/ssh/1.0/keys").
+		BodyString(`{"text":"ssh-rsa AAA...","label":"jcitizen@example.com"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.CreateKey(context.Background(), &scm.KeyInput{Title: "jcitizen@example.com", Key: "ssh-rsa AAA..."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/gpg/1.0/keys").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/gpg_keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.ListGPGKeys(context.Background(), scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := ioutil.ReadFile("testdata/gpg_keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGPGKeyCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/gpg/1.0/keys").
+		BodyString(`{"text":"-----BEGIN PGP PUBLIC KEY BLOCK-----"}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/gpg_key.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Keys.CreateGPGKey(context.Background(), &scm.GPGKeyInput{Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.GPGKey)
+	raw, _ := ioutil.ReadFile("testdata/gpg_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
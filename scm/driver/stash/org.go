@@ -6,6 +6,8 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -29,3 +31,131 @@ func (s *organizationService) ListMemberships(ctx context.Context, orgNameList [
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+// ListTeams returns the user groups. Bitbucket Server
+// groups are global, and the organization is ignored.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?%s", encodeListOptions(opts))
+	out := new(groups)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) FindTeam(ctx context.Context, name, team string) (*scm.Team, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("filter", team)
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?%s", params.Encode())
+	out := new(groups)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the filter matches groups that contain the name,
+	// and the results are searched for an exact match.
+	for _, v := range out.Values {
+		if v.Name == team {
+			return convertTeam(v), res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, name string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("name", input.Name)
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?%s", params.Encode())
+	out := new(group)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertTeam(out), res, err
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, name, team string, input *scm.TeamInput) (*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, name, team string) (*scm.Response, error) {
+	params := url.Values{}
+	params.Set("name", team)
+	path := fmt.Sprintf("rest/api/1.0/admin/groups?%s", params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.User, *scm.Response, error) {
+	params, _ := url.ParseQuery(encodeListOptions(opts))
+	params.Set("context", team)
+	path := fmt.Sprintf("rest/api/1.0/admin/groups/more-members?%s", params.Encode())
+	out := new(users)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertUserList(out), res, err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	in := &groupMemberInput{
+		Context:  team,
+		ItemName: username,
+	}
+	return s.client.do(ctx, "POST", "rest/api/1.0/admin/groups/add-user", in, nil)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, name, team, username string) (*scm.Response, error) {
+	in := &groupMemberInput{
+		Context:  team,
+		ItemName: username,
+	}
+	return s.client.do(ctx, "POST", "rest/api/1.0/admin/groups/remove-user", in, nil)
+}
+
+func (s *organizationService) AddTeamRepo(ctx context.Context, name, team, repo string, perm scm.Permission) (*scm.Response, error) {
+	namespace, slug := scm.Split(repo)
+	params := url.Values{}
+	params.Set("permission", convertFromPermission(perm))
+	params.Set("name", team)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/groups?%s", namespace, slug, params.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+func (s *organizationService) RemoveTeamRepo(ctx context.Context, name, team, repo string) (*scm.Response, error) {
+	namespace, slug := scm.Split(repo)
+	params := url.Values{}
+	params.Set("name", team)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/groups?%s", namespace, slug, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type groups struct {
+	pagination
+	Values []*group `json:"values"`
+}
+
+type group struct {
+	Name string `json:"name"`
+}
+
+type groupMemberInput struct {
+	Context  string `json:"context"`
+	ItemName string `json:"itemName"`
+}
+
+func convertTeamList(from *groups) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from.Values {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+// bitbucket server groups are identified by name.
+func convertTeam(from *group) *scm.Team {
+	return &scm.Team{
+		Name: from.Name,
+		Slug: from.Name,
+	}
+}
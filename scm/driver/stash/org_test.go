@@ -6,9 +6,13 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestOrganizationFind(t *testing.T) {
@@ -34,3 +38,226 @@ func TestOrganizationList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestOrganizationListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/admin/groups").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/groups.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "PRJ", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/groups.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationFindTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/admin/groups").
+		MatchParam("filter", "developers").
+		Reply(200).
+		Type("application/json").
+		File("testdata/groups.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.FindTeam(context.Background(), "PRJ", "developers")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/group.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationCreateTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups").
+		MatchParam("name", "developers").
+		Reply(200).
+		Type("application/json").
+		File("testdata/group.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.CreateTeam(context.Background(), "PRJ", &scm.TeamInput{Name: "developers"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Team)
+	raw, _ := ioutil.ReadFile("testdata/group.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationUpdateTeam(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Organizations.UpdateTeam(context.Background(), "PRJ", "developers", &scm.TeamInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationDeleteTeam(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/admin/groups").
+		MatchParam("name", "developers").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.DeleteTeam(context.Background(), "PRJ", "developers")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/admin/groups/more-members").
+		MatchParam("context", "developers").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "PRJ", "developers", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.User{}
+	raw, _ := ioutil.ReadFile("testdata/members.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationAddTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups/add-user").
+		BodyString(`{"context":"developers","itemName":"jcitizen"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.AddTeamMember(context.Background(), "PRJ", "developers", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationRemoveTeamMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/admin/groups/remove-user").
+		BodyString(`{"context":"developers","itemName":"jcitizen"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.RemoveTeamMember(context.Background(), "PRJ", "developers", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationAddTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/groups").
+		MatchParam("permission", "REPO_WRITE").
+		MatchParam("name", "developers").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.AddTeamRepo(context.Background(), "PRJ", "developers", "PRJ/my-repo", scm.PermissionWrite)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestOrganizationRemoveTeamRepo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/groups").
+		MatchParam("name", "developers").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.RemoveTeamRepo(context.Background(), "PRJ", "developers", "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -6,7 +6,10 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -84,6 +87,52 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) MergeWithOptions(ctx context.Context, repo string, number int, opts scm.PullRequestMergeOptions) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	// the pull request version is required to merge, and
+	// is used to verify the head of the pull request.
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	if opts.SHA != "" && opts.SHA != out.FromRef.LatestCommit {
+		return res, scm.ErrConflict
+	}
+	in := &mergeInput{
+		Message: opts.CommitTitle,
+	}
+	if opts.CommitMessage != "" {
+		if in.Message != "" {
+			in.Message += "\n\n"
+		}
+		in.Message += opts.CommitMessage
+	}
+	switch opts.Method {
+	case scm.MergeMethodMerge:
+		in.StrategyID = "no-ff"
+	case scm.MergeMethodSquash:
+		in.StrategyID = "squash"
+	case scm.MergeMethodRebase:
+		in.StrategyID = "rebase-ff-only"
+	case scm.MergeMethodFastForward:
+		in.StrategyID = "ff-only"
+	}
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/merge?version=%d", namespace, name, number, out.Version)
+	res, err = s.client.do(ctx, "POST", path, in, nil)
+	if err != nil {
+		return res, convertMergeError(opts.Method, err)
+	}
+	// the source branch cannot be deleted if the pull
+	// request was opened from a fork.
+	if !opts.DeleteSourceBranch || out.FromRef.Repository.ID != out.ToRef.Repository.ID {
+		return res, nil
+	}
+	path = fmt.Sprintf("rest/branch-utils/1.0/projects/%s/repos/%s/branches", namespace, name)
+	return s.client.do(ctx, "DELETE", path, &deleteBranchInput{Name: out.FromRef.ID}, nil)
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/decline", namespace, name, number)
@@ -91,6 +140,114 @@ func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	// the pull request version is required to reopen.
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/reopen?version=%d", namespace, name, number, out.Version)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *pullService) MarkReady(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := false
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) ConvertToDraft(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	draft := true
+	_, res, err := s.Update(ctx, repo, number, &scm.PullRequestUpdateInput{Draft: &draft})
+	return res, err
+}
+
+func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestUpdateInput) (*scm.PullRequest, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	// the pull request version is required to update, and
+	// fields that are not updated are set to their current
+	// values.
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	current := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, current)
+	if err != nil {
+		return nil, res, err
+	}
+	in := &prUpdateInput{
+		Version:     current.Version,
+		Title:       current.Title,
+		Description: current.Description,
+		Draft:       input.Draft,
+	}
+	in.ToRef.ID = current.ToRef.ID
+	if input.Title != "" {
+		in.Title = input.Title
+	}
+	if input.Body != "" {
+		in.Description = input.Body
+	}
+	if input.Target != "" {
+		in.ToRef.ID = scm.ExpandRef(input.Target, "refs/heads")
+	}
+	out := new(pr)
+	res, err = s.client.do(ctx, "PUT", path, in, out)
+	return convertPullRequest(out), res, err
+}
+
+func (s *pullService) RequestReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	// bitbucket does not support team reviewers.
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	var res *scm.Response
+	var err error
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants", namespace, name, number)
+	for _, v := range input.Users {
+		in := new(reviewerInput)
+		in.User.Name = v
+		in.Role = "REVIEWER"
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) RemoveReviewers(ctx context.Context, repo string, number int, input *scm.ReviewerInput) (*scm.Response, error) {
+	if len(input.Teams) != 0 {
+		return nil, scm.ErrNotSupported
+	}
+	var res *scm.Response
+	var err error
+	namespace, name := scm.Split(repo)
+	for _, v := range input.Users {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, v)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]scm.Reviewer, *scm.Response, error) {
+	pr, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return pr.Reviewers, res, nil
+}
+
+func (s *pullService) SetAssignees(context.Context, string, int, []string) (*scm.Response, error) {
+	// bitbucket does not support pull request assignees.
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", namespace, name)
@@ -126,6 +283,16 @@ func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Res
 	return nil, scm.ErrNotSupported
 }
 
+type mergeInput struct {
+	Message    string `json:"message,omitempty"`
+	StrategyID string `json:"strategyId,omitempty"`
+}
+
+type deleteBranchInput struct {
+	Name   string `json:"name"`
+	DryRun bool   `json:"dryRun"`
+}
+
 type pr struct {
 	ID          int    `json:"id"`
 	Version     int    `json:"version"`
@@ -134,6 +301,7 @@ type pr struct {
 	State       string `json:"state"`
 	Open        bool   `json:"open"`
 	Closed      bool   `json:"closed"`
+	Draft       bool   `json:"draft"`
 	CreatedDate int64  `json:"createdDate"`
 	UpdatedDate int64  `json:"updatedDate"`
 	FromRef     struct {
@@ -168,8 +336,8 @@ type pr struct {
 		Approved bool   `json:"approved"`
 		Status   string `json:"status"`
 	} `json:"author"`
-	Reviewers    []interface{} `json:"reviewers"`
-	Participants []interface{} `json:"participants"`
+	Reviewers    []*participant `json:"reviewers"`
+	Participants []interface{}  `json:"participants"`
 	Links        struct {
 		Self []link `json:"self"`
 	} `json:"links"`
@@ -181,11 +349,28 @@ type pr struct {
 	} `json:"properties"`
 }
 
+type reviewerInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Role string `json:"role"`
+}
+
 type prs struct {
 	pagination
 	Values []*pr `json:"values"`
 }
 
+type prUpdateInput struct {
+	Version     int    `json:"version"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Draft       *bool  `json:"draft,omitempty"`
+	ToRef       struct {
+		ID string `json:"id"`
+	} `json:"toRef"`
+}
+
 type prInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
@@ -222,7 +407,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		from.FromRef.Repository.Project.Key,
 		from.FromRef.Repository.Slug,
 	)
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number:  from.ID,
 		Title:   from.Title,
 		Body:    from.Description,
@@ -233,6 +418,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Target:  from.ToRef.DisplayID,
 		Fork:    fork,
 		Link:    extractSelfLink(from.Links.Self),
+		Draft:   from.Draft,
 		Closed:  from.Closed,
 		Merged:  from.State == "MERGED",
 		Created: time.Unix(from.CreatedDate/1000, 0),
@@ -254,6 +440,24 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Avatar: avatarLink(from.Author.User.EmailAddress),
 		},
 	}
+	for _, v := range from.Reviewers {
+		to.Reviewers = append(to.Reviewers, convertReviewer(v))
+	}
+	return to
+}
+
+// convertReviewer returns the reviewer from the pull request
+// participant. Reviewers that have not approved or marked
+// the pull request as needs work are pending.
+func convertReviewer(from *participant) scm.Reviewer {
+	state := convertReviewState(from.Status)
+	if state == scm.ReviewStateUnknown {
+		state = scm.ReviewStatePending
+	}
+	return scm.Reviewer{
+		User:  *convertUser(&from.User),
+		State: state,
+	}
 }
 
 type pullRequestComment struct {
@@ -288,7 +492,27 @@ type pullRequestComment struct {
 }
 
 type pullRequestCommentInput struct {
-	Text string `json:"text"`
+	Text   string                    `json:"text"`
+	Parent *pullRequestCommentParent `json:"parent,omitempty"`
+	Anchor *pullRequestCommentAnchor `json:"anchor,omitempty"`
+}
+
+type pullRequestCommentParent struct {
+	ID int `json:"id"`
+}
+
+type pullRequestCommentAnchor struct {
+	Path            string                       `json:"path"`
+	Line            int                          `json:"line,omitempty"`
+	LineType        string                       `json:"lineType,omitempty"`
+	FileType        string                       `json:"fileType,omitempty"`
+	DiffType        string                       `json:"diffType,omitempty"`
+	MultilineMarker *pullRequestCommentMultiline `json:"multilineMarker,omitempty"`
+}
+
+type pullRequestCommentMultiline struct {
+	StartLine     int    `json:"startLine"`
+	StartLineType string `json:"startLineType"`
 }
 
 func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
@@ -305,3 +529,16 @@ func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
 		},
 	}
 }
+
+// convertMergeError returns a MergeMethodError if the merge
+// failed because the merge strategy is not enabled for the
+// repository.
+func convertMergeError(method scm.MergeMethod, err error) error {
+	apiErr := new(scm.APIError)
+	if errors.As(err, &apiErr) &&
+		(apiErr.Status == http.StatusBadRequest || apiErr.Status == http.StatusConflict) &&
+		strings.Contains(strings.ToLower(apiErr.Message), "strategy") {
+		return &scm.MergeMethodError{Method: method, Err: err}
+	}
+	return err
+}
@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -134,6 +135,74 @@ func TestPullMerge(t *testing.T) {
 	}
 }
 
+func TestPullMergeWithOptions(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		MatchParam("version", "0").
+		JSON(map[string]string{
+			"message":    "Merge feature/x\n\nSquashed",
+			"strategyId": "squash",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]interface{}{"name": "refs/heads/feature/x", "dryRun": false}).
+		Reply(204)
+
+	opts := scm.PullRequestMergeOptions{
+		Method:             scm.MergeMethodSquash,
+		CommitTitle:        "Merge feature/x",
+		CommitMessage:      "Squashed",
+		SHA:                "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		DeleteSourceBranch: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "PRJ/my-repo", 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect source branch deleted")
+	}
+}
+
+func TestPullMergeWithOptions_NotAllowed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/merge").
+		Reply(400).
+		Type("application/json").
+		BodyString(`{"errors":[{"context":null,"message":"The merge strategy \"ff-only\" is not enabled.","exceptionName":"com.atlassian.bitbucket.pull.InvalidMergeStrategyException"}]}`)
+
+	opts := scm.PullRequestMergeOptions{Method: scm.MergeMethodFastForward}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.MergeWithOptions(context.Background(), "PRJ/my-repo", 1, opts)
+	merr := new(scm.MergeMethodError)
+	if !errors.As(err, &merr) {
+		t.Errorf("Want MergeMethodError, got %v", err)
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -150,6 +219,131 @@ func TestPullClose(t *testing.T) {
 	}
 }
 
+func TestPullReopen(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/reopen").
+		MatchParam("version", "0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.Reopen(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect pull request reopened")
+	}
+}
+
+func TestPullUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		JSON(map[string]interface{}{
+			"version":     0,
+			"title":       "Updated Files",
+			"description": "* added LICENSE\r\n* update files\r\n* update files",
+			"draft":       true,
+			"toRef":       map[string]string{"id": "refs/heads/develop"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	draft := true
+	input := scm.PullRequestUpdateInput{
+		Target: "develop",
+		Draft:  &draft,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.Update(context.Background(), "PRJ/my-repo", 1, &input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants").
+		JSON(map[string]interface{}{
+			"user": map[string]string{"name": "jsmith"},
+			"role": "REVIEWER",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.RequestReviewers(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewer added")
+	}
+}
+
+func TestPullRemoveReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jsmith").
+		Reply(204)
+
+	input := &scm.ReviewerInput{
+		Users: []string{"jsmith"},
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.RemoveReviewers(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reviewer removed")
+	}
+}
+
+func TestPullSetAssignees(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.SetAssignees(context.Background(), "PRJ/my-repo", 1, []string{"jsmith"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullCreate(t *testing.T) {
 	defer gock.Off()
 
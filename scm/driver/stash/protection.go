@@ -0,0 +1,234 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// protectionService implements the BranchProtectionService
+// using Bitbucket Server ref restrictions. The protection
+// rules for a branch are a set of restrictions, one for each
+// type, so an update saves the restriction of each type in
+// place, and removes the restrictions that are no longer
+// required once the others are saved. A branch name that
+// contains a wildcard is treated as a pattern. Review and
+// status check requirements are configured using merge
+// checks, and are not supported, with the exception that
+// requiring reviews prevents changes without a pull request.
+type protectionService struct {
+	client *wrapper
+}
+
+type refRestrictions struct {
+	pagination
+	Values []*refRestriction `json:"values"`
+}
+
+type refRestriction struct {
+	ID      int        `json:"id,omitempty"`
+	Type    string     `json:"type"`
+	Matcher refMatcher `json:"matcher"`
+	Users   []*user    `json:"users,omitempty"`
+	Groups  []string   `json:"groups,omitempty"`
+}
+
+type refRestrictionInput struct {
+	Type    string     `json:"type"`
+	Matcher refMatcher `json:"matcher"`
+	Users   []string   `json:"users,omitempty"`
+	Groups  []string   `json:"groups,omitempty"`
+}
+
+type refMatcher struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId"`
+	Type      struct {
+		ID string `json:"id"`
+	} `json:"type"`
+}
+
+func (s *protectionService) Find(ctx context.Context, repo, branch string) (*scm.BranchProtection, *scm.Response, error) {
+	out, res, err := s.list(ctx, repo, branch)
+	return convertRefRestrictions(branch, out), res, err
+}
+
+func (s *protectionService) Update(ctx context.Context, repo, branch string, input *scm.BranchProtectionInput) (*scm.BranchProtection, *scm.Response, error) {
+	existing, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return nil, res, err
+	}
+	current := map[string]*refRestriction{}
+	for _, v := range existing {
+		if _, ok := current[v.Type]; !ok {
+			current[v.Type] = v
+		}
+	}
+
+	// undo holds the requests that revert the changes made
+	// so far, in the order they were made.
+	var undo []func()
+	revert := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions", namespace, name)
+	kept := map[int]bool{}
+	out := []*refRestriction{}
+	for _, in := range convertFromBranchProtection(branch, input) {
+		saved := new(refRestriction)
+		if prev, ok := current[in.Type]; ok {
+			delete(current, in.Type)
+			kept[prev.ID] = true
+			res, err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", path, prev.ID), in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", path, prev.ID), convertToRefRestrictionInput(prev), nil)
+			})
+		} else {
+			res, err = s.client.do(ctx, "POST", path, in, saved)
+			if err != nil {
+				revert()
+				return nil, res, err
+			}
+			undo = append(undo, func() {
+				s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, saved.ID), nil, nil)
+			})
+		}
+		out = append(out, saved)
+	}
+
+	// the remaining restrictions are deleted once the new
+	// restrictions are saved, so that the branch is never
+	// unprotected.
+	for _, v := range existing {
+		if kept[v.ID] {
+			continue
+		}
+		prev := v
+		res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, prev.ID), nil, nil)
+		if err != nil {
+			revert()
+			return nil, res, err
+		}
+		undo = append(undo, func() {
+			s.client.do(ctx, "POST", path, convertToRefRestrictionInput(prev), nil)
+		})
+	}
+	return convertRefRestrictions(branch, out), res, nil
+}
+
+func (s *protectionService) Delete(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	out, res, err := s.list(ctx, repo, branch)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	for _, v := range out {
+		path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions/%d", namespace, name, v.ID)
+		res, err = s.client.do(ctx, "DELETE", path, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// list returns the ref restrictions for the branch.
+func (s *protectionService) list(ctx context.Context, repo, branch string) ([]*refRestriction, *scm.Response, error) {
+	matcher := convertRefMatcher(branch)
+	params := url.Values{}
+	params.Set("matcherType", matcher.Type.ID)
+	params.Set("matcherId", matcher.ID)
+	params.Set("limit", "100")
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-permissions/2.0/projects/%s/repos/%s/restrictions?%s", namespace, name, params.Encode())
+	out := new(refRestrictions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out.Values, res, err
+}
+
+func convertRefMatcher(branch string) refMatcher {
+	to := refMatcher{ID: branch, DisplayID: branch}
+	to.Type.ID = "PATTERN"
+	if !strings.Contains(branch, "*") {
+		to.ID = scm.ExpandRef(branch, "refs/heads")
+		to.Type.ID = "BRANCH"
+	}
+	return to
+}
+
+func convertRefRestrictions(branch string, from []*refRestriction) *scm.BranchProtection {
+	to := &scm.BranchProtection{
+		Branch:         branch,
+		AllowForcePush: true,
+		AllowDeletion:  true,
+	}
+	for _, v := range from {
+		switch v.Type {
+		case "read-only":
+			to.Push = new(scm.BranchRestrictions)
+			for _, user := range v.Users {
+				to.Push.Users = append(to.Push.Users, user.Name)
+			}
+			to.Push.Teams = v.Groups
+		case "fast-forward-only":
+			to.AllowForcePush = false
+		case "no-deletes":
+			to.AllowDeletion = false
+		case "pull-request-only":
+			to.RequiredReviews = new(scm.RequiredReviews)
+		}
+	}
+	return to
+}
+
+func convertFromBranchProtection(branch string, from *scm.BranchProtectionInput) []*refRestrictionInput {
+	var to []*refRestrictionInput
+	matcher := convertRefMatcher(branch)
+	if v := from.Push; v != nil {
+		to = append(to, &refRestrictionInput{
+			Type:    "read-only",
+			Matcher: matcher,
+			Users:   v.Users,
+			Groups:  v.Teams,
+		})
+	}
+	if !from.AllowForcePush {
+		to = append(to, &refRestrictionInput{Type: "fast-forward-only", Matcher: matcher})
+	}
+	if !from.AllowDeletion {
+		to = append(to, &refRestrictionInput{Type: "no-deletes", Matcher: matcher})
+	}
+	if from.RequiredReviews != nil {
+		to = append(to, &refRestrictionInput{Type: "pull-request-only", Matcher: matcher})
+	}
+	return to
+}
+
+// convertToRefRestrictionInput returns the input that saves
+// the existing restriction again.
+func convertToRefRestrictionInput(from *refRestriction) *refRestrictionInput {
+	to := &refRestrictionInput{
+		Type:    from.Type,
+		Matcher: from.Matcher,
+		Groups:  from.Groups,
+	}
+	for _, user := range from.Users {
+		to.Users = append(to.Users, user.Name)
+	}
+	return to
+}
@@ -0,0 +1,225 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestProtectionFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		MatchParam("matcherType", "BRANCH").
+		MatchParam("matcherId", "refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/restrictions.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.BranchProtections.Find(context.Background(), "PRJ/my-repo", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.BranchProtection)
+	raw, _ := ioutil.ReadFile("testdata/restrictions.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestProtectionUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		MatchParam("matcherType", "PATTERN").
+		MatchParam("matcherId", "release/*").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"values":[],"isLastPage":true}`)
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		JSON(map[string]interface{}{
+			"type": "no-deletes",
+			"matcher": map[string]interface{}{
+				"id":        "release/*",
+				"displayId": "release/*",
+				"type":      map[string]string{"id": "PATTERN"},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":3,"type":"no-deletes","matcher":{"id":"release/*","displayId":"release/*","type":{"id":"PATTERN"}}}`)
+
+	input := &scm.BranchProtectionInput{
+		AllowForcePush: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.BranchProtections.Update(context.Background(), "PRJ/my-repo", "release/*", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{
+		Branch:         "release/*",
+		AllowForcePush: true,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect ref restrictions created")
+	}
+}
+
+func TestProtectionUpdate_Replace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		MatchParam("matcherType", "BRANCH").
+		MatchParam("matcherId", "refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Put("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		BodyString(`{"type":"read-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}},"users":["jcitizen"]}`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":1,"type":"read-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}},"users":[{"name":"jcitizen"}]}`)
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		BodyString(`{"type":"fast-forward-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}}}`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":3,"type":"fast-forward-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}}}`)
+
+	// the no-deletes restriction is no longer required, and is
+	// deleted after the other restrictions are saved.
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/2").
+		Reply(204)
+
+	input := &scm.BranchProtectionInput{
+		Push:          &scm.BranchRestrictions{Users: []string{"jcitizen"}},
+		AllowDeletion: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.BranchProtections.Update(context.Background(), "PRJ/my-repo", "master", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.BranchProtection{
+		Branch:        "master",
+		Push:          &scm.BranchRestrictions{Users: []string{"jcitizen"}},
+		AllowDeletion: true,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect ref restrictions updated")
+	}
+}
+
+func TestProtectionUpdate_Revert(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		MatchParam("matcherType", "BRANCH").
+		MatchParam("matcherId", "refs/heads/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Put("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		BodyString(`{"type":"read-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}},"users":["jcitizen"]}`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":1,"type":"read-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}},"users":[{"name":"jcitizen"}]}`)
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(500).
+		Type("application/json").
+		BodyString(`{"errors":[{"message":"internal error"}]}`)
+
+	// the read-only restriction is restored, and the
+	// no-deletes restriction is never deleted.
+	gock.New("http://example.com:7990").
+		Put("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		BodyString(`{"type":"read-only","matcher":{"id":"refs/heads/master","displayId":"master","type":{"id":"BRANCH"}},"users":["jcitizen"],"groups":["release-managers"]}`).
+		Reply(200)
+
+	input := &scm.BranchProtectionInput{
+		Push:          &scm.BranchRestrictions{Users: []string{"jcitizen"}},
+		AllowDeletion: true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.BranchProtections.Update(context.Background(), "PRJ/my-repo", "master", input)
+	if err == nil {
+		t.Errorf("Expect error when the restriction is not created")
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect ref restrictions restored")
+	}
+}
+
+func TestProtectionDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/restrictions.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/1").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/branch-permissions/2.0/projects/PRJ/repos/my-repo/restrictions/2").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.BranchProtections.Delete(context.Background(), "PRJ/my-repo", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect ref restrictions deleted")
+	}
+}
@@ -39,6 +39,27 @@ type repository struct {
 	} `json:"links"`
 }
 
+type repositoryInput struct {
+	Name          string `json:"name"`
+	ScmID         string `json:"scmId"`
+	Description   string `json:"description,omitempty"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+	Public        bool   `json:"public"`
+}
+
+type repositoryUpdateInput struct {
+	Name          string        `json:"name,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	DefaultBranch string        `json:"defaultBranch,omitempty"`
+	Public        *bool         `json:"public,omitempty"`
+	Archived      *bool         `json:"archived,omitempty"`
+	Project       *projectInput `json:"project,omitempty"`
+}
+
+type projectInput struct {
+	Key string `json:"key"`
+}
+
 type repositories struct {
 	pagination
 	Values []*repository `json:"values"`
@@ -309,6 +330,145 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Create creates a new repository.
+func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
+	if input.Template != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// the repository is created in the personal project of
+	// the authenticated user if the project is not provided.
+	namespace := input.Namespace
+	if namespace == "" {
+		user, res, err := s.client.Users.Find(ctx)
+		if err != nil {
+			return nil, res, err
+		}
+		namespace = "~" + user.Login
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos", namespace)
+	in := &repositoryInput{
+		Name:          input.Name,
+		ScmID:         "git",
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		Public:        input.Visibility == scm.VisibilityPublic,
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil || !input.AutoInit {
+		return convertRepository(out), res, err
+	}
+
+	// bitbucket server creates empty repositories, so a
+	// readme is committed to create the default branch.
+	repo := scm.Join(out.Project.Key, out.Slug)
+	branch := input.Branch
+	if branch == "" {
+		branch = "master"
+	}
+	params := &scm.ContentParams{
+		Branch:  branch,
+		Message: "Initial commit",
+		Data:    []byte("# " + input.Name + "\n"),
+	}
+	res, err = s.client.Contents.Create(ctx, repo, "README.md", params)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertRepository(out)
+	to.Branch = branch
+	return to, res, nil
+}
+
+// Fork forks a repository. The fork is created in the
+// personal project of the authenticated user if the
+// project is not provided.
+func (s *repositoryService) Fork(ctx context.Context, repo string, input *scm.ForkInput) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	in := &repositoryUpdateInput{Name: input.Name}
+	if input.Namespace != "" {
+		in.Project = &projectInput{Key: input.Namespace}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Update updates a repository.
+func (s *repositoryService) Update(ctx context.Context, repo string, input *scm.RepositoryUpdateInput) (*scm.Repository, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	in := &repositoryUpdateInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		DefaultBranch: input.Branch,
+		Archived:      input.Archived,
+	}
+	if input.Visibility != scm.VisibilityUndefined {
+		public := input.Visibility == scm.VisibilityPublic
+		in.Public = &public
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// Delete deletes a repository.
+func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", namespace, name)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// Transfer moves a repository to a project.
+func (s *repositoryService) Transfer(ctx context.Context, repo, namespace string) (*scm.Repository, *scm.Response, error) {
+	repoNamespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", repoNamespace, name)
+	in := &repositoryUpdateInput{
+		Project: &projectInput{Key: namespace},
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out), res, err
+}
+
+// ListCollaborators returns the users with explicit
+// permission to the repository. Permissions granted on the
+// project are not included.
+func (s *repositoryService) ListCollaborators(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Collaborator, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, encodeListOptions(opts))
+	out := new(collaborators)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertCollaboratorList(out), res, err
+}
+
+// AddCollaborator grants a user permission to the
+// repository.
+func (s *repositoryService) AddCollaborator(ctx context.Context, repo, username string, perm scm.Permission) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("permission", convertFromPermission(perm))
+	params.Set("name", username)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// RemoveCollaborator revokes a user permission to the
+// repository.
+func (s *repositoryService) RemoveCollaborator(ctx context.Context, repo, username string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("name", username)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/permissions/users?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -435,3 +595,48 @@ func convertState(from string) scm.State {
 		return scm.StateUnknown
 	}
 }
+
+type collaborators struct {
+	pagination
+	Values []*collaborator `json:"values"`
+}
+
+type collaborator struct {
+	User       user   `json:"user"`
+	Permission string `json:"permission"`
+}
+
+func convertCollaboratorList(from *collaborators) []*scm.Collaborator {
+	to := []*scm.Collaborator{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Collaborator{
+			User:       *convertUser(&v.User),
+			Permission: convertPermission(v.Permission),
+		})
+	}
+	return to
+}
+
+func convertPermission(from string) scm.Permission {
+	switch from {
+	case "REPO_ADMIN":
+		return scm.PermissionAdmin
+	case "REPO_WRITE":
+		return scm.PermissionWrite
+	case "REPO_READ":
+		return scm.PermissionRead
+	default:
+		return scm.PermissionUndefined
+	}
+}
+
+func convertFromPermission(from scm.Permission) string {
+	switch from {
+	case scm.PermissionAdmin:
+		return "REPO_ADMIN"
+	case scm.PermissionWrite:
+		return "REPO_WRITE"
+	default:
+		return "REPO_READ"
+	}
+}
@@ -532,6 +532,223 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos").
+		BodyString(`{"name":"my-repo","scmId":"git","defaultBranch":"main","public":false}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README.md").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	input := &scm.RepositoryInput{
+		Namespace:  "PRJ",
+		Name:       "my-repo",
+		Visibility: scm.VisibilityPrivate,
+		Branch:     "main",
+		AutoInit:   true,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Create(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+	want.Branch = "main"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestRepositoryFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/DEV/repos/my-repo").
+		BodyString(`{"project":{"key":"PRJ"}}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Fork(context.Background(), "DEV/my-repo", &scm.ForkInput{Namespace: "PRJ"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+	want.Branch = "master"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		BodyString(`{"description":"My repo","defaultBranch":"master","public":false,"archived":true}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	archived := true
+	input := &scm.RepositoryUpdateInput{
+		Description: "My repo",
+		Branch:      "master",
+		Visibility:  scm.VisibilityPrivate,
+		Archived:    &archived,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Update(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	_ = json.Unmarshal(raw, &want)
+	want.Branch = "master"
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		Reply(202).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.Delete(context.Background(), "PRJ/my-repo")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryTransfer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/DEV/repos/my-repo").
+		BodyString(`{"project":{"key":"PRJ"}}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.Transfer(context.Background(), "DEV/my-repo", "PRJ")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.Namespace != "PRJ" {
+		t.Errorf("Want repository namespace PRJ, got %s", got.Namespace)
+	}
+}
+
+func TestRepositoryListCollaborators(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collaborators.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListCollaborators(context.Background(), "PRJ/my-repo", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Collaborator{}
+	raw, _ := ioutil.ReadFile("testdata/collaborators.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryAddCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("permission", "REPO_ADMIN").
+		MatchParam("name", "jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.AddCollaborator(context.Background(), "PRJ/my-repo", "jcitizen", scm.PermissionAdmin)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestRepositoryRemoveCollaborator(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo/permissions/users").
+		MatchParam("name", "jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.RemoveCollaborator(context.Background(), "PRJ/my-repo", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
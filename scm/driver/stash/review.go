@@ -6,6 +6,9 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -23,9 +26,309 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := convertReviewCommentInput(input)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out, out.Anchor), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+func (s *reviewService) ListReviews(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.PullRequestReview, *scm.Response, error) {
+	// reviews are represented by the pull request
+	// reviewers and participants that approved or
+	// marked the pull request as needs work.
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(prParticipants)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	participants := append(out.Reviewers, out.Participants...)
+	return convertParticipantList(participants), res, err
+}
+
+func (s *reviewService) Submit(ctx context.Context, repo string, number int, input *scm.PullRequestReviewInput) (*scm.PullRequestReview, *scm.Response, error) {
+	var res *scm.Response
+	var err error
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	for _, v := range input.Comments {
+		in := convertReviewCommentInput(v)
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	if input.Body != "" {
+		in := &pullRequestCommentInput{Text: input.Body}
+		res, err = s.client.do(ctx, "POST", path, in, nil)
+		if err != nil {
+			return nil, res, err
+		}
+	}
+	in := new(participantInput)
+	switch input.State {
+	case scm.ReviewStateApproved:
+		in.Status = "APPROVED"
+	case scm.ReviewStateChangesRequested:
+		in.Status = "NEEDS_WORK"
+	default:
+		return &scm.PullRequestReview{
+			Body:  input.Body,
+			State: input.State,
+			Sha:   input.Sha,
+		}, res, nil
+	}
+	// the review status is set on the participant entry
+	// of the authenticated user.
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, user.Login)
+	out := new(participant)
+	res, err = s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertParticipant(out)
+	to.Body = input.Body
+	if input.Sha != "" {
+		to.Sha = input.Sha
+	}
+	return to, res, nil
+}
+
+func (s *reviewService) Dismiss(ctx context.Context, repo string, number, id int, message string) (*scm.PullRequestReview, *scm.Response, error) {
+	// bitbucket only permits users to change their own
+	// review status.
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reviewService) ListThreads(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.ReviewThread, *scm.Response, error) {
+	// threads are represented by the comments added to the
+	// diff, which are returned with their replies in the
+	// pull request activity.
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	err = copyPagination(out.pagination, res)
+	return convertActivityList(out.Values), res, err
+}
+
+func (s *reviewService) Reply(ctx context.Context, repo string, number int, thread string, input *scm.CommentInput) (*scm.Review, *scm.Response, error) {
+	id, err := strconv.Atoi(thread)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := &pullRequestCommentInput{
+		Text:   input.Body,
+		Parent: &pullRequestCommentParent{ID: id},
+	}
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out, out.Anchor), res, err
+}
+
+func (s *reviewService) Resolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolveThread(ctx, repo, number, thread, true)
+}
+
+func (s *reviewService) Unresolve(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return s.resolveThread(ctx, repo, number, thread, false)
+}
+
+// resolveThread updates the resolution state of the comment
+// thread. The current version of the root comment is
+// required to update the comment.
+func (s *reviewService) resolveThread(ctx context.Context, repo string, number int, thread string, resolved bool) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%s", namespace, name, number, thread)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	in := &threadResolvedInput{
+		Version:        out.Version,
+		ThreadResolved: resolved,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type prParticipants struct {
+	Reviewers    []*participant `json:"reviewers"`
+	Participants []*participant `json:"participants"`
+}
+
+type participant struct {
+	User               user   `json:"user"`
+	LastReviewedCommit string `json:"lastReviewedCommit"`
+	Role               string `json:"role"`
+	Approved           bool   `json:"approved"`
+	Status             string `json:"status"`
+}
+
+type participantInput struct {
+	Status string `json:"status"`
+}
+
+type activities struct {
+	pagination
+	Values []*activity `json:"values"`
+}
+
+type activity struct {
+	Action        string                    `json:"action"`
+	CommentAction string                    `json:"commentAction"`
+	Comment       *reviewComment            `json:"comment"`
+	CommentAnchor *pullRequestCommentAnchor `json:"commentAnchor"`
+}
+
+type reviewComment struct {
+	ID             int                       `json:"id"`
+	Version        int                       `json:"version"`
+	Text           string                    `json:"text"`
+	Author         user                      `json:"author"`
+	Anchor         *pullRequestCommentAnchor `json:"anchor"`
+	ThreadResolved bool                      `json:"threadResolved"`
+	Comments       []*reviewComment          `json:"comments"`
+	CreatedDate    int64                     `json:"createdDate"`
+	UpdatedDate    int64                     `json:"updatedDate"`
+}
+
+type threadResolvedInput struct {
+	Version        int  `json:"version"`
+	ThreadResolved bool `json:"threadResolved"`
+}
+
+func convertParticipantList(from []*participant) []*scm.PullRequestReview {
+	to := []*scm.PullRequestReview{}
+	for _, v := range from {
+		if review := convertParticipant(v); review.State != scm.ReviewStateUnknown {
+			to = append(to, review)
+		}
+	}
+	return to
+}
+
+func convertParticipant(from *participant) *scm.PullRequestReview {
+	return &scm.PullRequestReview{
+		State:  convertReviewState(from.Status),
+		Sha:    from.LastReviewedCommit,
+		Author: *convertUser(&from.User),
+	}
+}
+
+func convertReviewState(from string) scm.ReviewState {
+	switch from {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "NEEDS_WORK":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+// convertReviewCommentInput returns the comment input
+// anchored to the diff. Comments on the left side of the
+// diff are anchored to the removed lines of the source file.
+func convertReviewCommentInput(from *scm.ReviewInput) *pullRequestCommentInput {
+	anchor := &pullRequestCommentAnchor{
+		Path:     from.Path,
+		Line:     from.Line,
+		LineType: "ADDED",
+		FileType: "TO",
+		DiffType: "EFFECTIVE",
+	}
+	if from.Side == scm.ReviewSideLeft {
+		anchor.LineType = "REMOVED"
+		anchor.FileType = "FROM"
+	}
+	if from.StartLine != 0 && from.StartLine != from.Line {
+		anchor.MultilineMarker = &pullRequestCommentMultiline{
+			StartLine:     from.StartLine,
+			StartLineType: anchor.LineType,
+		}
+	}
+	return &pullRequestCommentInput{
+		Text:   from.Body,
+		Anchor: anchor,
+	}
+}
+
+// convertActivityList returns the review threads from the
+// comments added to the diff.
+func convertActivityList(from []*activity) []*scm.ReviewThread {
+	to := []*scm.ReviewThread{}
+	for _, v := range from {
+		if v.Action != "COMMENTED" || v.CommentAction != "ADDED" || v.Comment == nil {
+			continue
+		}
+		anchor := v.CommentAnchor
+		if anchor == nil {
+			anchor = v.Comment.Anchor
+		}
+		if anchor == nil || anchor.Line == 0 {
+			continue
+		}
+		to = append(to, convertReviewThread(v.Comment, anchor))
+	}
+	return to
+}
+
+func convertReviewThread(from *reviewComment, anchor *pullRequestCommentAnchor) *scm.ReviewThread {
+	first := convertReviewComment(from, anchor)
+	to := &scm.ReviewThread{
+		ID:        strconv.Itoa(from.ID),
+		Path:      first.Path,
+		Line:      first.Line,
+		StartLine: first.StartLine,
+		Side:      first.Side,
+		Resolved:  from.ThreadResolved,
+		Comments:  []*scm.Review{first},
+	}
+	// replies are nested below the comment they reply to,
+	// and are flattened in the order they were added.
+	var walk func([]*reviewComment)
+	walk = func(replies []*reviewComment) {
+		for _, v := range replies {
+			to.Comments = append(to.Comments, convertReviewComment(v, anchor))
+			walk(v.Comments)
+		}
+	}
+	walk(from.Comments)
+	return to
+}
+
+func convertReviewComment(from *reviewComment, anchor *pullRequestCommentAnchor) *scm.Review {
+	to := &scm.Review{
+		ID:      from.ID,
+		Body:    from.Text,
+		Author:  *convertUser(&from.Author),
+		Created: time.Unix(from.CreatedDate/1000, 0),
+		Updated: time.Unix(from.UpdatedDate/1000, 0),
+	}
+	if anchor != nil {
+		to.Path = anchor.Path
+		to.Line = anchor.Line
+		to.Side = scm.ReviewSideRight
+		if anchor.FileType == "FROM" {
+			to.Side = scm.ReviewSideLeft
+		}
+		if anchor.MultilineMarker != nil {
+			to.StartLine = anchor.MultilineMarker.StartLine
+		}
+	}
+	return to
+}
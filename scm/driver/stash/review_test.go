@@ -6,9 +6,14 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -26,9 +31,50 @@ func TestReviewList(t *testing.T) {
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "Nit: typo",
+			"anchor": map[string]interface{}{
+				"path":     "README.md",
+				"line":     12,
+				"lineType": "ADDED",
+				"fileType": "TO",
+				"diffType": "EFFECTIVE",
+				"multilineMarker": map[string]interface{}{
+					"startLine":     10,
+					"startLineType": "ADDED",
+				},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Nit: typo",
+		Path:      "README.md",
+		Line:      12,
+		StartLine: 10,
+		Side:      scm.ReviewSideRight,
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/pr_review_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -38,3 +84,203 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewListReviews(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/participants.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ListReviews(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/participants.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewSubmit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text": "Nit: typo",
+			"anchor": map[string]interface{}{
+				"path":     "README.md",
+				"line":     6,
+				"lineType": "ADDED",
+				"fileType": "TO",
+				"diffType": "EFFECTIVE",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	gock.New("http://example.com:7990").
+		Get("plugins/servlet/applinks/whoami").
+		Reply(200).
+		Type("text/plain").
+		BodyString("jcitizen")
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/users/jcitizen").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants/jcitizen").
+		JSON(map[string]string{"status": "APPROVED"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/participant.json")
+
+	input := &scm.PullRequestReviewInput{
+		State: scm.ReviewStateApproved,
+		Comments: []*scm.ReviewInput{
+			{Path: "README.md", Line: 6, Body: "Nit: typo"},
+		},
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Submit(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comments created and pull request approved")
+	}
+
+	want := []*scm.PullRequestReview{}
+	raw, _ := ioutil.ReadFile("testdata/participants.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewDismiss(t *testing.T) {
+	_, _, err := NewDefault().Reviews.Dismiss(context.Background(), "", 0, 0, "")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReviewListThreads(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_activities.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ListThreads(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ReviewThread{}
+	raw, _ := ioutil.ReadFile("testdata/pr_activities.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewReply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		JSON(map[string]interface{}{
+			"text":   "Fixed, thanks",
+			"parent": map[string]int{"id": 41},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reviews.Reply(context.Background(), "PRJ/my-repo", 1, "41", &scm.CommentInput{Body: "Fixed, thanks"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect reply created")
+	}
+}
+
+func TestReviewResolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/41").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/41").
+		JSON(map[string]interface{}{"version": 0, "threadResolved": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.Resolve(context.Background(), "PRJ/my-repo", 1, "41")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comment thread resolved")
+	}
+}
+
+func TestReviewUnresolve(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/41").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/41").
+		JSON(map[string]interface{}{"version": 0, "threadResolved": false}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_review_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.Unresolve(context.Background(), "PRJ/my-repo", 1, "41")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect comment thread unresolved")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"net/url"
 	"strings"
@@ -37,15 +38,23 @@ func New(uri string) (*scm.Client, error) {
 	client.Driver = scm.DriverStash
 	client.Linker = &linker{base.String()}
 	client.Apps = &appsService{client}
+	client.BranchProtections = &protectionService{client}
+	client.Checks = &checksService{client}
 	client.Contents = &contentService{client}
+	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
+	client.GitData = &gitDataService{client}
 	client.Issues = &issueService{client}
+	client.Keys = &keyService{client}
+	client.Labels = &labelService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
+	client.Pipelines = &pipelineService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Secrets = &secretService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -114,14 +123,16 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	}
 	defer res.Body.Close()
 
+	// parse the bitbucket server request id.
+	res.ID = res.Header.Get("X-Arequestid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
 	// if an error is encountered, unmarshal and return the
 	// error response.
-	if res.Status == 401 {
-		return res, scm.ErrNotAuthorized
-	} else if res.Status > 300 {
-		err := new(Error)
-		json.NewDecoder(res.Body).Decode(err)
-		return res, err
+	if res.Status > 300 {
+		return res, newError(res)
 	}
 
 	if out == nil {
@@ -140,6 +151,34 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream wraps the Client.Do function by creating the Request
+// and returning the response body without reading it. The
+// caller is responsible for closing the response body.
+func (c *wrapper) stream(ctx context.Context, method, path string) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// parse the bitbucket server request id.
+	res.ID = res.Header.Get("X-Arequestid")
+
+	// snapshot the request rate limit
+	c.Client.SetRate(res.Rate)
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		return nil, res, newError(res)
+	}
+	return res.Body, res, nil
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -155,6 +194,7 @@ type Error struct {
 	Message string `json:"message"`
 	Status  int    `json:"status-code"`
 	Errors  []struct {
+		Context         string `json:"context"`
 		Message         string `json:"message"`
 		ExceptionName   string `json:"exceptionName"`
 		CurrentVersion  int    `json:"currentVersion"`
@@ -171,3 +211,37 @@ func (e *Error) Error() string {
 	}
 	return e.Errors[0].Message
 }
+
+// newError returns an API error from the stash error
+// response. An unauthorized response returns the
+// scm.ErrNotAuthorized error, which callers compare
+// by equality.
+func newError(res *scm.Response) error {
+	if res.Status == 401 {
+		return scm.ErrNotAuthorized
+	}
+	raw, _ := ioutil.ReadAll(res.Body)
+	to := &scm.APIError{
+		Status:    res.Status,
+		RequestID: res.ID,
+		Header:    res.Header,
+		Raw:       raw,
+	}
+	from := new(Error)
+	json.Unmarshal(raw, from)
+	to.Message = from.Error()
+	to.Err = from
+	for _, v := range from.Errors {
+		if to.Code == "" {
+			to.Code = v.ExceptionName
+		}
+		if v.Context != "" {
+			to.Errors = append(to.Errors, scm.FieldError{
+				Field:   v.Context,
+				Code:    v.ExceptionName,
+				Message: v.Message,
+			})
+		}
+	}
+	return to
+}
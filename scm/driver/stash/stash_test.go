@@ -5,7 +5,11 @@
 package stash
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
+
+	"github.com/drone/go-scm/scm"
 )
 
 func TestClient(t *testing.T) {
@@ -34,3 +38,13 @@ func TestClient_Error(t *testing.T) {
 		t.Errorf("Expect error when invalid URL")
 	}
 }
+
+func TestNewError_NotAuthorized(t *testing.T) {
+	res := &scm.Response{
+		Status: 401,
+		Body:   ioutil.NopCloser(strings.NewReader(`{}`)),
+	}
+	if err := newError(res); err != scm.ErrNotAuthorized {
+		t.Errorf("Want ErrNotAuthorized, got %v", err)
+	}
+}
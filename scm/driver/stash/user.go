@@ -101,6 +101,19 @@ type userFilter struct {
 	Values []*user `json:"values"`
 }
 
+type users struct {
+	pagination
+	Values []*user `json:"values"`
+}
+
+func convertUserList(from *users) []*scm.User {
+	to := []*scm.User{}
+	for _, v := range from.Values {
+		to = append(to, convertUser(v))
+	}
+	return to
+}
+
 func convertUser(from *user) *scm.User {
 	return &scm.User{
 		Avatar: avatarLink(from.EmailAddress),
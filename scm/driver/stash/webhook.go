@@ -43,6 +43,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePushHook(data)
 	case "pr:opened", "pr:from_ref_updated", "pr:modified", "pr:declined", "pr:deleted", "pr:merged":
 		hook, err = s.parsePullRequest(data)
+	case "pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work":
+		hook, err = s.parsePullRequestReviewHook(data)
 	}
 	if err != nil {
 		return nil, err
@@ -126,6 +128,23 @@ func (s *webhookService) parsePullRequest(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parsePullRequestReviewHook(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestReviewHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestReviewHook(src)
+	switch src.EventKey {
+	case "pr:reviewer:approved", "pr:reviewer:needs_work":
+		dst.Action = scm.ActionSubmit
+	case "pr:reviewer:unapproved":
+		dst.Action = scm.ActionDismiss
+		dst.Review.State = scm.ReviewStateDismissed
+	}
+	return dst, nil
+}
+
 //
 // native data structures
 //
@@ -138,6 +157,15 @@ type pushHook struct {
 	Changes    []*change   `json:"changes"`
 }
 
+type pullRequestReviewHook struct {
+	EventKey       string      `json:"eventKey"`
+	Date           string      `json:"date"`
+	Actor          *user       `json:"actor"`
+	PullRequest    *pr         `json:"pullRequest"`
+	Participant    participant `json:"participant"`
+	PreviousStatus string      `json:"previousStatus"`
+}
+
 type pullRequestHook struct {
 	EventKey    string `json:"eventKey"`
 	Date        string `json:"date"`
@@ -255,6 +283,18 @@ func convertSignature(actor *user) scm.Signature {
 	}
 }
 
+func convertPullRequestReviewHook(src *pullRequestReviewHook) *scm.PullRequestReviewHook {
+	submitted, _ := time.Parse("2006-01-02T15:04:05-0700", src.Date)
+	review := convertParticipant(&src.Participant)
+	review.Submitted = submitted
+	return &scm.PullRequestReviewHook{
+		Repo:        *convertRepository(&src.PullRequest.ToRef.Repository),
+		PullRequest: *convertPullRequest(src.PullRequest),
+		Review:      *review,
+		Sender:      *convertUser(src.Actor),
+	}
+}
+
 func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	repo := convertRepository(&src.PullRequest.ToRef.Repository)
 	pr := convertPullRequest(src.PullRequest)
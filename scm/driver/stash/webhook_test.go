@@ -99,6 +99,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_open.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:approved",
+			before: "testdata/webhooks/pr_reviewer_approved.json",
+			after:  "testdata/webhooks/pr_reviewer_approved.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
 		// pull request source branch updated
 		{
 			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
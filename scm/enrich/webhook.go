@@ -6,12 +6,271 @@ package enrich
 
 import (
 	"context"
+	"errors"
 
 	"github.com/drone/go-scm/scm"
 )
 
 // Webhook enriches the webhook payload with missing
 // information not included in the webhook payload.
-func Webhook(ctx context.Context, client *scm.Client, webhook *scm.Webhook) error {
-	return nil // TODO
+// Fields that are already populated are never overwritten,
+// and endpoints the driver does not support are skipped.
+//
+// The following fields may be enriched:
+//
+//	PushHook:               Commit, Commits, Sender.Email, Repo
+//	PullRequestHook:        PullRequest.Sha, PullRequest.Head.Sha,
+//	                        PullRequest.Base.Sha, Sender.Email, Repo
+//	PullRequestCommentHook: PullRequest.Sha, PullRequest.Head.Sha,
+//	                        PullRequest.Base.Sha, Sender.Email, Repo
+//	BranchHook, TagHook:    Ref.Sha, Sender.Email, Repo
+//	all other hooks:        Sender.Email, Repo
+//
+// Repo enrichment fills the Clone, CloneSSH, Link and
+// Branch fields when the Clone URL is missing.
+func Webhook(ctx context.Context, client *scm.Client, webhook scm.Webhook) error {
+	switch v := webhook.(type) {
+	case *scm.PushHook:
+		return enrichPushHook(ctx, client, v)
+	case *scm.PullRequestHook:
+		return enrichPullRequestHook(ctx, client, v)
+	case *scm.PullRequestCommentHook:
+		return enrichPullRequestCommentHook(ctx, client, v)
+	case *scm.BranchHook:
+		return enrichBranchHook(ctx, client, v)
+	case *scm.TagHook:
+		return enrichTagHook(ctx, client, v)
+	case *scm.IssueHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.IssueCommentHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.ReviewCommentHook:
+		return enrichRepository(ctx, client, &v.Repo)
+	case *scm.DeployHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.DeployStatusHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.ReleaseHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.PipelineHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	case *scm.PingHook:
+		return enrichCommon(ctx, client, &v.Repo, &v.Sender)
+	}
+	return nil
+}
+
+// enrichPushHook enriches the push hook commits with the
+// author and committer details, and the commit message,
+// when they are missing from the payload.
+func enrichPushHook(ctx context.Context, client *scm.Client, hook *scm.PushHook) error {
+	repo := scm.Join(hook.Repo.Namespace, hook.Repo.Name)
+	cache := map[string]*scm.Commit{}
+	for i := range hook.Commits {
+		if err := enrichCommit(ctx, client, repo, &hook.Commits[i], cache); err != nil {
+			return err
+		}
+	}
+	if err := enrichCommit(ctx, client, repo, &hook.Commit, cache); err != nil {
+		return err
+	}
+	return enrichCommon(ctx, client, &hook.Repo, &hook.Sender)
+}
+
+// enrichPullRequestHook enriches the pull request hook with
+// the pull request head and base commit sha.
+func enrichPullRequestHook(ctx context.Context, client *scm.Client, hook *scm.PullRequestHook) error {
+	if err := enrichPullRequest(ctx, client, &hook.Repo, &hook.PullRequest); err != nil {
+		return err
+	}
+	return enrichCommon(ctx, client, &hook.Repo, &hook.Sender)
+}
+
+// enrichPullRequestCommentHook enriches the pull request
+// comment hook with the pull request head and base commit sha.
+func enrichPullRequestCommentHook(ctx context.Context, client *scm.Client, hook *scm.PullRequestCommentHook) error {
+	if err := enrichPullRequest(ctx, client, &hook.Repo, &hook.PullRequest); err != nil {
+		return err
+	}
+	return enrichCommon(ctx, client, &hook.Repo, &hook.Sender)
+}
+
+// enrichBranchHook enriches the branch hook with the branch
+// sha. The sha cannot be resolved for deleted branches.
+func enrichBranchHook(ctx context.Context, client *scm.Client, hook *scm.BranchHook) error {
+	if hook.Ref.Sha == "" && hook.Action != scm.ActionDelete {
+		repo := scm.Join(hook.Repo.Namespace, hook.Repo.Name)
+		ref, _, err := client.Git.FindBranch(ctx, repo, hook.Ref.Name)
+		if err := ignoreNotSupported(err); err != nil {
+			return err
+		}
+		if ref != nil {
+			hook.Ref.Sha = ref.Sha
+		}
+	}
+	return enrichCommon(ctx, client, &hook.Repo, &hook.Sender)
+}
+
+// enrichTagHook enriches the tag hook with the tag sha. The
+// sha cannot be resolved for deleted tags.
+func enrichTagHook(ctx context.Context, client *scm.Client, hook *scm.TagHook) error {
+	if hook.Ref.Sha == "" && hook.Action != scm.ActionDelete {
+		repo := scm.Join(hook.Repo.Namespace, hook.Repo.Name)
+		ref, _, err := client.Git.FindTag(ctx, repo, hook.Ref.Name)
+		if err := ignoreNotSupported(err); err != nil {
+			return err
+		}
+		if ref != nil {
+			hook.Ref.Sha = ref.Sha
+		}
+	}
+	return enrichCommon(ctx, client, &hook.Repo, &hook.Sender)
+}
+
+// enrichCommon enriches the repository and sender fields
+// that are shared by all hook types.
+func enrichCommon(ctx context.Context, client *scm.Client, repo *scm.Repository, sender *scm.User) error {
+	if err := enrichRepository(ctx, client, repo); err != nil {
+		return err
+	}
+	return enrichSender(ctx, client, sender)
+}
+
+// enrichCommit populates the commit author, committer and
+// message if the author email is missing. The cache prevents
+// fetching the same commit more than once.
+func enrichCommit(ctx context.Context, client *scm.Client, repo string, commit *scm.Commit, cache map[string]*scm.Commit) error {
+	if commit.Sha == "" || commit.Author.Email != "" {
+		return nil
+	}
+	found, ok := cache[commit.Sha]
+	if !ok {
+		var err error
+		found, _, err = client.Git.FindCommit(ctx, repo, commit.Sha)
+		if err := ignoreNotSupported(err); err != nil {
+			return err
+		}
+		cache[commit.Sha] = found
+	}
+	if found == nil {
+		return nil
+	}
+	if commit.Message == "" {
+		commit.Message = found.Message
+	}
+	if commit.Link == "" {
+		commit.Link = found.Link
+	}
+	mergeSignature(&commit.Author, found.Author)
+	mergeSignature(&commit.Committer, found.Committer)
+	return nil
+}
+
+// enrichPullRequest populates the pull request head and base
+// sha if either value is missing.
+func enrichPullRequest(ctx context.Context, client *scm.Client, repo *scm.Repository, pr *scm.PullRequest) error {
+	if pr.Number == 0 {
+		return nil
+	}
+	if pr.Sha != "" && pr.Head.Sha != "" && pr.Base.Sha != "" {
+		return nil
+	}
+	found, _, err := client.PullRequests.Find(ctx, scm.Join(repo.Namespace, repo.Name), pr.Number)
+	if err := ignoreNotSupported(err); err != nil {
+		return err
+	}
+	if found == nil {
+		return nil
+	}
+	if pr.Sha == "" {
+		pr.Sha = found.Sha
+	}
+	if pr.Head.Sha == "" {
+		pr.Head.Sha = found.Head.Sha
+	}
+	if pr.Head.Sha == "" {
+		pr.Head.Sha = pr.Sha
+	}
+	if pr.Base.Sha == "" {
+		pr.Base.Sha = found.Base.Sha
+	}
+	return nil
+}
+
+// enrichRepository populates the repository clone urls and
+// default branch if the clone url is missing.
+func enrichRepository(ctx context.Context, client *scm.Client, repo *scm.Repository) error {
+	if repo.Clone != "" || repo.Name == "" {
+		return nil
+	}
+	found, _, err := client.Repositories.Find(ctx, scm.Join(repo.Namespace, repo.Name))
+	if err := ignoreNotSupported(err); err != nil {
+		return err
+	}
+	if found == nil {
+		return nil
+	}
+	repo.Clone = found.Clone
+	if repo.CloneSSH == "" {
+		repo.CloneSSH = found.CloneSSH
+	}
+	if repo.Link == "" {
+		repo.Link = found.Link
+	}
+	if repo.Branch == "" {
+		repo.Branch = found.Branch
+	}
+	return nil
+}
+
+// enrichSender populates the sender email address if the
+// email address is missing.
+func enrichSender(ctx context.Context, client *scm.Client, sender *scm.User) error {
+	if sender.Email != "" || sender.Login == "" {
+		return nil
+	}
+	found, _, err := client.Users.FindLogin(ctx, sender.Login)
+	if err := ignoreNotSupported(err); err != nil {
+		return err
+	}
+	if found == nil {
+		return nil
+	}
+	sender.Email = found.Email
+	if sender.Name == "" {
+		sender.Name = found.Name
+	}
+	if sender.Avatar == "" {
+		sender.Avatar = found.Avatar
+	}
+	return nil
+}
+
+// mergeSignature copies the non-empty values from src to
+// dst for any value that is missing in dst.
+func mergeSignature(dst *scm.Signature, src scm.Signature) {
+	if dst.Name == "" {
+		dst.Name = src.Name
+	}
+	if dst.Email == "" {
+		dst.Email = src.Email
+	}
+	if dst.Date.IsZero() {
+		dst.Date = src.Date
+	}
+	if dst.Login == "" {
+		dst.Login = src.Login
+	}
+	if dst.Avatar == "" {
+		dst.Avatar = src.Avatar
+	}
+}
+
+// ignoreNotSupported returns nil if the error indicates the
+// driver does not support the endpoint.
+func ignoreNotSupported(err error) error {
+	if errors.Is(err, scm.ErrNotSupported) {
+		return nil
+	}
+	return err
 }
@@ -3,3 +3,267 @@
 // license that can be found in the LICENSE file.
 
 package enrich
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+	"github.com/drone/go-scm/scm/driver/github"
+	"github.com/drone/go-scm/scm/driver/gitlab"
+	"github.com/drone/go-scm/scm/driver/stash"
+
+	"github.com/h2non/gock"
+)
+
+func TestWebhook_StashPush(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f").
+		Times(1).
+		Reply(200).
+		Type("application/json").
+		File("testdata/stash_commit.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo").
+		Reply(200).
+		Type("application/json").
+		File("testdata/stash_repo.json")
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/branches/default").
+		Reply(200).
+		Type("application/json").
+		File("testdata/stash_default_branch.json")
+
+	hook := &scm.PushHook{
+		Repo: scm.Repository{Namespace: "PRJ", Name: "my-repo", Branch: "master"},
+		Commit: scm.Commit{
+			Sha:    "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+			Author: scm.Signature{Login: "jcitizen"},
+		},
+		Commits: []scm.Commit{
+			{Sha: "131cb13f4aed12e725177bc4b7c28db67839bf9f"},
+		},
+		Sender: scm.User{Login: "jcitizen", Email: "jane@example.com"},
+	}
+
+	client, _ := stash.New("http://example.com:7990")
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, commit := range append(hook.Commits, hook.Commit) {
+		if got, want := commit.Message, "update files"; got != want {
+			t.Errorf("Want commit message %q, got %q", want, got)
+		}
+		if got, want := commit.Author.Email, "jane@example.com"; got != want {
+			t.Errorf("Want author email %q, got %q", want, got)
+		}
+		if got, want := commit.Committer.Email, "jane@example.com"; got != want {
+			t.Errorf("Want committer email %q, got %q", want, got)
+		}
+		if got, want := commit.Author.Login, "jcitizen"; got != want {
+			t.Errorf("Want author login %q, got %q", want, got)
+		}
+	}
+	if got, want := hook.Repo.Clone, "http://example.com:7990/scm/prj/my-repo.git"; got != want {
+		t.Errorf("Want clone url %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.CloneSSH, "ssh://git@example.com:7999/prj/my-repo.git"; got != want {
+		t.Errorf("Want clone ssh url %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Branch, "master"; got != want {
+		t.Errorf("Want existing branch %q preserved, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestWebhook_StashPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/stash_pr.json")
+
+	hook := &scm.PullRequestHook{
+		Repo: scm.Repository{
+			Namespace: "PRJ",
+			Name:      "my-repo",
+			Clone:     "http://example.com:7990/scm/prj/my-repo.git",
+		},
+		PullRequest: scm.PullRequest{Number: 1},
+		Sender:      scm.User{Login: "jcitizen", Email: "jane@example.com"},
+	}
+
+	client, _ := stash.New("http://example.com:7990")
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := hook.PullRequest.Sha, "131cb13f4aed12e725177bc4b7c28db67839bf9f"; got != want {
+		t.Errorf("Want sha %q, got %q", want, got)
+	}
+	if got, want := hook.PullRequest.Head.Sha, "131cb13f4aed12e725177bc4b7c28db67839bf9f"; got != want {
+		t.Errorf("Want head sha %q, got %q", want, got)
+	}
+	if got, want := hook.PullRequest.Base.Sha, "5c64a07cd6c0f21b753bf261ef059c7e7633c50a"; got != want {
+		t.Errorf("Want base sha %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestWebhook_BitbucketPush(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("application/json").
+		File("testdata/bitbucket_commit.json")
+
+	hook := &scm.PushHook{
+		Repo: scm.Repository{
+			Namespace: "atlassian",
+			Name:      "stash-example-plugin",
+			Clone:     "https://bitbucket.org/atlassian/stash-example-plugin.git",
+		},
+		Commit: scm.Commit{
+			Sha:     "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+			Message: "Add Apache 2.0 License\n",
+			Author:  scm.Signature{Name: "Adam Ahmed"},
+		},
+		Sender: scm.User{Login: "aahmed", Email: "aahmed@atlassian.com"},
+	}
+	hook.Commits = []scm.Commit{hook.Commit}
+
+	client := bitbucket.NewDefault()
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := hook.Commits[0].Author.Email, "aahmed@atlassian.com"; got != want {
+		t.Errorf("Want author email %q, got %q", want, got)
+	}
+	if got, want := hook.Commits[0].Author.Name, "Adam Ahmed"; got != want {
+		t.Errorf("Want author name %q, got %q", want, got)
+	}
+	if got, want := hook.Commit.Committer.Email, "aahmed@atlassian.com"; got != want {
+		t.Errorf("Want committer email %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestWebhook_GithubSender(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/users/octocat").
+		Reply(200).
+		Type("application/json").
+		File("testdata/github_user.json")
+
+	hook := &scm.IssueHook{
+		Repo: scm.Repository{
+			Namespace: "octocat",
+			Name:      "hello-world",
+			Clone:     "https://github.com/octocat/hello-world.git",
+		},
+		Sender: scm.User{Login: "octocat"},
+	}
+
+	client := github.NewDefault()
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := hook.Sender.Email, "octocat@github.com"; got != want {
+		t.Errorf("Want sender email %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Name, "monalisa octocat"; got != want {
+		t.Errorf("Want sender name %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestWebhook_GitlabPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		File("testdata/gitlab_merge.json")
+
+	hook := &scm.PullRequestCommentHook{
+		Repo: scm.Repository{
+			Namespace: "diaspora",
+			Name:      "diaspora",
+			Clone:     "https://gitlab.com/diaspora/diaspora.git",
+		},
+		PullRequest: scm.PullRequest{
+			Number: 1347,
+			Sha:    "12d65c8dd2b2676fa3ac47d955accc085a37a9c1",
+		},
+		Sender: scm.User{Login: "dblessing", Email: "dblessing@example.com"},
+	}
+
+	client := gitlab.NewDefault()
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := hook.PullRequest.Head.Sha, "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"; got != want {
+		t.Errorf("Want head sha %q, got %q", want, got)
+	}
+	if got, want := hook.PullRequest.Base.Sha, "45d65c8dd2b2676fa3ac47d955accc085a37a9c1"; got != want {
+		t.Errorf("Want base sha %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestWebhook_Complete(t *testing.T) {
+	defer gock.Off()
+
+	// a fully populated payload must not trigger any
+	// api requests.
+	gock.New("https://api.github.com").
+		Persist().
+		Reply(500)
+
+	hook := &scm.PullRequestHook{
+		Repo: scm.Repository{
+			Namespace: "octocat",
+			Name:      "hello-world",
+			Clone:     "https://github.com/octocat/hello-world.git",
+		},
+		PullRequest: scm.PullRequest{
+			Number: 1,
+			Sha:    "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+			Head:   scm.Reference{Sha: "ec26c3e57ca3a959ca5aad62de7213c562f8c821"},
+			Base:   scm.Reference{Sha: "7044a8a032e85b6ab611033b2ac8af7ce85805b2"},
+		},
+		Sender: scm.User{Login: "octocat", Email: "octocat@github.com"},
+	}
+
+	client := github.NewDefault()
+	if err := Webhook(context.Background(), client, hook); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"net/http"
+)
+
+type (
+	// APIError represents an error response returned by
+	// the provider API. It matches the ErrNotFound,
+	// ErrNotAuthorized, ErrConflict, ErrRateLimited and
+	// ErrValidation errors based on the status code when
+	// used with errors.Is, and unwraps to the driver
+	// specific error type. Bitbucket and Stash return
+	// ErrNotAuthorized itself for unauthorized responses.
+	APIError struct {
+		// Status is the HTTP status code.
+		Status int
+
+		// RequestID is the provider request identifier.
+		RequestID string
+
+		// Code is the provider specific error code.
+		Code string
+
+		// Message is the error message.
+		Message string
+
+		// Errors contains the field level validation
+		// errors, if any.
+		Errors []FieldError
+
+		// Header contains the HTTP response headers.
+		Header http.Header
+
+		// Raw contains the raw response body.
+		Raw []byte
+
+		// Err is the driver specific error.
+		Err error
+	}
+
+	// MergeMethodError is returned when a pull request
+	// cannot be merged because the merge method is not
+	// supported by the provider or not allowed for the
+	// repository.
+	MergeMethodError struct {
+		Method MergeMethod
+
+		// Err is the provider error, if any.
+		Err error
+	}
+
+	// FieldError represents a field level validation
+	// error.
+	FieldError struct {
+		Resource string
+		Field    string
+		Code     string
+		Message  string
+	}
+)
+
+// Error returns the error message.
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	if e.Message != "" {
+		return e.Message
+	}
+	return http.StatusText(e.Status)
+}
+
+// Unwrap returns the driver specific error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches the target error
+// based on the HTTP status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrNotAuthorized:
+		return e.Status == http.StatusUnauthorized ||
+			(e.Status == http.StatusForbidden && !e.rateLimited())
+	case ErrConflict:
+		return e.Status == http.StatusConflict
+	case ErrRateLimited:
+		return e.rateLimited()
+	case ErrValidation:
+		return e.Status == http.StatusUnprocessableEntity ||
+			(e.Status == http.StatusBadRequest && len(e.Errors) != 0)
+	}
+	return false
+}
+
+// rateLimited reports whether the error indicates the rate
+// limit is exceeded. Some providers, including GitHub,
+// return a 403 status code when the rate limit is exceeded.
+func (e *APIError) rateLimited() bool {
+	if e.Status == http.StatusTooManyRequests {
+		return true
+	}
+	if e.Status != http.StatusForbidden || e.Header == nil {
+		return false
+	}
+	if e.Header.Get("Retry-After") != "" {
+		return true
+	}
+	rate := ParseRate(e.Header)
+	return rate.Limit != 0 && rate.Remaining == 0
+}
+
+// Error returns the error message.
+func (e *MergeMethodError) Error() string {
+	return "merge method " + e.Method.String() + " is not allowed"
+}
+
+// Unwrap returns the provider error.
+func (e *MergeMethodError) Unwrap() error {
+	return e.Err
+}
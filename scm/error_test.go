@@ -0,0 +1,71 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		err    *APIError
+		target error
+		match  bool
+	}{
+		{&APIError{Status: 404}, ErrNotFound, true},
+		{&APIError{Status: 401}, ErrNotAuthorized, true},
+		{&APIError{Status: 403}, ErrNotAuthorized, true},
+		{&APIError{Status: 409}, ErrConflict, true},
+		{&APIError{Status: 422}, ErrValidation, true},
+		{&APIError{Status: 429}, ErrRateLimited, true},
+		{&APIError{Status: 400, Errors: []FieldError{{Field: "name"}}}, ErrValidation, true},
+		{&APIError{Status: 400}, ErrValidation, false},
+		{&APIError{Status: 500}, ErrNotFound, false},
+		{
+			err: &APIError{
+				Status: 403,
+				Header: http.Header{"Retry-After": {"60"}},
+			},
+			target: ErrRateLimited,
+			match:  true,
+		},
+		{
+			err: &APIError{
+				Status: 403,
+				Header: http.Header{
+					"X-Ratelimit-Limit":     {"5000"},
+					"X-Ratelimit-Remaining": {"0"},
+				},
+			},
+			target: ErrNotAuthorized,
+			match:  false,
+		},
+	}
+	for i, test := range tests {
+		if got, want := errors.Is(test.err, test.target), test.match; got != want {
+			t.Errorf("Test %d: want errors.Is(%d, %q) %v", i, test.err.Status, test.target, want)
+		}
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{Status: 404}
+	if got, want := err.Error(), "Not Found"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+	err.Message = "Repository not found"
+	if got, want := err.Error(), "Repository not found"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+	err.Err = errors.New("404 Repository Not Found")
+	if got, want := err.Error(), "404 Repository Not Found"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+	if got, want := errors.Unwrap(err), err.Err; got != want {
+		t.Errorf("Want unwrapped driver error")
+	}
+}
@@ -26,6 +26,32 @@ type (
 		Sha  string
 	}
 
+	// ReferenceUpdateInput provides the input fields required
+	// for updating a git reference. The update is rejected if
+	// it is not a fast-forward, unless forced, or if the
+	// reference does not match the expected old sha. Providers
+	// that cannot compare and update the reference atomically,
+	// for example GitHub, compare the old sha before the update,
+	// so a concurrent update of the reference may not be
+	// detected.
+	ReferenceUpdateInput struct {
+		Name   string
+		Sha    string
+		OldSha string
+		Force  bool
+	}
+
+	// TagInput provides the input fields required for
+	// creating a git tag. An annotated tag is created if a
+	// message is provided, otherwise a lightweight tag is
+	// created.
+	TagInput struct {
+		Name    string
+		Sha     string
+		Message string
+		Tagger  Signature
+	}
+
 	// Commit represents a repository commit.
 	Commit struct {
 		Sha       string
@@ -73,12 +99,21 @@ type (
 		// CreateBranch creates a git branch by name given a sha.
 		CreateBranch(ctx context.Context, repo string, params *ReferenceInput) (*Response, error)
 
+		// DeleteBranch deletes a git branch by name.
+		DeleteBranch(ctx context.Context, repo, name string) (*Response, error)
+
 		// FindBranch finds a git branch by name.
 		FindBranch(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
 		// FindCommit finds a git commit by ref.
 		FindCommit(ctx context.Context, repo, ref string) (*Commit, *Response, error)
 
+		// CreateTag creates a git tag by name given a sha.
+		CreateTag(ctx context.Context, repo string, params *TagInput) (*Response, error)
+
+		// DeleteTag deletes a git tag by name.
+		DeleteTag(ctx context.Context, repo, name string) (*Response, error)
+
 		// FindTag finds a git tag by name.
 		FindTag(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
@@ -97,6 +132,11 @@ type (
 		// ListTags returns a list of git tags.
 		ListTags(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
+		// UpdateRef updates a git reference to the sha. The
+		// name is a fully qualified reference, or a branch
+		// name.
+		UpdateRef(ctx context.Context, repo string, params *ReferenceUpdateInput) (*Reference, *Response, error)
+
 		// CompareChanges returns the changeset between two
 		// commits. If the source commit is not an ancestor
 		// of the target commit, it is up to the driver to
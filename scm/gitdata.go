@@ -0,0 +1,101 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// Blob represents a git blob.
+	Blob struct {
+		Sha     string
+		Size    int64
+		Content []byte
+	}
+
+	// BlobInput provides the input fields required for
+	// creating a git blob.
+	BlobInput struct {
+		Content []byte
+	}
+
+	// Tree represents a git tree.
+	Tree struct {
+		Sha       string
+		Entries   []*TreeEntry
+		Truncated bool
+	}
+
+	// TreeEntry represents a git tree entry.
+	TreeEntry struct {
+		Path string
+		Mode string
+		Type string
+		Sha  string
+		Size int64
+	}
+
+	// TreeInput provides the input fields required for
+	// creating a git tree. The entries are applied to the
+	// base tree, if provided.
+	TreeInput struct {
+		Base    string
+		Entries []*TreeEntryInput
+	}
+
+	// TreeEntryInput provides the input fields for a git
+	// tree entry. The entry references an existing object
+	// by sha, or provides the file content. A deleted entry
+	// is removed from the base tree.
+	TreeEntryInput struct {
+		Path    string
+		Mode    string
+		Type    string
+		Sha     string
+		Content []byte
+		Delete  bool
+	}
+
+	// GitCommitInput provides the input fields required for
+	// creating a git commit.
+	GitCommitInput struct {
+		Message   string
+		Tree      string
+		Parents   []string
+		Author    Signature
+		Committer Signature
+
+		// Branch is updated to the new commit, if provided.
+		// The tree entries are applied to the tree of the
+		// first parent if a tree sha is not provided. Drivers
+		// for providers that do not expose git objects require
+		// the branch and tree entries.
+		Branch  string
+		Entries []*TreeEntryInput
+	}
+
+	// GitDataService provides access to low-level git
+	// objects and references.
+	GitDataService interface {
+		// FindBlob finds a git blob by sha.
+		FindBlob(ctx context.Context, repo, sha string) (*Blob, *Response, error)
+
+		// CreateBlob creates a git blob.
+		CreateBlob(ctx context.Context, repo string, input *BlobInput) (*Blob, *Response, error)
+
+		// FindTree finds a git tree by sha. If recursive is
+		// true, the entries of nested trees are included.
+		FindTree(ctx context.Context, repo, sha string, recursive bool) (*Tree, *Response, error)
+
+		// CreateTree creates a git tree.
+		CreateTree(ctx context.Context, repo string, input *TreeInput) (*Tree, *Response, error)
+
+		// CreateCommit creates a git commit.
+		CreateCommit(ctx context.Context, repo string, input *GitCommitInput) (*Commit, *Response, error)
+
+		// UpdateRef updates a git reference to the sha. It
+		// is equivalent to the git service UpdateRef.
+		UpdateRef(ctx context.Context, repo string, input *ReferenceUpdateInput) (*Reference, *Response, error)
+	}
+)
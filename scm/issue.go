@@ -20,16 +20,35 @@ type (
 		Closed      bool
 		Locked      bool
 		Author      User
+		Assignees   []User
+		Milestone   *Milestone
 		PullRequest PullRequest
 		Created     time.Time
 		Updated     time.Time
 	}
 
 	// IssueInput provides the input fields required for
-	// creating or updating an issue.
+	// creating or updating an issue. Empty fields are not
+	// updated.
 	IssueInput struct {
 		Title string
 		Body  string
+
+		// Labels is the list of label names. The labels
+		// are replaced when updating an issue, and are not
+		// changed if nil.
+		Labels []string
+
+		// Assignees is the list of user logins. The
+		// assignees are replaced when updating an issue,
+		// and are not changed if nil.
+		Assignees []string
+
+		// Milestone is the milestone number.
+		Milestone int
+
+		// State is the issue state, open or closed.
+		State string
 	}
 
 	// IssueListOptions provides options for querying a
@@ -51,7 +70,7 @@ type (
 	}
 
 	// CommentInput provides the input fields required for
-	// creating an issue comment.
+	// creating or updating an issue comment.
 	CommentInput struct {
 		Body string
 	}
@@ -73,15 +92,24 @@ type (
 		// Create creates a new issue.
 		Create(context.Context, string, *IssueInput) (*Issue, *Response, error)
 
+		// Update updates an issue.
+		Update(context.Context, string, int, *IssueInput) (*Issue, *Response, error)
+
 		// CreateComment creates a new issue comment.
 		CreateComment(context.Context, string, int, *CommentInput) (*Comment, *Response, error)
 
+		// UpdateComment updates an issue comment.
+		UpdateComment(context.Context, string, int, int, *CommentInput) (*Comment, *Response, error)
+
 		// DeleteComment deletes an issue comment.
 		DeleteComment(context.Context, string, int, int) (*Response, error)
 
 		// Close closes an issue.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens a closed issue.
+		Reopen(context.Context, string, int) (*Response, error)
+
 		// Lock locks an issue discussion.
 		Lock(context.Context, string, int) (*Response, error)
 
@@ -0,0 +1,84 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Key represents a public ssh key, which is either a
+	// repository deploy key or a user key.
+	Key struct {
+		ID       string
+		Title    string
+		Key      string
+		ReadOnly bool
+		Created  time.Time
+	}
+
+	// KeyInput provides the input fields required for
+	// creating a deploy key or user key.
+	KeyInput struct {
+		Title string
+		Key   string
+
+		// ReadOnly creates a deploy key that cannot push
+		// to the repository. It is ignored for user keys.
+		ReadOnly bool
+	}
+
+	// GPGKey represents a user gpg key.
+	GPGKey struct {
+		ID      string
+		KeyID   string
+		Key     string
+		Emails  []string
+		Created time.Time
+		Expires time.Time
+	}
+
+	// GPGKeyInput provides the input fields required for
+	// creating a user gpg key.
+	GPGKeyInput struct {
+		// Key is the ascii-armored public key.
+		Key string
+	}
+
+	// KeyService provides access to deploy keys and the
+	// keys of the authenticated user.
+	KeyService interface {
+		// FindDeployKey returns a repository deploy key.
+		FindDeployKey(context.Context, string, string) (*Key, *Response, error)
+
+		// ListDeployKeys returns a list of repository
+		// deploy keys.
+		ListDeployKeys(context.Context, string, ListOptions) ([]*Key, *Response, error)
+
+		// CreateDeployKey creates a new repository deploy
+		// key.
+		CreateDeployKey(context.Context, string, *KeyInput) (*Key, *Response, error)
+
+		// DeleteDeployKey deletes a repository deploy key.
+		DeleteDeployKey(context.Context, string, string) (*Response, error)
+
+		// ListKeys returns a list of ssh keys of the
+		// authenticated user.
+		ListKeys(context.Context, ListOptions) ([]*Key, *Response, error)
+
+		// CreateKey creates a new ssh key for the
+		// authenticated user.
+		CreateKey(context.Context, *KeyInput) (*Key, *Response, error)
+
+		// ListGPGKeys returns a list of gpg keys of the
+		// authenticated user.
+		ListGPGKeys(context.Context, ListOptions) ([]*GPGKey, *Response, error)
+
+		// CreateGPGKey creates a new gpg key for the
+		// authenticated user.
+		CreateGPGKey(context.Context, *GPGKeyInput) (*GPGKey, *Response, error)
+	}
+)
@@ -0,0 +1,66 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// Label represents a repository label.
+	Label struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
+	// LabelInput provides the input fields required for
+	// creating or updating a label. The color is a
+	// hexadecimal color code.
+	LabelInput struct {
+		Name        string
+		Color       string
+		Description string
+	}
+
+	// LabelService provides access to repository labels,
+	// and the labels applied to issues and pull requests.
+	// Labels are identified by name.
+	LabelService interface {
+		// Find returns the repository label by name.
+		Find(context.Context, string, string) (*Label, *Response, error)
+
+		// List returns the repository labels.
+		List(context.Context, string, ListOptions) ([]*Label, *Response, error)
+
+		// Create creates a new repository label.
+		Create(context.Context, string, *LabelInput) (*Label, *Response, error)
+
+		// Update updates the repository label by name.
+		Update(context.Context, string, string, *LabelInput) (*Label, *Response, error)
+
+		// Delete deletes the repository label by name.
+		Delete(context.Context, string, string) (*Response, error)
+
+		// AddIssueLabels adds labels to the issue.
+		AddIssueLabels(context.Context, string, int, []string) (*Response, error)
+
+		// RemoveIssueLabels removes labels from the issue.
+		RemoveIssueLabels(context.Context, string, int, []string) (*Response, error)
+
+		// SetIssueLabels replaces the issue labels.
+		SetIssueLabels(context.Context, string, int, []string) (*Response, error)
+
+		// AddPullRequestLabels adds labels to the pull
+		// request.
+		AddPullRequestLabels(context.Context, string, int, []string) (*Response, error)
+
+		// RemovePullRequestLabels removes labels from the
+		// pull request.
+		RemovePullRequestLabels(context.Context, string, int, []string) (*Response, error)
+
+		// SetPullRequestLabels replaces the pull request
+		// labels.
+		SetPullRequestLabels(context.Context, string, int, []string) (*Response, error)
+	}
+)
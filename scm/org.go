@@ -22,6 +22,28 @@ type (
 		Organization Organization
 	}
 
+	// Team represents an organization team. The team
+	// identifier is the team slug, or the team id if the
+	// provider does not support slugs.
+	Team struct {
+		ID          string
+		Name        string
+		Slug        string
+		Description string
+	}
+
+	// TeamInput provides the input fields required for
+	// creating or updating a team.
+	TeamInput struct {
+		Name        string
+		Description string
+
+		// Permission is the default permission granted to
+		// the team, where the provider stores permissions
+		// on the team instead of the repository.
+		Permission Permission
+	}
+
 	// OrganizationService provides access to organization resources.
 	OrganizationService interface {
 		// Find returns the organization by name.
@@ -36,5 +58,38 @@ type (
 
 		// List returns the user organization list.
 		List(ctx context.Context, opts ListOptions) ([]*Organization, *Response, error)
+
+		// ListTeams returns the organization team list.
+		ListTeams(ctx context.Context, name string, opts ListOptions) ([]*Team, *Response, error)
+
+		// FindTeam returns the organization team.
+		FindTeam(ctx context.Context, name, team string) (*Team, *Response, error)
+
+		// CreateTeam creates a new organization team.
+		CreateTeam(ctx context.Context, name string, input *TeamInput) (*Team, *Response, error)
+
+		// UpdateTeam updates an organization team.
+		UpdateTeam(ctx context.Context, name, team string, input *TeamInput) (*Team, *Response, error)
+
+		// DeleteTeam deletes an organization team.
+		DeleteTeam(ctx context.Context, name, team string) (*Response, error)
+
+		// ListTeamMembers returns the team member list.
+		ListTeamMembers(ctx context.Context, name, team string, opts ListOptions) ([]*User, *Response, error)
+
+		// AddTeamMember adds a user account to the team.
+		AddTeamMember(ctx context.Context, name, team, username string) (*Response, error)
+
+		// RemoveTeamMember removes a user account from the
+		// team.
+		RemoveTeamMember(ctx context.Context, name, team, username string) (*Response, error)
+
+		// AddTeamRepo grants the team permission to the
+		// repository.
+		AddTeamRepo(ctx context.Context, name, team, repo string, perm Permission) (*Response, error)
+
+		// RemoveTeamRepo revokes the team permission to the
+		// repository.
+		RemoveTeamRepo(ctx context.Context, name, team, repo string) (*Response, error)
 	}
 )
@@ -0,0 +1,89 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type (
+	// PipelineRun represents a run of a pipeline or
+	// workflow.
+	PipelineRun struct {
+		ID       string
+		Number   int
+		Pipeline string
+		Ref      string
+		Sha      string
+		Event    string
+		Status   ExecutionStatus
+		Link     string
+		Created  time.Time
+		Updated  time.Time
+
+		// Jobs is the list of jobs in the run. The jobs
+		// are only included when finding a single run.
+		Jobs []*PipelineJob
+	}
+
+	// PipelineJob represents a job in a pipeline run.
+	PipelineJob struct {
+		ID       string
+		Name     string
+		Status   ExecutionStatus
+		Link     string
+		Started  time.Time
+		Finished time.Time
+	}
+
+	// PipelineInput provides the input fields required for
+	// triggering a pipeline run.
+	PipelineInput struct {
+		// Pipeline identifies the pipeline or workflow,
+		// for example the workflow file name or the
+		// pipeline definition id. It is ignored by
+		// providers with a single pipeline per repository.
+		Pipeline string
+		Ref      string
+		Inputs   map[string]string
+	}
+
+	// PipelineListOptions specifies optional pipeline run
+	// search term and pagination parameters.
+	PipelineListOptions struct {
+		Pipeline string
+		Ref      string
+		Page     int
+		Size     int
+	}
+
+	// PipelineService provides access to pipeline and
+	// workflow runs.
+	PipelineService interface {
+		// Trigger triggers a pipeline run. Providers that
+		// do not return the run when it is triggered return
+		// a nil run.
+		Trigger(context.Context, string, *PipelineInput) (*PipelineRun, *Response, error)
+
+		// ListRuns returns a list of pipeline runs.
+		ListRuns(context.Context, string, PipelineListOptions) ([]*PipelineRun, *Response, error)
+
+		// FindRun returns a pipeline run with its jobs.
+		FindRun(context.Context, string, string) (*PipelineRun, *Response, error)
+
+		// CancelRun cancels a pipeline run.
+		CancelRun(context.Context, string, string) (*Response, error)
+
+		// RetryRun re-runs a pipeline run.
+		RetryRun(context.Context, string, string) (*Response, error)
+
+		// FindLogs returns the logs of a job in a pipeline
+		// run. The caller is responsible for closing the
+		// returned stream.
+		FindLogs(context.Context, string, string, string) (io.ReadCloser, *Response, error)
+	}
+)
@@ -32,6 +32,38 @@ type (
 		Created time.Time
 		Updated time.Time
 		Labels  []Label
+
+		// Reviewers are the users and teams requested to
+		// review the pull request, with their review state.
+		// GitHub only includes pending reviewers, and the
+		// users that submitted a review are returned by
+		// ListReviewers.
+		Reviewers []Reviewer
+
+		// Assignees are the users assigned to the pull
+		// request.
+		Assignees []User
+	}
+
+	// Reviewer represents a pull request reviewer.
+	Reviewer struct {
+		User User
+
+		// Team is the name of the team if the review is
+		// requested from a team.
+		Team string
+
+		// State is the review state of the reviewer. The
+		// state is unknown if the provider does not
+		// include the review state with the pull request.
+		State ReviewState
+	}
+
+	// ReviewerInput provides the users and teams to request
+	// or remove from a pull request review.
+	ReviewerInput struct {
+		Users []string
+		Teams []string
 	}
 
 	// PullRequestInput provides the input fields required for creating a pull request.
@@ -42,6 +74,18 @@ type (
 		Target string
 	}
 
+	// PullRequestUpdateInput provides the input fields for
+	// updating a pull request. Empty fields are not updated.
+	PullRequestUpdateInput struct {
+		Title  string
+		Body   string
+		Target string
+
+		// Draft optionally changes the draft state of the
+		// pull request. The draft state is not changed if nil.
+		Draft *bool
+	}
+
 	// PullRequestListOptions provides options for querying
 	// a list of repository merge requests.
 	PullRequestListOptions struct {
@@ -51,6 +95,28 @@ type (
 		Closed bool
 	}
 
+	// PullRequestMergeOptions provides options for merging
+	// a pull request.
+	PullRequestMergeOptions struct {
+		// Method is the merge method. The provider or
+		// repository default is used if not set.
+		Method MergeMethod
+
+		// CommitTitle and CommitMessage optionally override
+		// the merge or squash commit message.
+		CommitTitle   string
+		CommitMessage string
+
+		// SHA optionally specifies the expected head sha of
+		// the pull request. The merge fails if the head of
+		// the pull request does not match.
+		SHA string
+
+		// DeleteSourceBranch deletes the source branch
+		// after the pull request is merged.
+		DeleteSourceBranch bool
+	}
+
 	// Change represents a changed file.
 	Change struct {
 		Path         string
@@ -62,11 +128,6 @@ type (
 		PrevFilePath string
 	}
 
-	Label struct {
-		Name  string
-		Color string
-	}
-
 	// Milestone the milestone
 	Milestone struct {
 		Number      int
@@ -101,16 +162,51 @@ type (
 		// Merge merges the repository pull request.
 		Merge(context.Context, string, int) (*Response, error)
 
+		// MergeWithOptions merges the repository pull request
+		// using the merge options.
+		MergeWithOptions(context.Context, string, int, PullRequestMergeOptions) (*Response, error)
+
 		// Close closes the repository pull request.
 		Close(context.Context, string, int) (*Response, error)
 
+		// Reopen reopens a closed repository pull request.
+		Reopen(context.Context, string, int) (*Response, error)
+
+		// MarkReady marks a draft pull request as ready for
+		// review.
+		MarkReady(context.Context, string, int) (*Response, error)
+
+		// ConvertToDraft converts the pull request to a draft.
+		ConvertToDraft(context.Context, string, int) (*Response, error)
+
 		// Create creates a new pull request.
 		Create(context.Context, string, *PullRequestInput) (*PullRequest, *Response, error)
 
+		// Update updates the title, body, target branch or
+		// draft state of the pull request.
+		Update(context.Context, string, int, *PullRequestUpdateInput) (*PullRequest, *Response, error)
+
 		// CreateComment creates a new pull request comment.
 		CreateComment(context.Context, string, int, *CommentInput) (*Comment, *Response, error)
 
 		// DeleteComment deletes an pull request comment.
 		DeleteComment(context.Context, string, int, int) (*Response, error)
+
+		// RequestReviewers requests a review from the users
+		// and teams.
+		RequestReviewers(context.Context, string, int, *ReviewerInput) (*Response, error)
+
+		// RemoveReviewers removes the review request for the
+		// users and teams.
+		RemoveReviewers(context.Context, string, int, *ReviewerInput) (*Response, error)
+
+		// ListReviewers returns the pull request reviewers
+		// with their review state, including the users that
+		// submitted a review without being requested.
+		ListReviewers(context.Context, string, int) ([]Reviewer, *Response, error)
+
+		// SetAssignees replaces the pull request assignees
+		// with the users.
+		SetAssignees(context.Context, string, int, []string) (*Response, error)
 	}
 )
@@ -0,0 +1,113 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// BranchProtection represents the protection rules
+	// applied to a branch or branch pattern. Providers
+	// ignore rules they cannot represent.
+	BranchProtection struct {
+		// Branch is the branch name or pattern the rules
+		// are applied to.
+		Branch string
+
+		// RequiredReviews requires pull request reviews
+		// before merging. Reviews are not required if nil.
+		RequiredReviews *RequiredReviews
+
+		// RequiredStatusChecks requires status checks to
+		// pass before merging. Status checks are not
+		// required if nil.
+		RequiredStatusChecks *RequiredStatusChecks
+
+		// Push restricts who can push to the branch. Anyone
+		// with write access can push if nil.
+		Push *BranchRestrictions
+
+		// Merge restricts who can merge pull requests into
+		// the branch. Anyone with write access can merge
+		// if nil.
+		Merge *BranchRestrictions
+
+		// AllowForcePush permits force pushes to the branch.
+		AllowForcePush bool
+
+		// AllowDeletion permits the branch to be deleted.
+		AllowDeletion bool
+
+		// EnforceAdmins applies the rules to administrators.
+		EnforceAdmins bool
+	}
+
+	// BranchProtectionInput provides the input fields
+	// required for updating branch protection.
+	BranchProtectionInput struct {
+		RequiredReviews      *RequiredReviews
+		RequiredStatusChecks *RequiredStatusChecks
+		Push                 *BranchRestrictions
+		Merge                *BranchRestrictions
+		AllowForcePush       bool
+		AllowDeletion        bool
+		EnforceAdmins        bool
+	}
+
+	// RequiredReviews represents the pull request review
+	// requirements of a protected branch.
+	RequiredReviews struct {
+		// Count is the number of approvals required.
+		Count int
+
+		// DismissStale dismisses approvals when new
+		// commits are pushed.
+		DismissStale bool
+
+		// RequireCodeOwners requires approval from the
+		// code owners.
+		RequireCodeOwners bool
+	}
+
+	// RequiredStatusChecks represents the status check
+	// requirements of a protected branch.
+	RequiredStatusChecks struct {
+		// Strict requires the branch to be up to date
+		// with the base branch before merging.
+		Strict bool
+
+		// Contexts is the list of status checks that must
+		// pass before merging.
+		Contexts []string
+	}
+
+	// BranchRestrictions represents the users, teams and
+	// apps that are allowed to perform an action on a
+	// protected branch. Empty lists only allow
+	// administrators. Users and teams are identified by
+	// login and slug, or by id for providers that do not
+	// accept names, for example the GitLab user id or the
+	// Bitbucket account id.
+	BranchRestrictions struct {
+		Users []string
+		Teams []string
+		Apps  []string
+	}
+
+	// BranchProtectionService provides access to branch
+	// protection rules.
+	BranchProtectionService interface {
+		// Find returns the protection rules for the branch
+		// or branch pattern.
+		Find(context.Context, string, string) (*BranchProtection, *Response, error)
+
+		// Update creates or replaces the protection rules
+		// for the branch or branch pattern.
+		Update(context.Context, string, string, *BranchProtectionInput) (*BranchProtection, *Response, error)
+
+		// Delete removes the protection rules for the branch
+		// or branch pattern.
+		Delete(context.Context, string, string) (*Response, error)
+	}
+)
@@ -2,6 +2,7 @@ package scm
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -30,6 +31,26 @@ type (
 		Prerelease  bool
 	}
 
+	// ReleaseAsset represents a file attached to a release.
+	ReleaseAsset struct {
+		ID          string
+		Name        string
+		ContentType string
+		Size        int64
+		Link        string
+		Created     time.Time
+	}
+
+	// ReleaseAssetInput contains the information needed to
+	// upload a release asset. The body is streamed to the
+	// server and is not buffered in memory.
+	ReleaseAssetInput struct {
+		Name        string
+		ContentType string
+		Size        int64
+		Body        io.Reader
+	}
+
 	// ReleaseListOptions provides options for querying a list of repository releases.
 	ReleaseListOptions struct {
 		Page   int
@@ -63,5 +84,19 @@ type (
 
 		// DeleteByTag deletes a release in the given repository by tag
 		DeleteByTag(context.Context, string, string) (*Response, error)
+
+		// ListAssets returns a list of assets of the release for the given tag
+		ListAssets(context.Context, string, string, ListOptions) ([]*ReleaseAsset, *Response, error)
+
+		// UploadAsset uploads an asset to the release for the given tag
+		UploadAsset(context.Context, string, string, *ReleaseAssetInput) (*ReleaseAsset, *Response, error)
+
+		// DownloadAsset downloads an asset of the release for the given tag.
+		// The caller is responsible for closing the returned stream.
+		// Assets stored on another host are downloaded without credentials.
+		DownloadAsset(context.Context, string, string, string) (io.ReadCloser, *Response, error)
+
+		// DeleteAsset deletes an asset of the release for the given tag
+		DeleteAsset(context.Context, string, string, string) (*Response, error)
 	}
 )
@@ -27,6 +27,61 @@ type (
 		Updated    time.Time
 	}
 
+	// RepositoryInput provides the input fields required for
+	// creating a repository.
+	RepositoryInput struct {
+		// Namespace is the organization or group in which
+		// the repository is created. The repository is
+		// created in the namespace of the authenticated
+		// user if empty.
+		Namespace   string
+		Name        string
+		Description string
+		Visibility  Visibility
+
+		// Branch is the name of the default branch.
+		Branch string
+
+		// AutoInit creates the repository with an initial
+		// commit, so the default branch exists.
+		AutoInit bool
+
+		// Template is the name of the template repository
+		// from which the repository is generated.
+		Template string
+	}
+
+	// RepositoryUpdateInput provides the input fields
+	// required for updating a repository. Empty fields are
+	// not updated.
+	RepositoryUpdateInput struct {
+		// Name is the new repository name, which renames
+		// the repository.
+		Name        string
+		Description string
+		Visibility  Visibility
+
+		// Branch is the name of the default branch.
+		Branch string
+
+		// Archived archives or unarchives the repository,
+		// and is not changed if nil.
+		Archived *bool
+	}
+
+	// ForkInput provides the input fields required for
+	// forking a repository.
+	ForkInput struct {
+		// Namespace is the organization or group in which
+		// the fork is created. The fork is created in the
+		// namespace of the authenticated user if empty.
+		Namespace string
+
+		// Name is the name of the fork, which defaults to
+		// the name of the forked repository.
+		Name string
+	}
+
 	// Perm represents a user's repository permissions.
 	Perm struct {
 		Pull  bool
@@ -34,6 +89,13 @@ type (
 		Admin bool
 	}
 
+	// Collaborator represents a user with access to the
+	// repository, and the user's permission level.
+	Collaborator struct {
+		User       User
+		Permission Permission
+	}
+
 	// Hook represents a repository hook.
 	Hook struct {
 		ID         string
@@ -97,16 +159,6 @@ type (
 		Target string
 	}
 
-	// DeployStatus represents a deployment status.
-	DeployStatus struct {
-		Number         int64
-		State          State
-		Desc           string
-		Target         string
-		Environment    string
-		EnvironmentURL string
-	}
-
 	// RepositoryService provides access to repository resources.
 	RepositoryService interface {
 		// Find returns a repository by name.
@@ -150,10 +202,32 @@ type (
 
 		// DeleteHook deletes a repository hook.
 		DeleteHook(context.Context, string, string) (*Response, error)
+
+		// Create creates a new repository.
+		Create(context.Context, *RepositoryInput) (*Repository, *Response, error)
+
+		// Fork forks a repository.
+		Fork(context.Context, string, *ForkInput) (*Repository, *Response, error)
+
+		// Update updates a repository.
+		Update(context.Context, string, *RepositoryUpdateInput) (*Repository, *Response, error)
+
+		// Delete deletes a repository.
+		Delete(context.Context, string) (*Response, error)
+
+		// Transfer transfers a repository to a namespace.
+		Transfer(context.Context, string, string) (*Repository, *Response, error)
+
+		// ListCollaborators returns a list of users with
+		// access to the repository.
+		ListCollaborators(context.Context, string, ListOptions) ([]*Collaborator, *Response, error)
+
+		// AddCollaborator grants a user permission to the
+		// repository.
+		AddCollaborator(context.Context, string, string, Permission) (*Response, error)
+
+		// RemoveCollaborator revokes a user permission to
+		// the repository.
+		RemoveCollaborator(context.Context, string, string) (*Response, error)
 	}
 )
-
-// TODO(bradrydzewski): Add endpoint to get a repository deploy key
-// TODO(bradrydzewski): Add endpoint to list repository deploy keys
-// TODO(bradrydzewski): Add endpoint to create a repository deploy key
-// TODO(bradrydzewski): Add endpoint to delete a repository deploy key
@@ -12,15 +12,21 @@ import (
 type (
 	// Review represents a review comment.
 	Review struct {
-		ID      int
-		Body    string
-		Path    string
-		Sha     string
-		Line    int
-		Link    string
-		Author  User
-		Created time.Time
-		Updated time.Time
+		ID        int
+		Body      string
+		Path      string
+		Sha       string
+		Line      int
+		StartLine int
+		Side      ReviewSide
+		Link      string
+		Author    User
+		Created   time.Time
+		Updated   time.Time
+
+		// Position is the line index in the diff, which is
+		// only reported by GitHub.
+		Position int
 	}
 
 	// ReviewInput provides the input fields required for
@@ -29,7 +35,69 @@ type (
 		Body string
 		Sha  string
 		Path string
+
+		// Line is the line number in the file the comment
+		// applies to, on the side of the diff given by Side.
 		Line int
+
+		// Position optionally positions the comment by its
+		// line index in the diff instead of by Line. It is
+		// only supported by GitHub.
+		Position int
+
+		// StartLine optionally specifies the first line of
+		// a multi-line comment. Line is the last line of
+		// the range.
+		StartLine int
+
+		// Side optionally specifies the side of the diff
+		// the comment applies to. The new (right) side is
+		// used if not set.
+		Side ReviewSide
+	}
+
+	// ReviewThread represents a thread of review comments
+	// on the pull request diff.
+	ReviewThread struct {
+		ID        string
+		Path      string
+		Line      int
+		StartLine int
+		Side      ReviewSide
+		Resolved  bool
+		Comments  []*Review
+	}
+
+	// PullRequestReview represents a submitted pull request
+	// review.
+	PullRequestReview struct {
+		ID        int
+		Body      string
+		State     ReviewState
+		Sha       string
+		Link      string
+		Author    User
+		Submitted time.Time
+	}
+
+	// PullRequestReviewInput provides the input fields
+	// required for submitting a pull request review.
+	PullRequestReviewInput struct {
+		// Body is the review summary.
+		Body string
+
+		// State is the review verdict, and must be one of
+		// ReviewStateApproved, ReviewStateChangesRequested
+		// or ReviewStateCommented.
+		State ReviewState
+
+		// Sha optionally specifies the commit being
+		// reviewed. The head commit is used if not set.
+		Sha string
+
+		// Comments are the inline review comments submitted
+		// with the review.
+		Comments []*ReviewInput
 	}
 
 	// ReviewService provides access to review resources.
@@ -45,5 +113,31 @@ type (
 
 		// Delete deletes a review comment.
 		Delete(context.Context, string, int, int) (*Response, error)
+
+		// ListReviews returns the pull request review list.
+		ListReviews(context.Context, string, int, ListOptions) ([]*PullRequestReview, *Response, error)
+
+		// Submit submits a pull request review with the
+		// inline review comments.
+		Submit(context.Context, string, int, *PullRequestReviewInput) (*PullRequestReview, *Response, error)
+
+		// Dismiss dismisses the pull request review by id
+		// with a message.
+		Dismiss(context.Context, string, int, int, string) (*PullRequestReview, *Response, error)
+
+		// ListThreads returns the review thread list. GitHub
+		// pages by cursor, which is returned in Page.NextURL
+		// and must be passed back in ListOptions.URL.
+		ListThreads(context.Context, string, int, ListOptions) ([]*ReviewThread, *Response, error)
+
+		// Reply creates a review comment in reply to the
+		// review thread.
+		Reply(context.Context, string, int, string, *CommentInput) (*Review, *Response, error)
+
+		// Resolve marks the review thread as resolved.
+		Resolve(context.Context, string, int, string) (*Response, error)
+
+		// Unresolve marks the review thread as unresolved.
+		Unresolve(context.Context, string, int, string) (*Response, error)
 	}
 )
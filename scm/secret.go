@@ -0,0 +1,91 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Secret represents a pipeline secret or variable. The
+	// secret value is write-only and is never returned.
+	Secret struct {
+		Name    string
+		Masked  bool
+		Created time.Time
+		Updated time.Time
+	}
+
+	// SecretInput provides the input fields required for
+	// creating or updating a secret.
+	SecretInput struct {
+		Name  string
+		Value string
+
+		// Masked hides the value in pipeline logs. It is
+		// ignored by providers that always mask secrets.
+		Masked bool
+
+		// Visibility optionally sets the repositories that
+		// can access an organization secret. The visibility
+		// of an existing secret is unchanged if undefined. It
+		// is ignored by providers without secret visibility.
+		Visibility SecretVisibility
+
+		// SelectedRepos are the ids of the repositories that
+		// can access an organization secret if the visibility
+		// is SecretVisibilitySelected.
+		SelectedRepos []string
+	}
+
+	// SecretService provides access to the pipeline secrets
+	// and variables of a repository, an organization, or a
+	// repository environment. Providers that encrypt secrets
+	// on the client encrypt the value before it is sent.
+	SecretService interface {
+		// ListRepoSecrets returns a list of repository
+		// secrets.
+		ListRepoSecrets(context.Context, string, ListOptions) ([]*Secret, *Response, error)
+
+		// CreateRepoSecret creates a repository secret.
+		CreateRepoSecret(context.Context, string, *SecretInput) (*Response, error)
+
+		// UpdateRepoSecret updates a repository secret.
+		UpdateRepoSecret(context.Context, string, *SecretInput) (*Response, error)
+
+		// DeleteRepoSecret deletes a repository secret.
+		DeleteRepoSecret(context.Context, string, string) (*Response, error)
+
+		// ListOrgSecrets returns a list of organization
+		// secrets.
+		ListOrgSecrets(context.Context, string, ListOptions) ([]*Secret, *Response, error)
+
+		// CreateOrgSecret creates an organization secret.
+		CreateOrgSecret(context.Context, string, *SecretInput) (*Response, error)
+
+		// UpdateOrgSecret updates an organization secret.
+		UpdateOrgSecret(context.Context, string, *SecretInput) (*Response, error)
+
+		// DeleteOrgSecret deletes an organization secret.
+		DeleteOrgSecret(context.Context, string, string) (*Response, error)
+
+		// ListEnvSecrets returns a list of secrets for the
+		// named repository environment.
+		ListEnvSecrets(context.Context, string, string, ListOptions) ([]*Secret, *Response, error)
+
+		// CreateEnvSecret creates a secret for the named
+		// repository environment.
+		CreateEnvSecret(context.Context, string, string, *SecretInput) (*Response, error)
+
+		// UpdateEnvSecret updates a secret for the named
+		// repository environment.
+		UpdateEnvSecret(context.Context, string, string, *SecretInput) (*Response, error)
+
+		// DeleteEnvSecret deletes a secret for the named
+		// repository environment.
+		DeleteEnvSecret(context.Context, string, string, string) (*Response, error)
+	}
+)
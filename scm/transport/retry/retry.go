@@ -0,0 +1,279 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package retry provides an http.RoundTripper that retries
+// rate limited and failed requests.
+package retry
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Default values used when the corresponding Transport
+// field is zero.
+const (
+	DefaultRetries    = 3
+	DefaultBackoff    = time.Second
+	DefaultMaxBackoff = 30 * time.Second
+	DefaultMaxWait    = time.Minute
+)
+
+// Transport is an http.RoundTripper that retries requests
+// that are rate limited (429, or 403 secondary rate limit)
+// or fail with a 5xx server error, using exponential
+// backoff with jitter. It honors the Retry-After header and
+// the rate limit reset time, and optionally pauses before
+// sending requests once the remaining rate limit drops to
+// the configured threshold.
+//
+// Server errors are only retried for idempotent methods,
+// and requests with a body are only retried if the body
+// can be rewound using http.Request.GetBody.
+type Transport struct {
+	Base http.RoundTripper
+
+	// Retries is the maximum number of retries.
+	Retries int
+
+	// Backoff is the initial backoff duration, doubled
+	// with each retry.
+	Backoff time.Duration
+
+	// MaxBackoff is the maximum backoff duration.
+	MaxBackoff time.Duration
+
+	// MaxWait is the maximum duration to wait for the rate
+	// limit to reset. A rate limited response that requires
+	// a longer wait is returned without retrying, and the
+	// proactive pause is capped to this duration.
+	MaxWait time.Duration
+
+	// Threshold pauses requests until the rate limit resets
+	// once the remaining rate limit is at or below this
+	// value. The pause is disabled if zero.
+	Threshold int
+
+	mu   sync.Mutex
+	rate scm.Rate
+}
+
+// RoundTrip executes the request, retrying rate limited or
+// failed requests.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	if err := sleep(ctx, t.pause()); err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		req := r
+		if attempt > 0 && r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = r.Clone(ctx)
+			req.Body = body
+		}
+		res, err := t.base().RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		rate := scm.ParseRate(res.Header)
+		if rate.Limit > 0 {
+			t.mu.Lock()
+			t.rate = rate
+			t.mu.Unlock()
+		}
+		if attempt >= t.retries() || !t.retryable(r, res, rate) {
+			return res, nil
+		}
+		wait, ok := t.wait(res, rate, attempt)
+		if !ok {
+			return res, nil
+		}
+		drain(res.Body)
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether the request should be retried
+// given the response.
+func (t *Transport) retryable(r *http.Request, res *http.Response, rate scm.Rate) bool {
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return false
+	}
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode == http.StatusForbidden:
+		return limited(res, rate)
+	case res.StatusCode >= 500:
+		return idempotent(r.Method)
+	}
+	return false
+}
+
+// wait returns the duration to wait before retrying. It
+// returns false if the wait would exceed the maximum wait.
+func (t *Transport) wait(res *http.Response, rate scm.Rate, attempt int) (time.Duration, bool) {
+	if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+		return d, d <= t.maxWait()
+	}
+	if limited(res, rate) && rate.Reset > 0 {
+		d := time.Until(time.Unix(rate.Reset, 0))
+		if d > 0 {
+			return d, d <= t.maxWait()
+		}
+	}
+	return t.backoff(attempt), true
+}
+
+// backoff returns the exponential backoff duration for the
+// attempt, with jitter applied.
+func (t *Transport) backoff(attempt int) time.Duration {
+	d := t.initialBackoff() << uint(attempt)
+	if d <= 0 || d > t.maxBackoff() {
+		d = t.maxBackoff()
+	}
+	// apply equal jitter, so the backoff is between half
+	// and the full duration.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// pause returns the duration to wait before sending the
+// request if the remaining rate limit is at or below the
+// threshold.
+func (t *Transport) pause() time.Duration {
+	if t.Threshold <= 0 {
+		return 0
+	}
+	t.mu.Lock()
+	rate := t.rate
+	t.mu.Unlock()
+	if rate.Limit == 0 || rate.Remaining > t.Threshold {
+		return 0
+	}
+	d := time.Until(time.Unix(rate.Reset, 0))
+	if d > t.maxWait() {
+		d = t.maxWait()
+	}
+	return d
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) retries() int {
+	if t.Retries == 0 {
+		return DefaultRetries
+	}
+	return t.Retries
+}
+
+func (t *Transport) initialBackoff() time.Duration {
+	if t.Backoff == 0 {
+		return DefaultBackoff
+	}
+	return t.Backoff
+}
+
+func (t *Transport) maxBackoff() time.Duration {
+	if t.MaxBackoff == 0 {
+		return DefaultMaxBackoff
+	}
+	return t.MaxBackoff
+}
+
+func (t *Transport) maxWait() time.Duration {
+	if t.MaxWait == 0 {
+		return DefaultMaxWait
+	}
+	return t.MaxWait
+}
+
+// limited reports whether the response indicates the rate
+// limit is exceeded. GitHub returns a 403 status with the
+// Retry-After header for secondary rate limits, and a 403
+// status with zero remaining for primary rate limits.
+func limited(res *http.Response, rate scm.Rate) bool {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if res.Header.Get("Retry-After") != "" {
+		return true
+	}
+	return rate.Limit > 0 && rate.Remaining == 0
+}
+
+// idempotent reports whether the http method is idempotent.
+func idempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, which is either
+// a number of seconds or an http date.
+func retryAfter(s string) (time.Duration, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(s); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if date, err := http.ParseTime(s); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleep waits for the duration to elapse, returning early
+// with an error if the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// drain reads and closes the response body so the
+// underlying connection can be reused.
+func drain(body io.ReadCloser) {
+	io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	body.Close()
+}
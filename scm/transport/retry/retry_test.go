@@ -0,0 +1,252 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package retry
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// server returns a test server that replies with the
+// given status codes in order, followed by 200.
+func server(t *testing.T, codes []int, headers http.Header, bodies *[]string) (*httptest.Server, *int) {
+	var count int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if bodies != nil {
+			raw, _ := ioutil.ReadAll(r.Body)
+			*bodies = append(*bodies, string(raw))
+		}
+		count++
+		if count <= len(codes) {
+			for k, v := range headers {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(codes[count-1])
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	return srv, &count
+}
+
+func TestTransport_ServerError(t *testing.T) {
+	srv, count := server(t, []int{503, 502}, nil, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{Backoff: time.Millisecond}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 3; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_ServerErrorNotIdempotent(t *testing.T) {
+	srv, count := server(t, []int{500}, nil, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{Backoff: time.Millisecond}}
+	res, err := client.Post(srv.URL, "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 500; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 1; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_RetryAfter(t *testing.T) {
+	var bodies []string
+	headers := http.Header{"Retry-After": {"0"}}
+	srv, count := server(t, []int{429}, headers, &bodies)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{}}
+	res, err := client.Post(srv.URL, "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 2; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+	for _, body := range bodies {
+		if body != "hello" {
+			t.Errorf("Want request body replayed, got %q", body)
+		}
+	}
+}
+
+func TestTransport_SecondaryRateLimit(t *testing.T) {
+	headers := http.Header{
+		"X-Ratelimit-Limit":     {"5000"},
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Unix(), 10)},
+	}
+	srv, count := server(t, []int{403}, headers, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{Backoff: time.Millisecond}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 200; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 2; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_Forbidden(t *testing.T) {
+	srv, count := server(t, []int{403}, nil, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{Backoff: time.Millisecond}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 403; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 1; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_MaxWait(t *testing.T) {
+	headers := http.Header{"Retry-After": {"3600"}}
+	srv, count := server(t, []int{429}, headers, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{MaxWait: time.Second}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 429; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 1; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_Retries(t *testing.T) {
+	srv, count := server(t, []int{500, 500, 500}, nil, nil)
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{Retries: 2, Backoff: time.Millisecond}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.StatusCode, 500; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+	if got, want := *count, 3; got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+}
+
+func TestTransport_Canceled(t *testing.T) {
+	srv, _ := server(t, []int{503}, nil, nil)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	req = req.WithContext(ctx)
+
+	client := &http.Client{Transport: &Transport{Backoff: time.Minute, MaxBackoff: time.Minute}}
+	_, err := client.Do(req)
+	if err == nil {
+		t.Errorf("Expect context deadline error")
+	}
+}
+
+func TestTransport_Threshold(t *testing.T) {
+	reset := time.Now().Add(50 * time.Millisecond)
+	headers := http.Header{
+		"Ratelimit-Limit":     {"600"},
+		"Ratelimit-Remaining": {"1"},
+		"Ratelimit-Reset":     {strconv.FormatInt(reset.Unix()+1, 10)},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range headers {
+			w.Header()[k] = v
+		}
+	}))
+	defer srv.Close()
+
+	tr := &Transport{Threshold: 5, MaxWait: 20 * time.Millisecond}
+	client := &http.Client{Transport: tr}
+	if _, err := client.Get(srv.URL); err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := tr.pause(), 20*time.Millisecond; got != want {
+		t.Errorf("Want pause capped at %s, got %s", want, got)
+	}
+
+	start := time.Now()
+	if _, err := client.Get(srv.URL); err != nil {
+		t.Error(err)
+		return
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Errorf("Expect request paused until the rate limit resets")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if d, ok := retryAfter("120"); !ok || d != 2*time.Minute {
+		t.Errorf("Want 2m retry after, got %s", d)
+	}
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := retryAfter(date); !ok || d < 59*time.Minute {
+		t.Errorf("Want 1h retry after, got %s", d)
+	}
+	if _, ok := retryAfter(""); ok {
+		t.Errorf("Expect empty retry after ignored")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tr := &Transport{Backoff: time.Second, MaxBackoff: 4 * time.Second}
+	for attempt, max := range []time.Duration{1, 2, 4, 4, 4} {
+		max = max * time.Second
+		d := tr.backoff(attempt)
+		if d < max/2 || d > max {
+			t.Errorf("Want backoff between %s and %s, got %s", max/2, max, d)
+		}
+	}
+}
@@ -1,3 +1,8 @@
 // Package traverse provides facilities for traversing
 // and combining the paginated results.
+//
+// The Walk functions request one page at a time and call
+// the walk function for each item, so large result sets
+// are never buffered in memory. The remaining functions
+// combine the traversed items into a single list.
 package traverse
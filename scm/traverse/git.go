@@ -0,0 +1,162 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Branches returns the full branch list, traversing and
+// combining paginated responses.
+func Branches(ctx context.Context, client *scm.Client, repo string, opts Options) ([]*scm.Reference, error) {
+	list := []*scm.Reference{}
+	err := WalkBranches(ctx, client, repo, opts, func(ref *scm.Reference) error {
+		list = append(list, ref)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkBranches calls fn for each branch, requesting one
+// page at a time.
+func WalkBranches(ctx context.Context, client *scm.Client, repo string, opts Options, fn func(*scm.Reference) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.ListBranches(ctx, repo, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Reference))
+	})
+}
+
+// BranchesV2 returns the branch list matching the search
+// term, traversing and combining paginated responses.
+func BranchesV2(ctx context.Context, client *scm.Client, repo, term string, opts Options) ([]*scm.Reference, error) {
+	list := []*scm.Reference{}
+	err := WalkBranchesV2(ctx, client, repo, term, opts, func(ref *scm.Reference) error {
+		list = append(list, ref)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkBranchesV2 calls fn for each branch matching the
+// search term, requesting one page at a time.
+func WalkBranchesV2(ctx context.Context, client *scm.Client, repo, term string, opts Options, fn func(*scm.Reference) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.ListBranchesV2(ctx, repo, scm.BranchListOptions{
+			SearchTerm:      term,
+			PageListOptions: page,
+		})
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Reference))
+	})
+}
+
+// Tags returns the full tag list, traversing and combining
+// paginated responses.
+func Tags(ctx context.Context, client *scm.Client, repo string, opts Options) ([]*scm.Reference, error) {
+	list := []*scm.Reference{}
+	err := WalkTags(ctx, client, repo, opts, func(ref *scm.Reference) error {
+		list = append(list, ref)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkTags calls fn for each tag, requesting one page at a
+// time.
+func WalkTags(ctx context.Context, client *scm.Client, repo string, opts Options, fn func(*scm.Reference) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.ListTags(ctx, repo, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Reference))
+	})
+}
+
+// Commits returns the full commit list, traversing and
+// combining paginated responses. The Page and Size fields
+// of the commit list options are ignored.
+func Commits(ctx context.Context, client *scm.Client, repo string, filter scm.CommitListOptions, opts Options) ([]*scm.Commit, error) {
+	list := []*scm.Commit{}
+	err := WalkCommits(ctx, client, repo, filter, opts, func(commit *scm.Commit) error {
+		list = append(list, commit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkCommits calls fn for each commit, requesting one page
+// at a time. The Page and Size fields of the commit list
+// options are ignored.
+func WalkCommits(ctx context.Context, client *scm.Client, repo string, filter scm.CommitListOptions, opts Options, fn func(*scm.Commit) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		filter.Page = page.Page
+		filter.Size = page.Size
+		return client.Git.ListCommits(ctx, repo, filter)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Commit))
+	})
+}
+
+// Changes returns the full changeset of a commit,
+// traversing and combining paginated responses.
+func Changes(ctx context.Context, client *scm.Client, repo, ref string, opts Options) ([]*scm.Change, error) {
+	list := []*scm.Change{}
+	err := WalkChanges(ctx, client, repo, ref, opts, func(change *scm.Change) error {
+		list = append(list, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkChanges calls fn for each change in the changeset of
+// a commit, requesting one page at a time.
+func WalkChanges(ctx context.Context, client *scm.Client, repo, ref string, opts Options, fn func(*scm.Change) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.ListChanges(ctx, repo, ref, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Change))
+	})
+}
+
+// CompareChanges returns the full changeset between two
+// commits, traversing and combining paginated responses.
+func CompareChanges(ctx context.Context, client *scm.Client, repo, source, target string, opts Options) ([]*scm.Change, error) {
+	list := []*scm.Change{}
+	err := WalkCompareChanges(ctx, client, repo, source, target, opts, func(change *scm.Change) error {
+		list = append(list, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkCompareChanges calls fn for each change between two
+// commits, requesting one page at a time.
+func WalkCompareChanges(ctx context.Context, client *scm.Client, repo, source, target string, opts Options, fn func(*scm.Change) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Git.CompareChanges(ctx, repo, source, target, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Change))
+	})
+}
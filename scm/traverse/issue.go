@@ -0,0 +1,119 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Issues returns the full issue list, traversing and
+// combining paginated responses. The Page and Size fields
+// of the list options are ignored.
+func Issues(ctx context.Context, client *scm.Client, repo string, filter scm.IssueListOptions, opts Options) ([]*scm.Issue, error) {
+	list := []*scm.Issue{}
+	err := WalkIssues(ctx, client, repo, filter, opts, func(issue *scm.Issue) error {
+		list = append(list, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkIssues calls fn for each issue, requesting one page
+// at a time. The Page and Size fields of the list options
+// are ignored.
+func WalkIssues(ctx context.Context, client *scm.Client, repo string, filter scm.IssueListOptions, opts Options, fn func(*scm.Issue) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		filter.Page = page.Page
+		filter.Size = page.Size
+		return client.Issues.List(ctx, repo, filter)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Issue))
+	})
+}
+
+// IssueComments returns the full issue comment list,
+// traversing and combining paginated responses.
+func IssueComments(ctx context.Context, client *scm.Client, repo string, number int, opts Options) ([]*scm.Comment, error) {
+	list := []*scm.Comment{}
+	err := WalkIssueComments(ctx, client, repo, number, opts, func(comment *scm.Comment) error {
+		list = append(list, comment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkIssueComments calls fn for each issue comment,
+// requesting one page at a time.
+func WalkIssueComments(ctx context.Context, client *scm.Client, repo string, number int, opts Options, fn func(*scm.Comment) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Issues.ListComments(ctx, repo, number, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Comment))
+	})
+}
+
+// Milestones returns the full milestone list, traversing
+// and combining paginated responses. The Page and Size
+// fields of the list options are ignored.
+func Milestones(ctx context.Context, client *scm.Client, repo string, filter scm.MilestoneListOptions, opts Options) ([]*scm.Milestone, error) {
+	list := []*scm.Milestone{}
+	err := WalkMilestones(ctx, client, repo, filter, opts, func(milestone *scm.Milestone) error {
+		list = append(list, milestone)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkMilestones calls fn for each milestone, requesting
+// one page at a time. The Page and Size fields of the list
+// options are ignored.
+func WalkMilestones(ctx context.Context, client *scm.Client, repo string, filter scm.MilestoneListOptions, opts Options, fn func(*scm.Milestone) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		filter.Page = page.Page
+		filter.Size = page.Size
+		return client.Milestones.List(ctx, repo, filter)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Milestone))
+	})
+}
+
+// Releases returns the full release list, traversing and
+// combining paginated responses. The Page and Size fields
+// of the list options are ignored.
+func Releases(ctx context.Context, client *scm.Client, repo string, filter scm.ReleaseListOptions, opts Options) ([]*scm.Release, error) {
+	list := []*scm.Release{}
+	err := WalkReleases(ctx, client, repo, filter, opts, func(release *scm.Release) error {
+		list = append(list, release)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkReleases calls fn for each release, requesting one
+// page at a time. The Page and Size fields of the list
+// options are ignored.
+func WalkReleases(ctx context.Context, client *scm.Client, repo string, filter scm.ReleaseListOptions, opts Options, fn func(*scm.Release) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		filter.Page = page.Page
+		filter.Size = page.Size
+		return client.Releases.List(ctx, repo, filter)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Release))
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Organizations returns the full user organization list,
+// traversing and combining paginated responses.
+func Organizations(ctx context.Context, client *scm.Client, opts Options) ([]*scm.Organization, error) {
+	list := []*scm.Organization{}
+	err := WalkOrganizations(ctx, client, opts, func(org *scm.Organization) error {
+		list = append(list, org)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkOrganizations calls fn for each user organization,
+// requesting one page at a time.
+func WalkOrganizations(ctx context.Context, client *scm.Client, opts Options, fn func(*scm.Organization) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Organizations.List(ctx, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Organization))
+	})
+}
+
+// Emails returns the full user email list, traversing and
+// combining paginated responses.
+func Emails(ctx context.Context, client *scm.Client, opts Options) ([]*scm.Email, error) {
+	list := []*scm.Email{}
+	err := WalkEmails(ctx, client, opts, func(email *scm.Email) error {
+		list = append(list, email)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkEmails calls fn for each user email, requesting one
+// page at a time.
+func WalkEmails(ctx context.Context, client *scm.Client, opts Options, fn func(*scm.Email) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Users.ListEmail(ctx, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Email))
+	})
+}
+
+// Installations returns the full app installation list,
+// traversing and combining paginated responses.
+func Installations(ctx context.Context, client *scm.Client, opts Options) ([]*scm.Installation, error) {
+	list := []*scm.Installation{}
+	err := WalkInstallations(ctx, client, opts, func(installation *scm.Installation) error {
+		list = append(list, installation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkInstallations calls fn for each app installation,
+// requesting one page at a time.
+func WalkInstallations(ctx context.Context, client *scm.Client, opts Options, fn func(*scm.Installation) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Apps.ListInstallations(ctx, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Installation))
+	})
+}
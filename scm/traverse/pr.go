@@ -0,0 +1,136 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// PullRequests returns the full pull request list,
+// traversing and combining paginated responses. The Page
+// and Size fields of the list options are ignored.
+func PullRequests(ctx context.Context, client *scm.Client, repo string, filter scm.PullRequestListOptions, opts Options) ([]*scm.PullRequest, error) {
+	list := []*scm.PullRequest{}
+	err := WalkPullRequests(ctx, client, repo, filter, opts, func(pr *scm.PullRequest) error {
+		list = append(list, pr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkPullRequests calls fn for each pull request,
+// requesting one page at a time. The Page and Size fields
+// of the list options are ignored.
+func WalkPullRequests(ctx context.Context, client *scm.Client, repo string, filter scm.PullRequestListOptions, opts Options, fn func(*scm.PullRequest) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		filter.Page = page.Page
+		filter.Size = page.Size
+		return client.PullRequests.List(ctx, repo, filter)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.PullRequest))
+	})
+}
+
+// PullRequestChanges returns the full pull request
+// changeset, traversing and combining paginated responses.
+func PullRequestChanges(ctx context.Context, client *scm.Client, repo string, number int, opts Options) ([]*scm.Change, error) {
+	list := []*scm.Change{}
+	err := WalkPullRequestChanges(ctx, client, repo, number, opts, func(change *scm.Change) error {
+		list = append(list, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkPullRequestChanges calls fn for each change in the
+// pull request changeset, requesting one page at a time.
+func WalkPullRequestChanges(ctx context.Context, client *scm.Client, repo string, number int, opts Options, fn func(*scm.Change) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.PullRequests.ListChanges(ctx, repo, number, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Change))
+	})
+}
+
+// PullRequestComments returns the full pull request
+// comment list, traversing and combining paginated
+// responses.
+func PullRequestComments(ctx context.Context, client *scm.Client, repo string, number int, opts Options) ([]*scm.Comment, error) {
+	list := []*scm.Comment{}
+	err := WalkPullRequestComments(ctx, client, repo, number, opts, func(comment *scm.Comment) error {
+		list = append(list, comment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkPullRequestComments calls fn for each pull request
+// comment, requesting one page at a time.
+func WalkPullRequestComments(ctx context.Context, client *scm.Client, repo string, number int, opts Options, fn func(*scm.Comment) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.PullRequests.ListComments(ctx, repo, number, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Comment))
+	})
+}
+
+// PullRequestCommits returns the full pull request commit
+// list, traversing and combining paginated responses.
+func PullRequestCommits(ctx context.Context, client *scm.Client, repo string, number int, opts Options) ([]*scm.Commit, error) {
+	list := []*scm.Commit{}
+	err := WalkPullRequestCommits(ctx, client, repo, number, opts, func(commit *scm.Commit) error {
+		list = append(list, commit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkPullRequestCommits calls fn for each pull request
+// commit, requesting one page at a time.
+func WalkPullRequestCommits(ctx context.Context, client *scm.Client, repo string, number int, opts Options, fn func(*scm.Commit) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.PullRequests.ListCommits(ctx, repo, number, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Commit))
+	})
+}
+
+// Reviews returns the full review comment list, traversing
+// and combining paginated responses.
+func Reviews(ctx context.Context, client *scm.Client, repo string, number int, opts Options) ([]*scm.Review, error) {
+	list := []*scm.Review{}
+	err := WalkReviews(ctx, client, repo, number, opts, func(review *scm.Review) error {
+		list = append(list, review)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkReviews calls fn for each review comment, requesting
+// one page at a time.
+func WalkReviews(ctx context.Context, client *scm.Client, repo string, number int, opts Options, fn func(*scm.Review) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Reviews.List(ctx, repo, number, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Review))
+	})
+}
@@ -13,24 +13,128 @@ import (
 // Repos returns the full repository list, traversing and
 // combining paginated responses if necessary.
 func Repos(ctx context.Context, client *scm.Client) ([]*scm.Repository, error) {
+	return ReposWithOptions(ctx, client, Options{})
+}
+
+// ReposWithOptions returns the repository list, traversing
+// and combining paginated responses up to the item limit.
+func ReposWithOptions(ctx context.Context, client *scm.Client, opts Options) ([]*scm.Repository, error) {
+	list := []*scm.Repository{}
+	err := WalkRepos(ctx, client, opts, func(repo *scm.Repository) error {
+		list = append(list, repo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkRepos calls fn for each repository in the repository
+// list, requesting one page at a time.
+func WalkRepos(ctx context.Context, client *scm.Client, opts Options, fn func(*scm.Repository) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.List(ctx, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Repository))
+	})
+}
+
+// ReposV2 returns the repository list matching the search
+// term, traversing and combining paginated responses.
+func ReposV2(ctx context.Context, client *scm.Client, term scm.RepoSearchTerm, opts Options) ([]*scm.Repository, error) {
+	list := []*scm.Repository{}
+	err := WalkReposV2(ctx, client, term, opts, func(repo *scm.Repository) error {
+		list = append(list, repo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkReposV2 calls fn for each repository matching the
+// search term, requesting one page at a time.
+func WalkReposV2(ctx context.Context, client *scm.Client, term scm.RepoSearchTerm, opts Options, fn func(*scm.Repository) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.ListV2(ctx, scm.RepoListOptions{
+			ListOptions:    page,
+			RepoSearchTerm: term,
+		})
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Repository))
+	})
+}
+
+// NamespaceRepos returns the repository list for the
+// namespace, traversing and combining paginated responses.
+func NamespaceRepos(ctx context.Context, client *scm.Client, namespace string, opts Options) ([]*scm.Repository, error) {
 	list := []*scm.Repository{}
-	opts := scm.ListOptions{Size: 100}
-	for {
-		result, meta, err := client.Repositories.List(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-		for _, src := range result {
-			if src != nil {
-				list = append(list, src)
-			}
-		}
-		opts.Page = meta.Page.Next
-		opts.URL = meta.Page.NextURL
-
-		if opts.Page == 0 && opts.URL == "" {
-			break
-		}
+	err := WalkNamespaceRepos(ctx, client, namespace, opts, func(repo *scm.Repository) error {
+		list = append(list, repo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkNamespaceRepos calls fn for each repository in the
+// namespace, requesting one page at a time.
+func WalkNamespaceRepos(ctx context.Context, client *scm.Client, namespace string, opts Options, fn func(*scm.Repository) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.ListNamespace(ctx, namespace, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Repository))
+	})
+}
+
+// Hooks returns the full repository hook list, traversing
+// and combining paginated responses.
+func Hooks(ctx context.Context, client *scm.Client, repo string, opts Options) ([]*scm.Hook, error) {
+	list := []*scm.Hook{}
+	err := WalkHooks(ctx, client, repo, opts, func(hook *scm.Hook) error {
+		list = append(list, hook)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return list, nil
 }
+
+// WalkHooks calls fn for each repository hook, requesting
+// one page at a time.
+func WalkHooks(ctx context.Context, client *scm.Client, repo string, opts Options, fn func(*scm.Hook) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.ListHooks(ctx, repo, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Hook))
+	})
+}
+
+// Statuses returns the full commit status list, traversing
+// and combining paginated responses.
+func Statuses(ctx context.Context, client *scm.Client, repo, ref string, opts Options) ([]*scm.Status, error) {
+	list := []*scm.Status{}
+	err := WalkStatuses(ctx, client, repo, ref, opts, func(status *scm.Status) error {
+		list = append(list, status)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WalkStatuses calls fn for each commit status, requesting
+// one page at a time.
+func WalkStatuses(ctx context.Context, client *scm.Client, repo, ref string, opts Options, fn func(*scm.Status) error) error {
+	return walk(ctx, opts, func(page scm.ListOptions) (interface{}, *scm.Response, error) {
+		return client.Repositories.ListStatus(ctx, repo, ref, page)
+	}, func(v interface{}) error {
+		return fn(v.(*scm.Status))
+	})
+}
@@ -3,3 +3,52 @@
 // license that can be found in the LICENSE file.
 
 package traverse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+
+	"github.com/h2non/gock"
+)
+
+func TestRepos_NextURL(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories").
+		MatchParam("role", "member").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{
+			"values": [{"full_name": "atlassian/one", "name": "one"}],
+			"next": "https://api.bitbucket.org/2.0/repositories?role=member&after=one"
+		}`)
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories").
+		MatchParam("after", "one").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{
+			"values": [{"full_name": "atlassian/two", "name": "two"}]
+		}`)
+
+	client := bitbucket.NewDefault()
+	got, err := Repos(context.Background(), client)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 2 {
+		t.Errorf("Want 2 repositories, got %d", len(got))
+		return
+	}
+	if got, want := got[1].Name, "two"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
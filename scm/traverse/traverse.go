@@ -0,0 +1,89 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// defaultSize is the default page size requested from the
+// provider when traversing paginated results.
+const defaultSize = 100
+
+// ErrStop may be returned by a walk function to stop the
+// traversal early. The traversal then returns a nil error.
+var ErrStop = errors.New("traverse: stop")
+
+// Options provides options for traversing paginated
+// results.
+type Options struct {
+	// Size is the page size requested from the provider.
+	// The default page size is used if zero.
+	Size int
+
+	// Limit is the maximum number of items traversed.
+	// All items are traversed if zero. The page size is
+	// reduced to the limit when the limit is smaller.
+	Limit int
+}
+
+// pageFunc requests a single page of results. The returned
+// value must be a slice.
+type pageFunc func(page scm.ListOptions) (interface{}, *scm.Response, error)
+
+// walk requests each page of results until the last page
+// is reached, the item limit is reached, or the walk
+// function returns an error. Nil items are skipped.
+func walk(ctx context.Context, opts Options, next pageFunc, fn func(interface{}) error) error {
+	page := scm.ListOptions{Size: opts.Size}
+	if page.Size == 0 {
+		page.Size = defaultSize
+	}
+	// the page size is only reduced for the first page
+	// since changing the size between pages would shift
+	// the page offsets.
+	if opts.Limit > 0 && opts.Limit < page.Size {
+		page.Size = opts.Limit
+	}
+	var count int
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result, res, err := next(page)
+		if err != nil {
+			return err
+		}
+		items := reflect.ValueOf(result)
+		for i := 0; items.Kind() == reflect.Slice && i < items.Len(); i++ {
+			item := items.Index(i)
+			if item.Kind() == reflect.Ptr && item.IsNil() {
+				continue
+			}
+			if err := fn(item.Interface()); errors.Is(err, ErrStop) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			count++
+			if opts.Limit > 0 && count >= opts.Limit {
+				return nil
+			}
+		}
+		if res == nil {
+			return nil
+		}
+		page.Page = res.Page.Next
+		page.URL = res.Page.NextURL
+
+		if page.Page == 0 && page.URL == "" {
+			return nil
+		}
+	}
+}
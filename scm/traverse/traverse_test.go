@@ -0,0 +1,200 @@
+// Copyright 2022 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/github"
+
+	"github.com/h2non/gock"
+)
+
+func mockBranchPages() {
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches").
+		MatchParam("per_page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeader("Link", `<https://api.github.com/repos/octocat/hello-world/branches?page=2&per_page=2>; rel="next"`).
+		BodyString(`[{"name":"main"},{"name":"develop"}]`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches").
+		MatchParam("page", "2").
+		MatchParam("per_page", "2").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"name":"feature"}]`)
+}
+
+func TestBranches(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	client := github.NewDefault()
+	got, err := Branches(context.Background(), client, "octocat/hello-world", Options{Size: 2})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var names []string
+	for _, ref := range got {
+		names = append(names, ref.Name)
+	}
+	if want := []string{"main", "develop", "feature"}; !equal(names, want) {
+		t.Errorf("Want branches %v, got %v", want, names)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestBranches_Limit(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	client := github.NewDefault()
+	got, err := Branches(context.Background(), client, "octocat/hello-world", Options{Size: 2, Limit: 2})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 2 {
+		t.Errorf("Want 2 branches, got %d", len(got))
+	}
+	if gock.IsDone() {
+		t.Errorf("Expect second page not requested")
+	}
+}
+
+func TestWalkBranches_Stop(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	var names []string
+	client := github.NewDefault()
+	err := WalkBranches(context.Background(), client, "octocat/hello-world", Options{Size: 2}, func(ref *scm.Reference) error {
+		names = append(names, ref.Name)
+		if ref.Name == "develop" {
+			return ErrStop
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Expect ErrStop to return nil error, got %s", err)
+	}
+	if want := []string{"main", "develop"}; !equal(names, want) {
+		t.Errorf("Want branches %v, got %v", want, names)
+	}
+}
+
+func TestWalkBranches_StopWrapped(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	client := github.NewDefault()
+	err := WalkBranches(context.Background(), client, "octocat/hello-world", Options{Size: 2}, func(ref *scm.Reference) error {
+		return fmt.Errorf("done: %w", ErrStop)
+	})
+	if err != nil {
+		t.Errorf("Expect wrapped ErrStop to return nil error, got %s", err)
+	}
+}
+
+func TestBranches_LimitSize(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches").
+		MatchParam("per_page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeader("Link", `<https://api.github.com/repos/octocat/hello-world/branches?page=2&per_page=1>; rel="next"`).
+		BodyString(`[{"name":"main"}]`)
+
+	client := github.NewDefault()
+	got, err := Branches(context.Background(), client, "octocat/hello-world", Options{Limit: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 {
+		t.Errorf("Want 1 branch, got %d", len(got))
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expect page size reduced to the limit")
+	}
+}
+
+func TestWalkBranches_Error(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	want := errors.New("oops")
+	client := github.NewDefault()
+	got := WalkBranches(context.Background(), client, "octocat/hello-world", Options{Size: 2}, func(ref *scm.Reference) error {
+		return want
+	})
+	if got != want {
+		t.Errorf("Want error %v, got %v", want, got)
+	}
+}
+
+func TestWalkBranches_Canceled(t *testing.T) {
+	defer gock.Off()
+	mockBranchPages()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := github.NewDefault()
+	err := WalkBranches(ctx, client, "octocat/hello-world", Options{Size: 2}, func(ref *scm.Reference) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Want context canceled error, got %v", err)
+	}
+}
+
+func TestPullRequests_Filter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls").
+		MatchParam("state", "closed").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"number":1},{"number":2}]`)
+
+	client := github.NewDefault()
+	got, err := PullRequests(context.Background(), client, "octocat/hello-world", scm.PullRequestListOptions{Closed: true}, Options{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 2 {
+		t.Errorf("Want 2 pull requests, got %d", len(got))
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
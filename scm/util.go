@@ -105,15 +105,15 @@ func ConvertVisibility(from string) Visibility {
 
 func ConvertExecutionStatus(from string) ExecutionStatus {
 	switch from {
-	case "running", "in_progress", "INPROGRESS":
+	case "running", "in_progress", "INPROGRESS", "IN_PROGRESS", "inProgress":
 		return StatusRunning
-	case "success", "completed", "SUCCESSFUL":
+	case "success", "completed", "SUCCESSFUL", "succeeded":
 		return StatusSuccess
-	case "Failed", "failure", "FAILED":
+	case "Failed", "failure", "FAILED", "failed", "error", "ERROR":
 		return StatusFailed
-	case "Canceled", "cancelled", "STOPPED":
+	case "Canceled", "cancelled", "STOPPED", "canceled", "killed":
 		return StatusCanceled
-	case "pending", "queued":
+	case "pending", "queued", "PENDING", "created", "waiting", "waiting_for_resource", "preparing", "scheduled", "notStarted":
 		return StatusPending
 	default:
 		return StatusUnknown
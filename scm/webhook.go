@@ -110,6 +110,16 @@ type (
 		Review      Review
 	}
 
+	// PullRequestReviewHook represents a pull request
+	// review event, eg pull_request_review.
+	PullRequestReviewHook struct {
+		Action      Action
+		Repo        Repository
+		PullRequest PullRequest
+		Review      PullRequestReview
+		Sender      User
+	}
+
 	// DeployHook represents a deployment event. This is
 	// currently a GitHub-specific event type.
 	DeployHook struct {
@@ -124,6 +134,15 @@ type (
 		Task      string
 	}
 
+	// DeployStatusHook represents a deployment status
+	// event, eg deployment_status.
+	DeployStatusHook struct {
+		Deployment Deployment
+		Status     DeployStatus
+		Repo       Repository
+		Sender     User
+	}
+
 	// ReleaseHook represents a release event. This is
 	// currently a GitHub-specific event type.
 	ReleaseHook struct {
@@ -133,6 +152,25 @@ type (
 		Sender  User
 	}
 
+	// CheckRunHook represents a check run event, eg
+	// check_run.
+	CheckRunHook struct {
+		Action          Action
+		Repo            Repository
+		CheckRun        CheckRun
+		RequestedAction string
+		Sender          User
+	}
+
+	// CheckSuiteHook represents a check suite event, eg
+	// check_suite.
+	CheckSuiteHook struct {
+		Action     Action
+		Repo       Repository
+		CheckSuite CheckSuite
+		Sender     User
+	}
+
 	// PingHook represents a ping hook, eg ping events.
 	PingHook struct {
 		Repo   Repository
@@ -158,12 +196,16 @@ type (
 func (h *PushHook) Repository() Repository               { return h.Repo }
 func (h *BranchHook) Repository() Repository             { return h.Repo }
 func (h *DeployHook) Repository() Repository             { return h.Repo }
+func (h *DeployStatusHook) Repository() Repository       { return h.Repo }
 func (h *TagHook) Repository() Repository                { return h.Repo }
 func (h *IssueHook) Repository() Repository              { return h.Repo }
 func (h *IssueCommentHook) Repository() Repository       { return h.Repo }
 func (h *PullRequestHook) Repository() Repository        { return h.Repo }
 func (h *PullRequestCommentHook) Repository() Repository { return h.Repo }
 func (h *ReviewCommentHook) Repository() Repository      { return h.Repo }
+func (h *PullRequestReviewHook) Repository() Repository  { return h.Repo }
 func (h *ReleaseHook) Repository() Repository            { return h.Repo }
 func (h *PipelineHook) Repository() Repository           { return h.Repo }
 func (h *PingHook) Repository() Repository               { return h.Repo }
+func (h *CheckRunHook) Repository() Repository           { return h.Repo }
+func (h *CheckSuiteHook) Repository() Repository         { return h.Repo }